@@ -645,6 +645,7 @@ func makeTestEvmTxm(t *testing.T, db *sqlx.DB, ethClient client.Client, keyStore
 		keyStore,
 		estimator,
 		ht,
+		nil,
 		nil)
 	require.NoError(t, err, "can't create tx manager")
 
@@ -343,11 +343,16 @@ func (w *launcher) addRemoteCapabilities(ctx context.Context, myDON registrysync
 			}
 		case capabilities.CapabilityTypeAction:
 			newActionFn := func(info capabilities.CapabilityInfo) (capabilityService, error) {
+				aggregatorConfig, err := aggregation.ExtractResponseAggregatorConfig(capabilityConfig.DefaultConfig)
+				if err != nil {
+					return nil, err
+				}
 				client := executable.NewClient(
 					info,
 					myDON.DON,
 					w.dispatcher,
 					defaultTargetRequestTimeout,
+					aggregatorConfig,
 					w.lggr,
 				)
 				return client, nil
@@ -361,11 +366,16 @@ func (w *launcher) addRemoteCapabilities(ctx context.Context, myDON registrysync
 			// nothing to do; we don't support remote consensus capabilities for now
 		case capabilities.CapabilityTypeTarget:
 			newTargetFn := func(info capabilities.CapabilityInfo) (capabilityService, error) {
+				aggregatorConfig, err := aggregation.ExtractResponseAggregatorConfig(capabilityConfig.DefaultConfig)
+				if err != nil {
+					return nil, err
+				}
 				client := executable.NewClient(
 					info,
 					myDON.DON,
 					w.dispatcher,
 					defaultTargetRequestTimeout,
+					aggregatorConfig,
 					w.lggr,
 				)
 				return client, nil
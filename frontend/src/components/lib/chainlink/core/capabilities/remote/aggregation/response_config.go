@@ -0,0 +1,150 @@
+package aggregation
+
+import (
+	"fmt"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+)
+
+// Modes for aggregating the responses of a remote executable capability,
+// selected with the "aggregationMode" key of the capability config in the
+// capabilities registry
+const (
+	// F+1 identical responses (the default)
+	ResponseModeIdentical = "identical"
+	// A configurable quorum of identical responses
+	ResponseModeMajority = "majority"
+	// The response with the median value of a numeric field across a quorum
+	// of responses
+	ResponseModeMedian = "median"
+	// The first successful response; only suitable for idempotent reads
+	ResponseModeFirstSuccess = "firstSuccess"
+)
+
+type ResponseAggregatorConfig struct {
+	Mode string
+	// Quorum is the number of responses required by the majority and median
+	// modes. Defaults to a simple majority of the DON for majority and 2F+1
+	// for median.
+	Quorum uint32
+	// MedianField is the dot-separated path of the numeric field of the
+	// response value that the median mode orders responses by
+	MedianField string
+
+	// quorumAtLeastDefault is set when a request sets the quorum of a
+	// capability configured with the default quorum of its mode, which the
+	// requested quorum must then not be below
+	quorumAtLeastDefault bool
+}
+
+// ExtractResponseAggregatorConfig reads the "aggregationMode",
+// "aggregationQuorum" and "aggregationMedianField" keys of a capability
+// config
+func ExtractResponseAggregatorConfig(config *values.Map) (ResponseAggregatorConfig, error) {
+	var c struct {
+		AggregationMode        string
+		AggregationQuorum      uint32
+		AggregationMedianField string
+	}
+	if config != nil {
+		if err := config.UnwrapTo(&c); err != nil {
+			return ResponseAggregatorConfig{}, fmt.Errorf("failed to unwrap aggregation config from value map: %w", err)
+		}
+	}
+	return ResponseAggregatorConfig{Mode: c.AggregationMode, Quorum: c.AggregationQuorum, MedianField: c.AggregationMedianField}, nil
+}
+
+// WithRequestOverride returns the config of the capability with the
+// aggregation keys set in a request's config applied on top. A request can
+// only strengthen the aggregation the capability is configured with: it can
+// repeat the configured mode and raise its quorum, but not switch to another
+// mode or lower the quorum, as that would let a single workflow accept a
+// result that fewer nodes agree on than the capability requires.
+func (c ResponseAggregatorConfig) WithRequestOverride(requestConfig *values.Map) (ResponseAggregatorConfig, error) {
+	override, err := ExtractResponseAggregatorConfig(requestConfig)
+	if err != nil {
+		return ResponseAggregatorConfig{}, err
+	}
+	if override.Mode != "" && override.Mode != c.Mode && (c.Mode != "" || override.Mode != ResponseModeIdentical) {
+		return ResponseAggregatorConfig{}, fmt.Errorf("aggregation mode %q cannot be set per request, the capability is configured with %q", override.Mode, c.mode())
+	}
+	if override.Quorum != 0 {
+		if override.Quorum < c.Quorum {
+			return ResponseAggregatorConfig{}, fmt.Errorf("aggregation quorum %d cannot be set per request, it must be at least the configured quorum %d", override.Quorum, c.Quorum)
+		}
+		if c.Quorum == 0 {
+			c.quorumAtLeastDefault = true
+		}
+		c.Quorum = override.Quorum
+	}
+	if override.MedianField != "" {
+		c.MedianField = override.MedianField
+	}
+	return c, nil
+}
+
+func (c ResponseAggregatorConfig) mode() string {
+	if c.Mode == "" {
+		return ResponseModeIdentical
+	}
+	return c.Mode
+}
+
+// NewResponseAggregator returns the aggregator for the given config and the
+// DON serving the capability
+func NewResponseAggregator(cfg ResponseAggregatorConfig, don commoncap.DON) (remotetypes.ResponseAggregator, error) {
+	n := len(don.Members)
+	f := int(don.F)
+	quorum := int(cfg.Quorum)
+	if quorum > n {
+		return nil, fmt.Errorf("aggregation quorum %d exceeds DON size %d", quorum, n)
+	}
+
+	switch cfg.Mode {
+	case "", ResponseModeIdentical:
+		return NewModeResponseAggregator(f + 1), nil
+	case ResponseModeMajority:
+		if err := checkQuorum(&quorum, n/2+1, cfg.quorumAtLeastDefault); err != nil {
+			return nil, err
+		}
+		if quorum < f+1 {
+			return nil, fmt.Errorf("majority quorum %d must be at least F+1 (%d)", quorum, f+1)
+		}
+		return NewModeResponseAggregator(quorum), nil
+	case ResponseModeMedian:
+		if cfg.MedianField == "" {
+			return nil, fmt.Errorf("aggregation mode %q requires aggregationMedianField", ResponseModeMedian)
+		}
+		if err := checkQuorum(&quorum, 2*f+1, cfg.quorumAtLeastDefault); err != nil {
+			return nil, err
+		}
+		// with fewer than 2F+1 responses, the F faulty nodes could make up
+		// half of them and pick the median
+		if quorum < 2*f+1 {
+			return nil, fmt.Errorf("median quorum %d must be at least 2F+1 (%d)", quorum, 2*f+1)
+		}
+		if quorum > n {
+			return nil, fmt.Errorf("median quorum %d exceeds DON size %d", quorum, n)
+		}
+		return NewMedianResponseAggregator(quorum, cfg.MedianField), nil
+	case ResponseModeFirstSuccess:
+		return NewFirstSuccessResponseAggregator(), nil
+	default:
+		return nil, fmt.Errorf("unknown aggregation mode %q", cfg.Mode)
+	}
+}
+
+// checkQuorum sets quorum to the default quorum of the mode if it is not
+// set, or checks that a quorum set by a request does not lower the default
+func checkQuorum(quorum *int, defaultQuorum int, atLeastDefault bool) error {
+	switch {
+	case *quorum == 0:
+		*quorum = defaultQuorum
+	case atLeastDefault && *quorum < defaultQuorum:
+		return fmt.Errorf("aggregation quorum %d cannot be set per request, it must be at least the default quorum %d", *quorum, defaultQuorum)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package aggregation
+
+import (
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
+	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+)
+
+// firstSuccessResponseAggregator accepts the first successful response
+// without comparing it to others. A single faulty node can determine the
+// result, so it must only be used for idempotent reads where availability
+// matters more than agreement.
+type firstSuccessResponseAggregator struct{}
+
+var _ remotetypes.ResponseAggregator = &firstSuccessResponseAggregator{}
+
+func NewFirstSuccessResponseAggregator() *firstSuccessResponseAggregator {
+	return &firstSuccessResponseAggregator{}
+}
+
+func (a *firstSuccessResponseAggregator) Aggregate(responses []commoncap.CapabilityResponse) (*commoncap.CapabilityResponse, []int, error) {
+	if len(responses) == 0 {
+		return nil, nil, nil
+	}
+	return &responses[0], []int{0}, nil
+}
+
+func (a *firstSuccessResponseAggregator) MinResponses() int {
+	return 1
+}
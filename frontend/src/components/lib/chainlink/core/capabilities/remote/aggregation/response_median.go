@@ -0,0 +1,106 @@
+package aggregation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+)
+
+// medianResponseAggregator orders a quorum of responses by a numeric field
+// and returns the median response as a whole. For an even number of
+// responses the lower of the two middle responses is used. Returning a
+// response reported by a single node, rather than combining fields from
+// different responses, keeps the fields of the result consistent with each
+// other. This tolerates nodes reporting slightly different prices for the
+// same read. Responses without a numeric value of the field are skipped.
+type medianResponseAggregator struct {
+	quorum int
+	field  []string
+}
+
+var _ remotetypes.ResponseAggregator = &medianResponseAggregator{}
+
+// NewMedianResponseAggregator returns an aggregator ordering responses by
+// field, a dot-separated path into the response value
+func NewMedianResponseAggregator(quorum int, field string) *medianResponseAggregator {
+	return &medianResponseAggregator{quorum: quorum, field: strings.Split(field, ".")}
+}
+
+func (a *medianResponseAggregator) Aggregate(responses []commoncap.CapabilityResponse) (*commoncap.CapabilityResponse, []int, error) {
+	if len(responses) < a.quorum {
+		return nil, nil, nil
+	}
+
+	// responses without a numeric value of the field are skipped, so that a
+	// faulty node cannot hold up the aggregation once enough valid
+	// responses arrived
+	keys := make(map[int]*big.Float, a.quorum)
+	indexes := make([]int, 0, a.quorum)
+	var errs []error
+	for i := range responses {
+		if len(indexes) == a.quorum {
+			break
+		}
+		key, err := a.key(responses[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("response %d: %w", i, err))
+			continue
+		}
+		keys[i] = key
+		indexes = append(indexes, i)
+	}
+	if len(indexes) < a.quorum {
+		return nil, nil, fmt.Errorf("%d valid responses of the %d required: %w", len(indexes), a.quorum, errors.Join(errs...))
+	}
+
+	sorted := slices.Clone(indexes)
+	slices.SortStableFunc(sorted, func(x, y int) int { return keys[x].Cmp(keys[y]) })
+	median := sorted[(len(sorted)-1)/2]
+	return &responses[median], indexes, nil
+}
+
+func (a *medianResponseAggregator) MinResponses() int {
+	return a.quorum
+}
+
+// key returns the numeric value of the aggregator's field in r
+func (a *medianResponseAggregator) key(r commoncap.CapabilityResponse) (*big.Float, error) {
+	path := strings.Join(a.field, ".")
+	var v values.Value
+	if r.Value != nil {
+		v = r.Value
+	}
+	for _, k := range a.field {
+		m, ok := v.(*values.Map)
+		if !ok || m == nil {
+			return nil, fmt.Errorf("field %s not found", path)
+		}
+		if v, ok = m.Underlying[k]; !ok {
+			return nil, fmt.Errorf("field %s not found", path)
+		}
+	}
+
+	switch x := v.(type) {
+	case *values.Int64:
+		return new(big.Float).SetInt64(x.Underlying), nil
+	case *values.Float64:
+		if math.IsNaN(x.Underlying) {
+			return nil, fmt.Errorf("field %s is NaN", path)
+		}
+		return new(big.Float).SetFloat64(x.Underlying), nil
+	case *values.BigInt:
+		return new(big.Float).SetInt(x.Underlying), nil
+	case *values.Decimal:
+		return x.Underlying.BigFloat(), nil
+	default:
+		return nil, fmt.Errorf("field %s is not numeric, got %T", path, v)
+	}
+}
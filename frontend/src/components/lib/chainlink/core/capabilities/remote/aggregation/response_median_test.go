@@ -0,0 +1,96 @@
+package aggregation
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+)
+
+func TestMedianResponseAggregator_Aggregate(t *testing.T) {
+	response := func(price int64, source string) commoncap.CapabilityResponse {
+		return newCapabilityResponse(t, map[string]any{
+			"answer": map[string]any{"price": price},
+			"source": source,
+		})
+	}
+
+	responses := []commoncap.CapabilityResponse{
+		response(103, "a"),
+		response(100, "b"),
+		response(101, "c"),
+		response(102, "d"),
+	}
+
+	agg := NewMedianResponseAggregator(3, "answer.price")
+	assert.Equal(t, 3, agg.MinResponses())
+
+	res, _, err := agg.Aggregate(responses[:2])
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	res, used, err := agg.Aggregate(responses)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	// only the first quorum of responses is used
+	assert.Equal(t, []int{0, 1, 2}, used)
+	// the whole median response is returned
+	assert.Equal(t, responses[2], *res)
+
+	t.Run("lower median for even number of responses", func(t *testing.T) {
+		agg := NewMedianResponseAggregator(4, "answer.price")
+		res, _, err := agg.Aggregate(responses)
+		require.NoError(t, err)
+		assert.Equal(t, responses[2], *res)
+	})
+
+	t.Run("compares numeric types with each other", func(t *testing.T) {
+		agg := NewMedianResponseAggregator(3, "price")
+		rs := []commoncap.CapabilityResponse{
+			newCapabilityResponse(t, map[string]any{"price": decimal.RequireFromString("2.5")}),
+			newCapabilityResponse(t, map[string]any{"price": big.NewInt(3)}),
+			newCapabilityResponse(t, map[string]any{"price": 2.25}),
+		}
+		res, _, err := agg.Aggregate(rs)
+		require.NoError(t, err)
+		assert.Equal(t, rs[0], *res)
+	})
+
+	t.Run("fails if a response is missing the field", func(t *testing.T) {
+		agg := NewMedianResponseAggregator(2, "answer.price")
+		_, _, err := agg.Aggregate([]commoncap.CapabilityResponse{
+			response(1, "a"),
+			newCapabilityResponse(t, map[string]any{"source": "b"}),
+		})
+		require.ErrorContains(t, err, "response 1: field answer.price not found")
+	})
+
+	t.Run("skips invalid responses", func(t *testing.T) {
+		agg := NewMedianResponseAggregator(3, "answer.price")
+		rs := []commoncap.CapabilityResponse{
+			response(103, "a"),
+			newCapabilityResponse(t, map[string]any{"answer": map[string]any{"price": "not a number"}}),
+			response(100, "c"),
+		}
+		_, _, err := agg.Aggregate(rs)
+		require.ErrorContains(t, err, "2 valid responses of the 3 required")
+
+		// a later valid response completes the quorum
+		rs = append(rs, response(101, "d"))
+		res, used, err := agg.Aggregate(rs)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, []int{0, 2, 3}, used)
+		assert.Equal(t, rs[3], *res)
+	})
+
+	t.Run("fails if the field is not numeric", func(t *testing.T) {
+		agg := NewMedianResponseAggregator(1, "source")
+		_, _, err := agg.Aggregate([]commoncap.CapabilityResponse{response(1, "a")})
+		require.ErrorContains(t, err, "field source is not numeric")
+	})
+}
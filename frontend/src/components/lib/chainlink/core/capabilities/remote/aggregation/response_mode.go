@@ -0,0 +1,43 @@
+package aggregation
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
+
+	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+)
+
+// modeResponseAggregator returns the first response with a quorum of
+// identical copies
+type modeResponseAggregator struct {
+	quorum int
+}
+
+var _ remotetypes.ResponseAggregator = &modeResponseAggregator{}
+
+func NewModeResponseAggregator(quorum int) *modeResponseAggregator {
+	return &modeResponseAggregator{quorum: quorum}
+}
+
+func (a *modeResponseAggregator) Aggregate(responses []commoncap.CapabilityResponse) (*commoncap.CapabilityResponse, []int, error) {
+	hashToIndexes := make(map[[32]byte][]int)
+	for i, r := range responses {
+		b, err := pb.MarshalCapabilityResponse(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+		}
+		h := sha256.Sum256(b)
+		hashToIndexes[h] = append(hashToIndexes[h], i)
+		if len(hashToIndexes[h]) == a.quorum {
+			return &responses[hashToIndexes[h][0]], hashToIndexes[h], nil
+		}
+	}
+	return nil, nil, nil
+}
+
+func (a *modeResponseAggregator) MinResponses() int {
+	return a.quorum
+}
@@ -0,0 +1,158 @@
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+func newCapabilityResponse(t *testing.T, v map[string]any) commoncap.CapabilityResponse {
+	m, err := values.NewMap(v)
+	require.NoError(t, err)
+	return commoncap.CapabilityResponse{Value: m}
+}
+
+func TestModeResponseAggregator_Aggregate(t *testing.T) {
+	r1 := newCapabilityResponse(t, map[string]any{"response": "a"})
+	r2 := newCapabilityResponse(t, map[string]any{"response": "b"})
+
+	agg := NewModeResponseAggregator(2)
+	assert.Equal(t, 2, agg.MinResponses())
+
+	res, used, err := agg.Aggregate([]commoncap.CapabilityResponse{r1, r2})
+	require.NoError(t, err)
+	assert.Nil(t, res)
+	assert.Nil(t, used)
+
+	res, used, err = agg.Aggregate([]commoncap.CapabilityResponse{r1, r2, r2})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, r2, *res)
+	assert.Equal(t, []int{1, 2}, used)
+}
+
+func TestFirstSuccessResponseAggregator_Aggregate(t *testing.T) {
+	r1 := newCapabilityResponse(t, map[string]any{"response": "a"})
+	r2 := newCapabilityResponse(t, map[string]any{"response": "b"})
+
+	agg := NewFirstSuccessResponseAggregator()
+	res, _, err := agg.Aggregate(nil)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	res, used, err := agg.Aggregate([]commoncap.CapabilityResponse{r1, r2})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, r1, *res)
+	assert.Equal(t, []int{0}, used)
+}
+
+func TestNewResponseAggregator(t *testing.T) {
+	don := commoncap.DON{Members: make([]p2ptypes.PeerID, 7), F: 2}
+
+	tests := []struct {
+		name         string
+		cfg          ResponseAggregatorConfig
+		minResponses int
+		err          string
+	}{
+		{name: "default", cfg: ResponseAggregatorConfig{}, minResponses: 3},
+		{name: "identical", cfg: ResponseAggregatorConfig{Mode: ResponseModeIdentical}, minResponses: 3},
+		{name: "majority default quorum", cfg: ResponseAggregatorConfig{Mode: ResponseModeMajority}, minResponses: 4},
+		{name: "majority with quorum", cfg: ResponseAggregatorConfig{Mode: ResponseModeMajority, Quorum: 6}, minResponses: 6},
+		{name: "majority quorum too small", cfg: ResponseAggregatorConfig{Mode: ResponseModeMajority, Quorum: 2}, err: "must be at least F+1"},
+		{name: "median default quorum", cfg: ResponseAggregatorConfig{Mode: ResponseModeMedian, MedianField: "price"}, minResponses: 5},
+		{name: "median with quorum", cfg: ResponseAggregatorConfig{Mode: ResponseModeMedian, MedianField: "price", Quorum: 6}, minResponses: 6},
+		{name: "median quorum below 2F+1", cfg: ResponseAggregatorConfig{Mode: ResponseModeMedian, MedianField: "price", Quorum: 3}, err: "must be at least 2F+1 (5)"},
+		{name: "median quorum too large", cfg: ResponseAggregatorConfig{Mode: ResponseModeMedian, MedianField: "price", Quorum: 8}, err: "exceeds DON size"},
+		{name: "median without field", cfg: ResponseAggregatorConfig{Mode: ResponseModeMedian}, err: "requires aggregationMedianField"},
+		{name: "first success", cfg: ResponseAggregatorConfig{Mode: ResponseModeFirstSuccess}, minResponses: 1},
+		{name: "unknown", cfg: ResponseAggregatorConfig{Mode: "mean"}, err: "unknown aggregation mode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agg, err := NewResponseAggregator(tt.cfg, don)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.minResponses, agg.MinResponses())
+		})
+	}
+}
+
+func TestExtractResponseAggregatorConfig(t *testing.T) {
+	m, err := values.NewMap(map[string]any{
+		"schedule":               "allAtOnce",
+		"aggregationMode":        "median",
+		"aggregationQuorum":      3,
+		"aggregationMedianField": "answer.price",
+	})
+	require.NoError(t, err)
+
+	cfg, err := ExtractResponseAggregatorConfig(m)
+	require.NoError(t, err)
+	assert.Equal(t, ResponseAggregatorConfig{Mode: ResponseModeMedian, Quorum: 3, MedianField: "answer.price"}, cfg)
+
+	cfg, err = ExtractResponseAggregatorConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, ResponseAggregatorConfig{}, cfg)
+}
+
+func TestResponseAggregatorConfig_WithRequestOverride(t *testing.T) {
+	capabilityConfig := ResponseAggregatorConfig{Mode: ResponseModeMedian, MedianField: "price"}
+
+	override := func(t *testing.T, c ResponseAggregatorConfig, cfg map[string]any) (ResponseAggregatorConfig, error) {
+		m, err := values.NewMap(cfg)
+		require.NoError(t, err)
+		return c.WithRequestOverride(m)
+	}
+
+	cfg, err := override(t, capabilityConfig, map[string]any{"schedule": "allAtOnce"})
+	require.NoError(t, err)
+	assert.Equal(t, capabilityConfig, cfg)
+
+	// the mode cannot be switched per request
+	for _, mode := range []string{ResponseModeIdentical, ResponseModeMajority, ResponseModeFirstSuccess} {
+		_, err = override(t, capabilityConfig, map[string]any{"aggregationMode": mode})
+		require.ErrorContains(t, err, "cannot be set per request")
+	}
+	_, err = override(t, ResponseAggregatorConfig{}, map[string]any{"aggregationMode": "median", "aggregationMedianField": "price"})
+	require.ErrorContains(t, err, `aggregation mode "median" cannot be set per request, the capability is configured with "identical"`)
+	cfg, err = override(t, ResponseAggregatorConfig{}, map[string]any{"aggregationMode": "identical"})
+	require.NoError(t, err)
+	assert.Equal(t, ResponseAggregatorConfig{}, cfg)
+
+	// the quorum can only be raised
+	majority := ResponseAggregatorConfig{Mode: ResponseModeMajority, Quorum: 4}
+	cfg, err = override(t, majority, map[string]any{"aggregationMode": "majority", "aggregationQuorum": 5})
+	require.NoError(t, err)
+	assert.Equal(t, ResponseAggregatorConfig{Mode: ResponseModeMajority, Quorum: 5}, cfg)
+	_, err = override(t, majority, map[string]any{"aggregationQuorum": 3})
+	require.ErrorContains(t, err, "it must be at least the configured quorum 4")
+
+	// a quorum set over the default quorum of the mode cannot be below it
+	don := commoncap.DON{Members: make([]p2ptypes.PeerID, 7), F: 2}
+	cfg, err = override(t, capabilityConfig, map[string]any{"aggregationQuorum": 3})
+	require.NoError(t, err)
+	_, err = NewResponseAggregator(cfg, don)
+	require.ErrorContains(t, err, "it must be at least the default quorum 5")
+	cfg, err = override(t, capabilityConfig, map[string]any{"aggregationQuorum": 6})
+	require.NoError(t, err)
+	agg, err := NewResponseAggregator(cfg, don)
+	require.NoError(t, err)
+	assert.Equal(t, 6, agg.MinResponses())
+
+	// the capability's own mode can be repeated in the request config
+	cfg, err = override(t, ResponseAggregatorConfig{Mode: ResponseModeFirstSuccess}, map[string]any{"aggregationMode": "firstSuccess"})
+	require.NoError(t, err)
+	assert.Equal(t, ResponseAggregatorConfig{Mode: ResponseModeFirstSuccess}, cfg)
+}
@@ -37,9 +37,14 @@ type dispatcher struct {
 	rateLimiter *common.RateLimiter
 	receivers   map[key]*receiver
 	mu          sync.RWMutex
+	reassembler *chunkReassembler
 	stopCh      services.StopChan
 	wg          sync.WaitGroup
 	lggr        logger.Logger
+
+	// features advertised by each peer in the last message received from it
+	peerFeatures   map[p2ptypes.PeerID]uint32
+	peerFeaturesMu sync.RWMutex
 }
 
 type key struct {
@@ -60,14 +65,16 @@ func NewDispatcher(cfg config.Dispatcher, peerWrapper p2ptypes.PeerWrapper, sign
 		return nil, errors.Wrap(err, "failed to create rate limiter")
 	}
 	return &dispatcher{
-		cfg:         cfg,
-		peerWrapper: peerWrapper,
-		signer:      signer,
-		registry:    registry,
-		rateLimiter: rl,
-		receivers:   make(map[key]*receiver),
-		stopCh:      make(services.StopChan),
-		lggr:        lggr.Named("Dispatcher"),
+		cfg:          cfg,
+		peerWrapper:  peerWrapper,
+		signer:       signer,
+		registry:     registry,
+		rateLimiter:  rl,
+		receivers:    make(map[key]*receiver),
+		reassembler:  newChunkReassembler(),
+		stopCh:       make(services.StopChan),
+		lggr:         lggr.Named("Dispatcher"),
+		peerFeatures: make(map[p2ptypes.PeerID]uint32),
 	}, nil
 }
 
@@ -157,23 +164,76 @@ func (d *dispatcher) Send(peerID p2ptypes.PeerID, msgBody *types.MessageBody) er
 	msgBody.Sender = d.peerID[:]
 	msgBody.Receiver = peerID[:]
 	msgBody.Timestamp = time.Now().UnixMilli()
+	msgBody.Features = supportedFeatures
 	rawBody, err := proto.Marshal(msgBody)
 	if err != nil {
 		return err
 	}
+	if len(rawBody) > maxMessageSize {
+		return fmt.Errorf("message body of %d bytes exceeds limit of %d bytes", len(rawBody), maxMessageSize)
+	}
+
+	peerFeatures, known := d.getPeerFeatures(peerID)
+	if !known && len(rawBody) > maxChunkSize {
+		// The body does not fit in a single P2P message, so a peer without
+		// chunking support could not receive it either way. Until the peer
+		// has advertised its features in a message to us, assume it supports
+		// the same features as we do.
+		peerFeatures = supportedFeatures
+	}
+	if len(rawBody) > maxChunkSize && !hasFeature(peerFeatures, types.Feature_FEATURE_CHUNKING) {
+		return fmt.Errorf("message body of %d bytes is too large for peer %s, which does not support chunking", len(rawBody), peerID)
+	}
+	compression := types.Compression_COMPRESSION_NONE
+	if hasFeature(peerFeatures, types.Feature_FEATURE_COMPRESSION) && len(rawBody) > compressionThreshold {
+		compressed, err := compressBody(rawBody)
+		if err != nil {
+			return fmt.Errorf("failed to compress message body: %w", err)
+		}
+		if len(compressed) < len(rawBody) {
+			rawBody = compressed
+			compression = types.Compression_COMPRESSION_GZIP
+		}
+	}
+
 	signature, err := d.signer.Sign(rawBody)
 	if err != nil {
 		return err
 	}
-	msg := &types.Message{Signature: signature, Body: rawBody}
-	rawMsg, err := proto.Marshal(msg)
-	if err != nil {
-		return err
+	msgs := []*types.Message{{Signature: signature, Body: rawBody, Compression: compression}}
+	if len(rawBody) > maxChunkSize {
+		msgs = splitIntoChunks(msgs[0])
 	}
-	return d.peer.Send(peerID, rawMsg)
+	for _, msg := range msgs {
+		rawMsg, err := proto.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		if err = d.peer.Send(peerID, rawMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPeerFeatures returns the features advertised by peerID, and whether it
+// has advertised any yet
+func (d *dispatcher) getPeerFeatures(peerID p2ptypes.PeerID) (uint32, bool) {
+	d.peerFeaturesMu.RLock()
+	defer d.peerFeaturesMu.RUnlock()
+	features, ok := d.peerFeatures[peerID]
+	return features, ok
+}
+
+func (d *dispatcher) setPeerFeatures(peerID p2ptypes.PeerID, features uint32) {
+	d.peerFeaturesMu.Lock()
+	defer d.peerFeaturesMu.Unlock()
+	d.peerFeatures[peerID] = features
 }
 
 func (d *dispatcher) receive() {
+	ctx, cancel := d.stopCh.NewCtx()
+	defer cancel()
 	recvCh := d.peer.Receive()
 	for {
 		select {
@@ -181,16 +241,36 @@ func (d *dispatcher) receive() {
 			d.lggr.Info("stopped - exiting receive")
 			return
 		case msg := <-recvCh:
-			if !d.rateLimiter.Allow(msg.Sender.String()) {
+			// every P2P message counts against the rate limit, including
+			// each chunk of a chunked message
+			if !d.rateLimiter.Allow(ctx, msg.Sender.String()) {
 				d.lggr.Errorw("rate limit exceeded, dropping message", "sender", msg.Sender)
 				continue
 			}
-			body, err := ValidateMessage(msg, d.peerID)
+			var topLevelMessage types.Message
+			if err := proto.Unmarshal(msg.Payload, &topLevelMessage); err != nil {
+				d.lggr.Debugw("received invalid message", "error", err)
+				continue
+			}
+			complete := &topLevelMessage
+			if topLevelMessage.Chunk != nil {
+				var err error
+				complete, err = d.reassembler.Add(msg.Sender, &topLevelMessage)
+				if err != nil {
+					d.lggr.Debugw("received invalid message chunk", "sender", msg.Sender, "error", err)
+					continue
+				}
+				if complete == nil {
+					continue
+				}
+			}
+			body, err := validateTopLevelMessage(complete, msg.Sender, d.peerID)
 			if err != nil {
 				d.lggr.Debugw("received invalid message", "error", err)
 				d.tryRespondWithError(msg.Sender, body, types.Error_VALIDATION_FAILED)
 				continue
 			}
+			d.setPeerFeatures(msg.Sender, body.Features)
 			k := key{body.CapabilityId, body.CapabilityDonId}
 			d.mu.RLock()
 			receiver, ok := d.receivers[k]
@@ -1,12 +1,18 @@
 package remote_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
@@ -195,3 +201,181 @@ func TestDispatcher_RespondWithError(t *testing.T) {
 
 	require.NoError(t, dispatcher.Close())
 }
+
+func TestDispatcher_LargeMessages(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	cfg := testConfig{
+		supportedVersion:   1,
+		receiverBufferSize: 10000,
+		rateLimit: testRateLimitConfig{
+			globalRPS:   800.0,
+			globalBurst: 100,
+			rps:         10.0,
+			burst:       50,
+		},
+	}
+
+	type node struct {
+		privKey    ed25519.PrivateKey
+		peerID     p2ptypes.PeerID
+		recvCh     chan p2ptypes.Message
+		sent       []*remotetypes.Message
+		dispatcher remotetypes.Dispatcher
+		receiver   *testReceiver
+	}
+	nodes := make([]*node, 2)
+	for i := range nodes {
+		privKey, peerID := newKeyPair(t)
+		nodes[i] = &node{privKey: privKey, peerID: peerID, recvCh: make(chan p2ptypes.Message, 100), receiver: newReceiver()}
+	}
+	for i, n := range nodes {
+		other := nodes[1-i]
+		peer := mocks.NewPeer(t)
+		peer.On("Receive", mock.Anything).Return((<-chan p2ptypes.Message)(n.recvCh))
+		peer.On("ID", mock.Anything).Return(n.peerID)
+		peer.On("Send", other.peerID, mock.Anything).Return(func(_ p2ptypes.PeerID, payload []byte) error {
+			msg := &remotetypes.Message{}
+			require.NoError(t, proto.Unmarshal(payload, msg))
+			n.sent = append(n.sent, msg)
+			other.recvCh <- p2ptypes.Message{Sender: n.peerID, Payload: payload}
+			return nil
+		})
+		wrapper := mocks.NewPeerWrapper(t)
+		wrapper.On("GetPeer").Return(peer)
+		signer := mocks.NewSigner(t)
+		signer.On("Sign", mock.Anything).Return(func(data []byte) ([]byte, error) {
+			return ed25519.Sign(n.privKey, data), nil
+		})
+		registry := commonMocks.NewCapabilitiesRegistry(t)
+
+		d, err := remote.NewDispatcher(cfg, wrapper, signer, registry, lggr)
+		require.NoError(t, err)
+		require.NoError(t, d.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, d.Close()) })
+		require.NoError(t, d.SetReceiver(capID1, donID1, n.receiver))
+		n.dispatcher = d
+	}
+	send := func(from, to *node, payload []byte) []*remotetypes.Message {
+		from.sent = nil
+		require.NoError(t, from.dispatcher.Send(to.peerID, &remotetypes.MessageBody{
+			CapabilityId:    capID1,
+			CapabilityDonId: donID1,
+			Payload:         payload,
+		}))
+		m := <-to.receiver.ch
+		require.Equal(t, payload, m.Payload)
+		return from.sent
+	}
+
+	// compressible messages are not compressed until the receiver has
+	// advertised support
+	compressible := bytes.Repeat([]byte(payload1), 10000)
+	sent := send(nodes[0], nodes[1], compressible)
+	require.Len(t, sent, 1)
+	require.Equal(t, remotetypes.Compression_COMPRESSION_NONE, sent[0].Compression)
+
+	// messages too large for a single P2P message are chunked even before the
+	// receiver has advertised support
+	random := make([]byte, 1024*1024)
+	_, err := rand.Read(random)
+	require.NoError(t, err)
+	sent = send(nodes[0], nodes[1], random)
+	require.Len(t, sent, 3)
+	require.NotNil(t, sent[0].Chunk)
+
+	// incompressible messages are chunked
+	sent = send(nodes[1], nodes[0], random)
+	require.Len(t, sent, 3)
+	for i, msg := range sent {
+		require.NotNil(t, msg.Chunk)
+		require.Equal(t, uint32(i), msg.Chunk.Index)
+		require.Equal(t, uint32(3), msg.Chunk.Count)
+		require.Equal(t, remotetypes.Compression_COMPRESSION_NONE, msg.Compression)
+	}
+
+	// compressible messages are compressed
+	sent = send(nodes[1], nodes[0], bytes.Repeat([]byte(payload1), 100000))
+	require.Len(t, sent, 1)
+	require.Nil(t, sent[0].Chunk)
+	require.Equal(t, remotetypes.Compression_COMPRESSION_GZIP, sent[0].Compression)
+
+	// small messages are sent as is
+	sent = send(nodes[1], nodes[0], []byte(payload2))
+	require.Len(t, sent, 1)
+	require.Nil(t, sent[0].Chunk)
+	require.Equal(t, remotetypes.Compression_COMPRESSION_NONE, sent[0].Compression)
+}
+
+// splitAndSign splits a signed message into count chunks
+func splitAndSign(t *testing.T, msg p2ptypes.Message, count int) []p2ptypes.Message {
+	var signed remotetypes.Message
+	require.NoError(t, proto.Unmarshal(msg.Payload, &signed))
+	digest := sha256.Sum256(signed.Body)
+	size := (len(signed.Body) + count - 1) / count
+	chunks := make([]p2ptypes.Message, count)
+	for i := range chunks {
+		end := min((i+1)*size, len(signed.Body))
+		rawMsg, err := proto.Marshal(&remotetypes.Message{
+			Signature: signed.Signature,
+			Body:      signed.Body[i*size : end],
+			Chunk: &remotetypes.Chunk{
+				Digest:    digest[:],
+				Index:     uint32(i),
+				Count:     uint32(count),
+				TotalSize: uint32(len(signed.Body)),
+			},
+		})
+		require.NoError(t, err)
+		chunks[i] = p2ptypes.Message{Sender: msg.Sender, Payload: rawMsg}
+	}
+	return chunks
+}
+
+func TestDispatcher_RateLimitsChunks(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	privKey1, peerID1 := newKeyPair(t)
+	_, peerID2 := newKeyPair(t)
+
+	peer := mocks.NewPeer(t)
+	recvCh := make(chan p2ptypes.Message)
+	peer.On("Receive", mock.Anything).Return((<-chan p2ptypes.Message)(recvCh))
+	peer.On("ID", mock.Anything).Return(peerID2)
+	wrapper := mocks.NewPeerWrapper(t)
+	wrapper.On("GetPeer").Return(peer)
+	signer := mocks.NewSigner(t)
+	registry := commonMocks.NewCapabilitiesRegistry(t)
+
+	dispatcher, err := remote.NewDispatcher(testConfig{
+		supportedVersion:   1,
+		receiverBufferSize: 10000,
+		rateLimit: testRateLimitConfig{
+			globalRPS:   800.0,
+			globalBurst: 100,
+			rps:         0.001,
+			burst:       3,
+		},
+	}, wrapper, signer, registry, lggr)
+	require.NoError(t, err)
+	require.NoError(t, dispatcher.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, dispatcher.Close()) })
+
+	rcv := newReceiver()
+	require.NoError(t, dispatcher.SetReceiver(capID1, donID1, rcv))
+
+	// each chunk takes a token
+	for _, chunk := range splitAndSign(t, encodeAndSign(t, privKey1, peerID1, peerID2, capID1, donID1, []byte(payload1)), 3) {
+		recvCh <- chunk
+	}
+	m := <-rcv.ch
+	require.Equal(t, payload1, string(m.Payload))
+
+	// so there are none left for the next message
+	recvCh <- encodeAndSign(t, privKey1, peerID1, peerID2, capID1, donID1, []byte(payload2))
+	select {
+	case m := <-rcv.ch:
+		t.Fatalf("expected message to be rate limited, got %s", m.Payload)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink-protos/workflows/go/events"
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/aggregation"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/validation"
@@ -32,17 +33,19 @@ type clientResponse struct {
 }
 
 type ClientRequest struct {
-	id                string
-	cancelFn          context.CancelFunc
-	responseCh        chan clientResponse
-	createdAt         time.Time
-	responseIDCount   map[[32]byte]int
-	meteringResponses map[[32]byte][]commoncap.MeteringNodeDetail
-	errorCount        map[string]int
-	totalErrorCount   int
-	responseReceived  map[p2ptypes.PeerID]bool
-	lggr              logger.Logger
-
+	id               string
+	cancelFn         context.CancelFunc
+	responseCh       chan clientResponse
+	createdAt        time.Time
+	responseIDCount  map[[32]byte]int
+	responses        []commoncap.CapabilityResponse
+	responseMetering []*commoncap.MeteringNodeDetail
+	errorCount       map[string]int
+	totalErrorCount  int
+	responseReceived map[p2ptypes.PeerID]bool
+	lggr             logger.Logger
+
+	aggregator                 types.ResponseAggregator
 	requiredIdenticalResponses int
 	remoteNodeCount            int
 
@@ -55,7 +58,7 @@ type ClientRequest struct {
 
 func NewClientExecuteRequest(ctx context.Context, lggr logger.Logger, req commoncap.CapabilityRequest,
 	remoteCapabilityInfo commoncap.CapabilityInfo, localDonInfo commoncap.DON, dispatcher types.Dispatcher,
-	requestTimeout time.Duration) (*ClientRequest, error) {
+	requestTimeout time.Duration, aggregatorConfig aggregation.ResponseAggregatorConfig) (*ClientRequest, error) {
 	rawRequest, err := proto.MarshalOptions{Deterministic: true}.Marshal(pb.CapabilityRequestToProto(req))
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal capability request: %w", err)
@@ -75,8 +78,13 @@ func NewClientExecuteRequest(ctx context.Context, lggr logger.Logger, req common
 		return nil, fmt.Errorf("failed to extract transmission config from request: %w", err)
 	}
 
+	ac, err := aggregatorConfig.WithRequestOverride(req.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract aggregation config from request: %w", err)
+	}
+
 	lggr = lggr.With("requestId", requestID, "capabilityID", remoteCapabilityInfo.ID)
-	return newClientRequest(ctx, lggr, requestID, remoteCapabilityInfo, localDonInfo, dispatcher, requestTimeout, tc, types.MethodExecute, rawRequest, workflowExecutionID, req.Metadata.ReferenceID)
+	return newClientRequest(ctx, lggr, requestID, remoteCapabilityInfo, localDonInfo, dispatcher, requestTimeout, tc, ac, types.MethodExecute, rawRequest, workflowExecutionID, req.Metadata.ReferenceID)
 }
 
 var (
@@ -85,12 +93,17 @@ var (
 
 func newClientRequest(ctx context.Context, lggr logger.Logger, requestID string, remoteCapabilityInfo commoncap.CapabilityInfo,
 	localDonInfo commoncap.DON, dispatcher types.Dispatcher, requestTimeout time.Duration,
-	tc transmission.TransmissionConfig, methodType string, rawRequest []byte, workflowExecutionID string, stepRef string) (*ClientRequest, error) {
+	tc transmission.TransmissionConfig, ac aggregation.ResponseAggregatorConfig, methodType string, rawRequest []byte, workflowExecutionID string, stepRef string) (*ClientRequest, error) {
 	remoteCapabilityDonInfo := remoteCapabilityInfo.DON
 	if remoteCapabilityDonInfo == nil {
 		return nil, errors.New("remote capability info missing DON")
 	}
 
+	aggregator, err := aggregation.NewResponseAggregator(ac, *remoteCapabilityDonInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create response aggregator: %w", err)
+	}
+
 	peerIDToTransmissionDelay, err := transmission.GetPeerIDToTransmissionDelaysForConfig(remoteCapabilityDonInfo.Members, requestID, tc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get peer ID to transmission delay: %w", err)
@@ -183,10 +196,10 @@ func newClientRequest(ctx context.Context, lggr logger.Logger, requestID string,
 		cancelFn:                   cancelFn,
 		createdAt:                  time.Now(),
 		requestTimeout:             requestTimeout,
+		aggregator:                 aggregator,
 		requiredIdenticalResponses: int(remoteCapabilityDonInfo.F + 1),
 		remoteNodeCount:            len(remoteCapabilityDonInfo.Members),
 		responseIDCount:            make(map[[32]byte]int),
-		meteringResponses:          make(map[[32]byte][]commoncap.MeteringNodeDetail),
 		errorCount:                 make(map[string]int),
 		responseReceived:           responseReceived,
 		responseCh:                 make(chan clientResponse, 1),
@@ -294,39 +307,54 @@ func (c *ClientRequest) OnMessage(_ context.Context, msg *types.MessageBody) err
 
 	if msg.Error == types.Error_OK {
 		// metering reports per node are aggregated into a single array of values. for any single node message, the
-		// metering values are extracted from the CapabilityResponse, added to an array, and the CapabilityResponse
-		// is marshalled without the metering value to get the hash. each node could have a different metering value
-		// which would result in different hashes. removing the metering detail allows for direct comparison of results.
-		responseID, metadata, err := c.getMessageHashAndMetadata(msg)
+		// metering values are extracted from the CapabilityResponse, and the CapabilityResponse is aggregated without
+		// the metering value, since each node could have a different metering value. the metering values of the
+		// responses the aggregated response was derived from are then attached to it.
+		resp, metadata, err := c.getResponseAndMetadata(msg)
 		if err != nil {
-			return fmt.Errorf("failed to get message hash: %w", err)
+			return fmt.Errorf("failed to get response: %w", err)
 		}
 
-		lggr := c.lggr.With("responseID", hex.EncodeToString(responseID[:]), "requiredCount", c.requiredIdenticalResponses, "peer", sender)
-
-		nodeReports, exists := c.meteringResponses[responseID]
-		if !exists {
-			nodeReports = make([]commoncap.MeteringNodeDetail, 0)
+		responseID, err := responseHash(resp)
+		if err != nil {
+			return fmt.Errorf("failed to get message hash: %w", err)
 		}
 
+		lggr := c.lggr.With("responseID", hex.EncodeToString(responseID[:]), "requiredCount", c.aggregator.MinResponses(), "peer", sender)
+
+		var rpt *commoncap.MeteringNodeDetail
 		if len(metadata.Metering) == 1 {
-			rpt := metadata.Metering[0]
+			rpt = &metadata.Metering[0]
 			rpt.Peer2PeerID = sender.String()
-
-			nodeReports = append(nodeReports, rpt)
 		} else {
 			lggr.Warnw("node metering detail did not contain exactly 1 record", "records", len(metadata.Metering))
 		}
 
 		c.responseIDCount[responseID]++
-		c.meteringResponses[responseID] = nodeReports
+		c.responses = append(c.responses, resp)
+		c.responseMetering = append(c.responseMetering, rpt)
 
 		if len(c.responseIDCount) > 1 {
 			lggr.Warn("received multiple different responses for the same request, number of different responses received: %d", len(c.responseIDCount))
 		}
 
-		if c.responseIDCount[responseID] == c.requiredIdenticalResponses {
-			payload, err := c.encodePayloadWithMetadata(msg, commoncap.ResponseMetadata{Metering: nodeReports})
+		aggregated, used, err := c.aggregator.Aggregate(c.responses)
+		if err != nil {
+			lggr.Warnw("failed to aggregate responses", "err", err)
+			if len(c.responses)+c.totalErrorCount == c.remoteNodeCount {
+				c.sendResponse(clientResponse{Err: fmt.Errorf("failed to aggregate responses: %w", err)})
+			}
+			return nil
+		}
+		if aggregated != nil {
+			nodeReports := make([]commoncap.MeteringNodeDetail, 0, len(used))
+			for _, i := range used {
+				if c.responseMetering[i] != nil {
+					nodeReports = append(nodeReports, *c.responseMetering[i])
+				}
+			}
+			aggregated.Metadata = commoncap.ResponseMetadata{Metering: nodeReports}
+			payload, err := pb.MarshalCapabilityResponse(*aggregated)
 			if err != nil {
 				return fmt.Errorf("failed to encode payload with metadata: %w", err)
 			}
@@ -344,7 +372,7 @@ func (c *ClientRequest) OnMessage(_ context.Context, msg *types.MessageBody) err
 
 		if c.errorCount[msg.ErrorMsg] == c.requiredIdenticalResponses {
 			c.sendResponse(clientResponse{Err: fmt.Errorf("%s : %s", msg.Error, msg.ErrorMsg)})
-		} else if c.totalErrorCount == c.remoteNodeCount-c.requiredIdenticalResponses+1 {
+		} else if c.totalErrorCount == c.remoteNodeCount-c.aggregator.MinResponses()+1 {
 			c.sendResponse(clientResponse{Err: fmt.Errorf("received %d errors, last error %s : %s", c.totalErrorCount, msg.Error, msg.ErrorMsg)})
 		}
 	}
@@ -359,35 +387,25 @@ func (c *ClientRequest) sendResponse(response clientResponse) {
 		c.lggr.Warnw("received error response", "error", remote.SanitizeLogString(response.Err.Error()))
 		return
 	}
-	c.lggr.Debugw("received OK response", "count", c.requiredIdenticalResponses)
+	c.lggr.Debugw("received OK response", "count", len(c.responses))
 }
 
-func (c *ClientRequest) getMessageHashAndMetadata(msg *types.MessageBody) ([32]byte, commoncap.ResponseMetadata, error) {
-	var metadata commoncap.ResponseMetadata
-
+func (c *ClientRequest) getResponseAndMetadata(msg *types.MessageBody) (commoncap.CapabilityResponse, commoncap.ResponseMetadata, error) {
 	resp, err := pb.UnmarshalCapabilityResponse(msg.Payload)
 	if err != nil {
-		return [32]byte{}, metadata, err
+		return commoncap.CapabilityResponse{}, commoncap.ResponseMetadata{}, err
 	}
 
-	metadata = resp.Metadata
+	metadata := resp.Metadata
 	resp.Metadata = commoncap.ResponseMetadata{}
-
-	payload, err := pb.MarshalCapabilityResponse(resp)
-	if err != nil {
-		return [32]byte{}, metadata, err
-	}
-
-	return sha256.Sum256(payload), metadata, nil
+	return resp, metadata, nil
 }
 
-func (c *ClientRequest) encodePayloadWithMetadata(msg *types.MessageBody, metadata commoncap.ResponseMetadata) ([]byte, error) {
-	resp, err := pb.UnmarshalCapabilityResponse(msg.Payload)
+func responseHash(resp commoncap.CapabilityResponse) ([32]byte, error) {
+	payload, err := pb.MarshalCapabilityResponse(resp)
 	if err != nil {
-		return nil, err
+		return [32]byte{}, err
 	}
 
-	resp.Metadata = metadata
-
-	return pb.MarshalCapabilityResponse(resp)
+	return sha256.Sum256(payload), nil
 }
@@ -20,6 +20,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/aggregation"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/executable/request"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
@@ -85,7 +86,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		req, err := request.NewClientExecuteRequest(ctx, lggr, capabilityRequest, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
 		defer req.Cancel(errors.New("test end"))
 
 		require.NoError(t, err)
@@ -136,7 +137,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		req, err := request.NewClientExecuteRequest(ctx, lggr, capabilityRequest, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
 		require.NoError(t, err)
 		defer req.Cancel(errors.New("test end"))
 
@@ -170,7 +171,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		req, err := request.NewClientExecuteRequest(ctx, lggr, capabilityRequest, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
 		require.NoError(t, err)
 		defer req.Cancel(errors.New("test end"))
 
@@ -201,7 +202,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		req, err := request.NewClientExecuteRequest(ctx, lggr, capabilityRequest, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
 		require.NoError(t, err)
 		defer req.Cancel(errors.New("test end"))
 
@@ -239,7 +240,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		req, err := request.NewClientExecuteRequest(ctx, lggr, capabilityRequest, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
 		require.NoError(t, err)
 		defer req.Cancel(errors.New("test end"))
 
@@ -300,7 +301,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		req, err := request.NewClientExecuteRequest(ctx, lggr, capabilityRequest, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
 		require.NoError(t, err)
 		defer req.Cancel(errors.New("test end"))
 
@@ -357,6 +358,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 			workflowDonInfo,
 			dispatcher,
 			10*time.Minute,
+			aggregation.ResponseAggregatorConfig{},
 		)
 		require.NoError(t, err)
 		defer req.Cancel(errors.New("test end"))
@@ -476,6 +478,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 			workflowDonInfo,
 			dispatcher,
 			10*time.Minute,
+			aggregation.ResponseAggregatorConfig{},
 		)
 		require.NoError(t, err)
 		defer req.Cancel(errors.New("test end"))
@@ -568,7 +571,7 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 
 		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
 		req, err := request.NewClientExecuteRequest(ctx, lggr, capabilityRequest, capInfo,
-			workflowDonInfo, dispatcher, 10*time.Minute)
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
 		require.NoError(t, err)
 		defer req.Cancel(errors.New("test end"))
 
@@ -607,6 +610,121 @@ func Test_ClientRequest_MessageValidation(t *testing.T) {
 	})
 }
 
+func Test_ClientRequest_AggregationModes(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	workflowDonInfo := commoncap.DON{
+		Members: []p2ptypes.PeerID{NewP2PPeerID(t)},
+		ID:      2,
+	}
+
+	newRequest := func(t *testing.T, cfg map[string]any) commoncap.CapabilityRequest {
+		config, err := values.NewMap(cfg)
+		require.NoError(t, err)
+		return commoncap.CapabilityRequest{
+			Metadata: commoncap.RequestMetadata{
+				WorkflowID:          workflowID1,
+				WorkflowExecutionID: workflowExecutionID1,
+				ReferenceID:         stepRef1,
+			},
+			Config: config,
+		}
+	}
+
+	newResponse := func(t *testing.T, price int64) []byte {
+		v, err := values.NewMap(map[string]any{"price": price})
+		require.NoError(t, err)
+		b, err := pb.MarshalCapabilityResponse(commoncap.CapabilityResponse{Value: v})
+		require.NoError(t, err)
+		return b
+	}
+
+	send := func(t *testing.T, req *request.ClientRequest, capDonInfo commoncap.DON, capInfo commoncap.CapabilityInfo, sender p2ptypes.PeerID, payload []byte) {
+		msg := &types.MessageBody{
+			CapabilityId:    capInfo.ID,
+			CapabilityDonId: capDonInfo.ID,
+			CallerDonId:     workflowDonInfo.ID,
+			Method:          types.MethodExecute,
+			Payload:         payload,
+			MessageId:       []byte("messageID"),
+			Sender:          sender[:],
+		}
+		require.NoError(t, req.OnMessage(t.Context(), msg))
+	}
+
+	t.Run("median", func(t *testing.T) {
+		capPeers, capDonInfo, capInfo := capabilityDon(t, 4, 1)
+		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
+		req, err := request.NewClientExecuteRequest(t.Context(), lggr, newRequest(t, map[string]any{}), capInfo,
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{Mode: aggregation.ResponseModeMedian, MedianField: "price"})
+		require.NoError(t, err)
+		defer req.Cancel(errors.New("test end"))
+
+		send(t, req, capDonInfo, capInfo, capPeers[0], newResponse(t, 102))
+		send(t, req, capDonInfo, capInfo, capPeers[1], newResponse(t, 100))
+		select {
+		case <-req.ResponseChan():
+			t.Fatal("expected no response before quorum")
+		default:
+		}
+		send(t, req, capDonInfo, capInfo, capPeers[2], newResponse(t, 101))
+
+		response := <-req.ResponseChan()
+		require.NoError(t, response.Err)
+		capResponse, err := pb.UnmarshalCapabilityResponse(response.Result)
+		require.NoError(t, err)
+		assert.Equal(t, values.NewInt64(101), capResponse.Value.Underlying["price"])
+	})
+
+	t.Run("firstSuccess", func(t *testing.T) {
+		capPeers, capDonInfo, capInfo := capabilityDon(t, 4, 1)
+		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
+		req, err := request.NewClientExecuteRequest(t.Context(), lggr, newRequest(t, map[string]any{"aggregationMode": "firstSuccess"}), capInfo,
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{Mode: aggregation.ResponseModeFirstSuccess})
+		require.NoError(t, err)
+		defer req.Cancel(errors.New("test end"))
+
+		send(t, req, capDonInfo, capInfo, capPeers[0], newResponse(t, 102))
+
+		response := <-req.ResponseChan()
+		require.NoError(t, response.Err)
+		capResponse, err := pb.UnmarshalCapabilityResponse(response.Result)
+		require.NoError(t, err)
+		assert.Equal(t, values.NewInt64(102), capResponse.Value.Underlying["price"])
+	})
+
+	t.Run("rejects unknown mode", func(t *testing.T) {
+		_, _, capInfo := capabilityDon(t, 4, 1)
+		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
+		_, err := request.NewClientExecuteRequest(t.Context(), lggr, newRequest(t, map[string]any{"aggregationMode": "mean"}), capInfo,
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
+		require.ErrorContains(t, err, "unknown aggregation mode")
+	})
+
+	t.Run("rejects a request override to firstSuccess", func(t *testing.T) {
+		_, _, capInfo := capabilityDon(t, 4, 1)
+		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
+		_, err := request.NewClientExecuteRequest(t.Context(), lggr, newRequest(t, map[string]any{"aggregationMode": "firstSuccess"}), capInfo,
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
+		require.ErrorContains(t, err, "cannot be set per request")
+	})
+
+	t.Run("rejects a request override of the quorum below the default quorum", func(t *testing.T) {
+		_, _, capInfo := capabilityDon(t, 4, 1)
+		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
+		_, err := request.NewClientExecuteRequest(t.Context(), lggr, newRequest(t, map[string]any{"aggregationQuorum": 2}), capInfo,
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{Mode: aggregation.ResponseModeMajority})
+		require.ErrorContains(t, err, "it must be at least the default quorum 3")
+	})
+
+	t.Run("rejects a request override weakening the mode", func(t *testing.T) {
+		_, _, capInfo := capabilityDon(t, 4, 1)
+		dispatcher := &clientRequestTestDispatcher{msgs: make(chan *types.MessageBody, 100)}
+		_, err := request.NewClientExecuteRequest(t.Context(), lggr, newRequest(t, map[string]any{"aggregationMode": "median", "aggregationMedianField": "price"}), capInfo,
+			workflowDonInfo, dispatcher, 10*time.Minute, aggregation.ResponseAggregatorConfig{})
+		require.ErrorContains(t, err, "cannot be set per request")
+	})
+}
+
 func capabilityDon(t *testing.T, numCapabilityPeers int, f uint8) ([]p2ptypes.PeerID, commoncap.DON, commoncap.CapabilityInfo) {
 	capabilityPeers := make([]p2ptypes.PeerID, numCapabilityPeers)
 	for i := range numCapabilityPeers {
@@ -0,0 +1,220 @@
+package remote
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+const (
+	// Bodies larger than this are compressed if the receiver supports it
+	compressionThreshold = 4 * 1024
+	// Bodies larger than this are split into chunks if the receiver supports
+	// it. Leaves room for the envelope within the P2P message size limit.
+	maxChunkSize = 400 * 1024
+	// Limit on the size of a body after reassembly or decompression
+	maxMessageSize = 16 * 1024 * 1024
+	maxChunkCount  = (maxMessageSize + maxChunkSize - 1) / maxChunkSize
+	// Partially received chunked messages are dropped after this long
+	chunkReassemblyTimeout = 30 * time.Second
+	// Limit on the size of partially received chunked messages buffered per sender
+	maxBufferedChunkBytesPerSender = 2 * maxMessageSize
+)
+
+// supportedFeatures is advertised to peers in every message. Peers only
+// compress messages to us after seeing it, and we only do so for peers that
+// advertised it, so nodes without support keep receiving plain messages.
+// Messages too large for a single P2P message are chunked even before the
+// peer's features are known, as they could not be delivered otherwise.
+var supportedFeatures = uint32(types.Feature_FEATURE_COMPRESSION) | uint32(types.Feature_FEATURE_CHUNKING)
+
+func hasFeature(features uint32, f types.Feature) bool {
+	return features&uint32(f) != 0
+}
+
+func compressBody(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompressBody(c types.Compression, b []byte) ([]byte, error) {
+	switch c {
+	case types.Compression_COMPRESSION_NONE:
+		return b, nil
+	case types.Compression_COMPRESSION_GZIP:
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress message body: %w", err)
+		}
+		defer r.Close()
+		out, err := io.ReadAll(io.LimitReader(r, maxMessageSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress message body: %w", err)
+		}
+		if len(out) > maxMessageSize {
+			return nil, fmt.Errorf("decompressed message body exceeds limit of %d bytes", maxMessageSize)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %s", c)
+	}
+}
+
+// splitIntoChunks splits the body of msg into messages of at most
+// maxChunkSize bytes each, all carrying the signature of the complete body
+func splitIntoChunks(msg *types.Message) []*types.Message {
+	digest := sha256.Sum256(msg.Body)
+	count := (len(msg.Body) + maxChunkSize - 1) / maxChunkSize
+	chunks := make([]*types.Message, 0, count)
+	for i := 0; i < count; i++ {
+		end := min((i+1)*maxChunkSize, len(msg.Body))
+		chunks = append(chunks, &types.Message{
+			Signature:   msg.Signature,
+			Body:        msg.Body[i*maxChunkSize : end],
+			Compression: msg.Compression,
+			Chunk: &types.Chunk{
+				Digest: digest[:],
+				//nolint:gosec // disable G115, bounded by maxChunkCount
+				Index: uint32(i),
+				//nolint:gosec // disable G115, bounded by maxChunkCount
+				Count: uint32(count),
+				//nolint:gosec // disable G115, bounded by maxMessageSize
+				TotalSize: uint32(len(msg.Body)),
+			},
+		})
+	}
+	return chunks
+}
+
+type chunkKey struct {
+	sender p2ptypes.PeerID
+	digest [sha256.Size]byte
+}
+
+type partialMessage struct {
+	signature   []byte
+	compression types.Compression
+	totalSize   uint32
+	chunks      [][]byte
+	received    int
+	size        int
+	firstSeen   time.Time
+}
+
+// chunkReassembler collects the chunks of chunked messages until they are
+// complete. It is not safe for concurrent use.
+type chunkReassembler struct {
+	partial  map[chunkKey]*partialMessage
+	buffered map[p2ptypes.PeerID]int
+	now      func() time.Time
+}
+
+func newChunkReassembler() *chunkReassembler {
+	return &chunkReassembler{
+		partial:  make(map[chunkKey]*partialMessage),
+		buffered: make(map[p2ptypes.PeerID]int),
+		now:      time.Now,
+	}
+}
+
+// Add adds a chunk received from sender and returns the reassembled message
+// once all of its chunks have been received, or nil if more are needed. The
+// signature of the reassembled message still needs to be validated.
+func (r *chunkReassembler) Add(sender p2ptypes.PeerID, msg *types.Message) (*types.Message, error) {
+	r.expire()
+
+	c := msg.Chunk
+	if len(c.Digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid chunk digest length %d", len(c.Digest))
+	}
+	if c.Count == 0 || c.Count > maxChunkCount || c.Index >= c.Count {
+		return nil, fmt.Errorf("invalid chunk %d of %d", c.Index, c.Count)
+	}
+	if c.TotalSize > maxMessageSize {
+		return nil, fmt.Errorf("chunked message of %d bytes exceeds limit of %d bytes", c.TotalSize, maxMessageSize)
+	}
+	if len(msg.Body) == 0 || len(msg.Body) > maxChunkSize {
+		return nil, fmt.Errorf("invalid chunk size %d", len(msg.Body))
+	}
+
+	k := chunkKey{sender: sender, digest: [sha256.Size]byte(c.Digest)}
+	p, ok := r.partial[k]
+	if !ok {
+		p = &partialMessage{
+			signature:   msg.Signature,
+			compression: msg.Compression,
+			totalSize:   c.TotalSize,
+			chunks:      make([][]byte, c.Count),
+			firstSeen:   r.now(),
+		}
+		r.partial[k] = p
+	}
+	if int(c.Count) != len(p.chunks) || c.TotalSize != p.totalSize || msg.Compression != p.compression || !bytes.Equal(msg.Signature, p.signature) {
+		r.drop(k)
+		return nil, errors.New("chunk does not match previously received chunks of the same message")
+	}
+	if p.chunks[c.Index] != nil {
+		return nil, fmt.Errorf("duplicate chunk %d", c.Index)
+	}
+	if p.size+len(msg.Body) > int(p.totalSize) {
+		r.drop(k)
+		return nil, errors.New("chunks exceed total size of message")
+	}
+	if r.buffered[sender]+len(msg.Body) > maxBufferedChunkBytesPerSender {
+		r.drop(k)
+		return nil, fmt.Errorf("too many partially received messages from sender %s", sender)
+	}
+
+	p.chunks[c.Index] = msg.Body
+	p.received++
+	p.size += len(msg.Body)
+	r.buffered[sender] += len(msg.Body)
+	if p.received < len(p.chunks) {
+		return nil, nil
+	}
+
+	r.drop(k)
+	body := bytes.Join(p.chunks, nil)
+	if len(body) != int(p.totalSize) {
+		return nil, fmt.Errorf("reassembled message has %d bytes, expected %d", len(body), p.totalSize)
+	}
+	if sha256.Sum256(body) != k.digest {
+		return nil, errors.New("reassembled message does not match digest")
+	}
+	return &types.Message{Signature: p.signature, Body: body, Compression: p.compression}, nil
+}
+
+func (r *chunkReassembler) drop(k chunkKey) {
+	p, ok := r.partial[k]
+	if !ok {
+		return
+	}
+	delete(r.partial, k)
+	r.buffered[k.sender] -= p.size
+	if r.buffered[k.sender] <= 0 {
+		delete(r.buffered, k.sender)
+	}
+}
+
+func (r *chunkReassembler) expire() {
+	now := r.now()
+	for k, p := range r.partial {
+		if now.Sub(p.firstSeen) > chunkReassemblyTimeout {
+			r.drop(k)
+		}
+	}
+}
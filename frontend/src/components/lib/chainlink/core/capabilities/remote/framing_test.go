@@ -0,0 +1,131 @@
+package remote
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+func randomBytes(t *testing.T, n int) []byte {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func TestCompression(t *testing.T) {
+	body := bytes.Repeat([]byte("payload"), 10000)
+	compressed, err := compressBody(body)
+	require.NoError(t, err)
+	assert.Less(t, len(compressed), len(body))
+
+	decompressed, err := decompressBody(types.Compression_COMPRESSION_GZIP, compressed)
+	require.NoError(t, err)
+	assert.Equal(t, body, decompressed)
+
+	t.Run("rejects bodies exceeding the size limit once decompressed", func(t *testing.T) {
+		bomb, err := compressBody(make([]byte, maxMessageSize+1))
+		require.NoError(t, err)
+		_, err = decompressBody(types.Compression_COMPRESSION_GZIP, bomb)
+		require.ErrorContains(t, err, "exceeds limit")
+	})
+
+	t.Run("rejects unknown compression", func(t *testing.T) {
+		_, err := decompressBody(types.Compression(42), compressed)
+		require.ErrorContains(t, err, "unsupported compression")
+	})
+}
+
+func TestChunkReassembler(t *testing.T) {
+	var sender p2ptypes.PeerID
+	copy(sender[:], randomBytes(t, len(sender)))
+	msg := &types.Message{
+		Signature: []byte("signature"),
+		Body:      randomBytes(t, 2*maxChunkSize+100),
+	}
+
+	t.Run("reassembles chunks in any order", func(t *testing.T) {
+		chunks := splitIntoChunks(msg)
+		require.Len(t, chunks, 3)
+
+		r := newChunkReassembler()
+		for _, i := range []int{2, 0} {
+			complete, err := r.Add(sender, chunks[i])
+			require.NoError(t, err)
+			require.Nil(t, complete)
+		}
+		complete, err := r.Add(sender, chunks[1])
+		require.NoError(t, err)
+		require.NotNil(t, complete)
+		assert.Equal(t, msg.Body, complete.Body)
+		assert.Equal(t, msg.Signature, complete.Signature)
+		assert.Nil(t, complete.Chunk)
+		assert.Empty(t, r.partial)
+		assert.Empty(t, r.buffered)
+	})
+
+	t.Run("rejects tampered chunks", func(t *testing.T) {
+		chunks := splitIntoChunks(msg)
+		chunks[1].Body = bytes.Clone(chunks[1].Body)
+		chunks[1].Body[0] ^= 0xff
+
+		r := newChunkReassembler()
+		var err error
+		for _, c := range chunks {
+			_, err = r.Add(sender, c)
+		}
+		require.ErrorContains(t, err, "does not match digest")
+	})
+
+	t.Run("rejects duplicate and inconsistent chunks", func(t *testing.T) {
+		chunks := splitIntoChunks(msg)
+		r := newChunkReassembler()
+		_, err := r.Add(sender, chunks[0])
+		require.NoError(t, err)
+		_, err = r.Add(sender, chunks[0])
+		require.ErrorContains(t, err, "duplicate chunk")
+
+		inconsistent := *chunks[1]
+		inconsistent.Chunk = &types.Chunk{Digest: chunks[1].Chunk.Digest, Index: 1, Count: 4, TotalSize: chunks[1].Chunk.TotalSize}
+		_, err = r.Add(sender, &inconsistent)
+		require.ErrorContains(t, err, "does not match previously received chunks")
+		assert.Empty(t, r.partial)
+	})
+
+	t.Run("rejects messages exceeding limits", func(t *testing.T) {
+		r := newChunkReassembler()
+		c := splitIntoChunks(msg)[0]
+		c.Chunk.TotalSize = maxMessageSize + 1
+		_, err := r.Add(sender, c)
+		require.ErrorContains(t, err, "exceeds limit")
+
+		c = splitIntoChunks(msg)[0]
+		c.Chunk.Count = maxChunkCount + 1
+		_, err = r.Add(sender, c)
+		require.ErrorContains(t, err, "invalid chunk")
+	})
+
+	t.Run("expires partial messages", func(t *testing.T) {
+		chunks := splitIntoChunks(msg)
+		now := time.Now()
+		r := newChunkReassembler()
+		r.now = func() time.Time { return now }
+		_, err := r.Add(sender, chunks[0])
+		require.NoError(t, err)
+		require.Len(t, r.partial, 1)
+
+		now = now.Add(chunkReassemblyTimeout + time.Second)
+		complete, err := r.Add(sender, chunks[1])
+		require.NoError(t, err)
+		assert.Nil(t, complete)
+		// only the chunk received after expiry is buffered
+		assert.Equal(t, len(chunks[1].Body), r.buffered[sender])
+	})
+}
@@ -79,10 +79,110 @@ func (Error) EnumDescriptor() ([]byte, []int) {
 	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{0}
 }
 
+type Compression int32
+
+const (
+	Compression_COMPRESSION_NONE Compression = 0
+	Compression_COMPRESSION_GZIP Compression = 1
+)
+
+// Enum value maps for Compression.
+var (
+	Compression_name = map[int32]string{
+		0: "COMPRESSION_NONE",
+		1: "COMPRESSION_GZIP",
+	}
+	Compression_value = map[string]int32{
+		"COMPRESSION_NONE": 0,
+		"COMPRESSION_GZIP": 1,
+	}
+)
+
+func (x Compression) Enum() *Compression {
+	p := new(Compression)
+	*p = x
+	return p
+}
+
+func (x Compression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Compression) Descriptor() protoreflect.EnumDescriptor {
+	return file_core_capabilities_remote_types_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (Compression) Type() protoreflect.EnumType {
+	return &file_core_capabilities_remote_types_messages_proto_enumTypes[1]
+}
+
+func (x Compression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Compression.Descriptor instead.
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{1}
+}
+
+// Feature values are bit flags advertised in MessageBody.features
+type Feature int32
+
+const (
+	Feature_FEATURE_NONE        Feature = 0
+	Feature_FEATURE_COMPRESSION Feature = 1
+	Feature_FEATURE_CHUNKING    Feature = 2
+)
+
+// Enum value maps for Feature.
+var (
+	Feature_name = map[int32]string{
+		0: "FEATURE_NONE",
+		1: "FEATURE_COMPRESSION",
+		2: "FEATURE_CHUNKING",
+	}
+	Feature_value = map[string]int32{
+		"FEATURE_NONE":        0,
+		"FEATURE_COMPRESSION": 1,
+		"FEATURE_CHUNKING":    2,
+	}
+)
+
+func (x Feature) Enum() *Feature {
+	p := new(Feature)
+	*p = x
+	return p
+}
+
+func (x Feature) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Feature) Descriptor() protoreflect.EnumDescriptor {
+	return file_core_capabilities_remote_types_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (Feature) Type() protoreflect.EnumType {
+	return &file_core_capabilities_remote_types_messages_proto_enumTypes[2]
+}
+
+func (x Feature) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Feature.Descriptor instead.
+func (Feature) EnumDescriptor() ([]byte, []int) {
+	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{2}
+}
+
 type Message struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Signature     []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Body          []byte                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"` // proto-encoded MessageBody to sign
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Signature []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Body      []byte                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"` // proto-encoded MessageBody to sign
+	// compression applied to the MessageBody before signing
+	Compression Compression `protobuf:"varint,3,opt,name=compression,proto3,enum=remote.Compression" json:"compression,omitempty"`
+	// set if body only holds one chunk of the signed body
+	Chunk         *Chunk `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +231,90 @@ func (x *Message) GetBody() []byte {
 	return nil
 }
 
+func (x *Message) GetCompression() Compression {
+	if x != nil {
+		return x.Compression
+	}
+	return Compression_COMPRESSION_NONE
+}
+
+func (x *Message) GetChunk() *Chunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type Chunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sha256 of the complete body, identifying the chunked message and
+	// checking its integrity once reassembled
+	Digest        []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	Index         uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Count         uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	TotalSize     uint32 `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chunk) Reset() {
+	*x = Chunk{}
+	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chunk) ProtoMessage() {}
+
+func (x *Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chunk.ProtoReflect.Descriptor instead.
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Chunk) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *Chunk) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Chunk) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Chunk) GetTotalSize() uint32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
 type MessageBody struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	Version      uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -151,13 +335,15 @@ type MessageBody struct {
 	Metadata        isMessageBody_Metadata `protobuf_oneof:"metadata"`
 	CapabilityDonId uint32                 `protobuf:"varint,15,opt,name=capability_don_id,json=capabilityDonId,proto3" json:"capability_don_id,omitempty"`
 	CallerDonId     uint32                 `protobuf:"varint,16,opt,name=caller_don_id,json=callerDonId,proto3" json:"caller_don_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// bitmask of Feature values supported by the sender
+	Features      uint32 `protobuf:"varint,17,opt,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageBody) Reset() {
 	*x = MessageBody{}
-	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[1]
+	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -169,7 +355,7 @@ func (x *MessageBody) String() string {
 func (*MessageBody) ProtoMessage() {}
 
 func (x *MessageBody) ProtoReflect() protoreflect.Message {
-	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[1]
+	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -182,7 +368,7 @@ func (x *MessageBody) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageBody.ProtoReflect.Descriptor instead.
 func (*MessageBody) Descriptor() ([]byte, []int) {
-	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{1}
+	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MessageBody) GetVersion() uint32 {
@@ -294,6 +480,13 @@ func (x *MessageBody) GetCallerDonId() uint32 {
 	return 0
 }
 
+func (x *MessageBody) GetFeatures() uint32 {
+	if x != nil {
+		return x.Features
+	}
+	return 0
+}
+
 type isMessageBody_Metadata interface {
 	isMessageBody_Metadata()
 }
@@ -319,7 +512,7 @@ type TriggerRegistrationMetadata struct {
 
 func (x *TriggerRegistrationMetadata) Reset() {
 	*x = TriggerRegistrationMetadata{}
-	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[2]
+	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -331,7 +524,7 @@ func (x *TriggerRegistrationMetadata) String() string {
 func (*TriggerRegistrationMetadata) ProtoMessage() {}
 
 func (x *TriggerRegistrationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[2]
+	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +537,7 @@ func (x *TriggerRegistrationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerRegistrationMetadata.ProtoReflect.Descriptor instead.
 func (*TriggerRegistrationMetadata) Descriptor() ([]byte, []int) {
-	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{2}
+	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TriggerRegistrationMetadata) GetLastReceivedEventId() string {
@@ -364,7 +557,7 @@ type TriggerEventMetadata struct {
 
 func (x *TriggerEventMetadata) Reset() {
 	*x = TriggerEventMetadata{}
-	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[3]
+	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -376,7 +569,7 @@ func (x *TriggerEventMetadata) String() string {
 func (*TriggerEventMetadata) ProtoMessage() {}
 
 func (x *TriggerEventMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[3]
+	mi := &file_core_capabilities_remote_types_messages_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +582,7 @@ func (x *TriggerEventMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerEventMetadata.ProtoReflect.Descriptor instead.
 func (*TriggerEventMetadata) Descriptor() ([]byte, []int) {
-	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{3}
+	return file_core_capabilities_remote_types_messages_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TriggerEventMetadata) GetTriggerEventId() string {
@@ -410,10 +603,18 @@ var File_core_capabilities_remote_types_messages_proto protoreflect.FileDescript
 
 const file_core_capabilities_remote_types_messages_proto_rawDesc = "" +
 	"\n" +
-	"-core/capabilities/remote/types/messages.proto\x12\x06remote\";\n" +
+	"-core/capabilities/remote/types/messages.proto\x12\x06remote\"\x97\x01\n" +
 	"\aMessage\x12\x1c\n" +
 	"\tsignature\x18\x01 \x01(\fR\tsignature\x12\x12\n" +
-	"\x04body\x18\x02 \x01(\fR\x04body\"\xd9\x04\n" +
+	"\x04body\x18\x02 \x01(\fR\x04body\x125\n" +
+	"\vcompression\x18\x03 \x01(\x0e2\x13.remote.CompressionR\vcompression\x12#\n" +
+	"\x05chunk\x18\x04 \x01(\v2\r.remote.ChunkR\x05chunk\"j\n" +
+	"\x05Chunk\x12\x16\n" +
+	"\x06digest\x18\x01 \x01(\fR\x06digest\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\rR\x05count\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x04 \x01(\rR\ttotalSize\"\xf5\x04\n" +
 	"\vMessageBody\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\rR\aversion\x12\x16\n" +
 	"\x06sender\x18\x02 \x01(\fR\x06sender\x12\x1a\n" +
@@ -430,7 +631,8 @@ const file_core_capabilities_remote_types_messages_proto_rawDesc = "" +
 	"\x1dtrigger_registration_metadata\x18\r \x01(\v2#.remote.TriggerRegistrationMetadataH\x00R\x1btriggerRegistrationMetadata\x12T\n" +
 	"\x16trigger_event_metadata\x18\x0e \x01(\v2\x1c.remote.TriggerEventMetadataH\x00R\x14triggerEventMetadata\x12*\n" +
 	"\x11capability_don_id\x18\x0f \x01(\rR\x0fcapabilityDonId\x12\"\n" +
-	"\rcaller_don_id\x18\x10 \x01(\rR\vcallerDonIdB\n" +
+	"\rcaller_don_id\x18\x10 \x01(\rR\vcallerDonId\x12\x1a\n" +
+	"\bfeatures\x18\x11 \x01(\rR\bfeaturesB\n" +
 	"\n" +
 	"\bmetadataJ\x04\b\a\x10\bJ\x04\b\b\x10\t\"R\n" +
 	"\x1bTriggerRegistrationMetadata\x123\n" +
@@ -444,7 +646,14 @@ const file_core_capabilities_remote_types_messages_proto_rawDesc = "" +
 	"\x14CAPABILITY_NOT_FOUND\x10\x02\x12\x13\n" +
 	"\x0fINVALID_REQUEST\x10\x03\x12\v\n" +
 	"\aTIMEOUT\x10\x04\x12\x12\n" +
-	"\x0eINTERNAL_ERROR\x10\x05B Z\x1ecore/capabilities/remote/typesb\x06proto3"
+	"\x0eINTERNAL_ERROR\x10\x05*9\n" +
+	"\vCompression\x12\x14\n" +
+	"\x10COMPRESSION_NONE\x10\x00\x12\x14\n" +
+	"\x10COMPRESSION_GZIP\x10\x01*J\n" +
+	"\aFeature\x12\x10\n" +
+	"\fFEATURE_NONE\x10\x00\x12\x17\n" +
+	"\x13FEATURE_COMPRESSION\x10\x01\x12\x14\n" +
+	"\x10FEATURE_CHUNKING\x10\x02B Z\x1ecore/capabilities/remote/typesb\x06proto3"
 
 var (
 	file_core_capabilities_remote_types_messages_proto_rawDescOnce sync.Once
@@ -458,24 +667,29 @@ func file_core_capabilities_remote_types_messages_proto_rawDescGZIP() []byte {
 	return file_core_capabilities_remote_types_messages_proto_rawDescData
 }
 
-var file_core_capabilities_remote_types_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_core_capabilities_remote_types_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_core_capabilities_remote_types_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_core_capabilities_remote_types_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_core_capabilities_remote_types_messages_proto_goTypes = []any{
 	(Error)(0),                          // 0: remote.Error
-	(*Message)(nil),                     // 1: remote.Message
-	(*MessageBody)(nil),                 // 2: remote.MessageBody
-	(*TriggerRegistrationMetadata)(nil), // 3: remote.TriggerRegistrationMetadata
-	(*TriggerEventMetadata)(nil),        // 4: remote.TriggerEventMetadata
+	(Compression)(0),                    // 1: remote.Compression
+	(Feature)(0),                        // 2: remote.Feature
+	(*Message)(nil),                     // 3: remote.Message
+	(*Chunk)(nil),                       // 4: remote.Chunk
+	(*MessageBody)(nil),                 // 5: remote.MessageBody
+	(*TriggerRegistrationMetadata)(nil), // 6: remote.TriggerRegistrationMetadata
+	(*TriggerEventMetadata)(nil),        // 7: remote.TriggerEventMetadata
 }
 var file_core_capabilities_remote_types_messages_proto_depIdxs = []int32{
-	0, // 0: remote.MessageBody.error:type_name -> remote.Error
-	3, // 1: remote.MessageBody.trigger_registration_metadata:type_name -> remote.TriggerRegistrationMetadata
-	4, // 2: remote.MessageBody.trigger_event_metadata:type_name -> remote.TriggerEventMetadata
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1, // 0: remote.Message.compression:type_name -> remote.Compression
+	4, // 1: remote.Message.chunk:type_name -> remote.Chunk
+	0, // 2: remote.MessageBody.error:type_name -> remote.Error
+	6, // 3: remote.MessageBody.trigger_registration_metadata:type_name -> remote.TriggerRegistrationMetadata
+	7, // 4: remote.MessageBody.trigger_event_metadata:type_name -> remote.TriggerEventMetadata
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_core_capabilities_remote_types_messages_proto_init() }
@@ -483,7 +697,7 @@ func file_core_capabilities_remote_types_messages_proto_init() {
 	if File_core_capabilities_remote_types_messages_proto != nil {
 		return
 	}
-	file_core_capabilities_remote_types_messages_proto_msgTypes[1].OneofWrappers = []any{
+	file_core_capabilities_remote_types_messages_proto_msgTypes[2].OneofWrappers = []any{
 		(*MessageBody_TriggerRegistrationMetadata)(nil),
 		(*MessageBody_TriggerEventMetadata)(nil),
 	}
@@ -492,8 +706,8 @@ func file_core_capabilities_remote_types_messages_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_core_capabilities_remote_types_messages_proto_rawDesc), len(file_core_capabilities_remote_types_messages_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
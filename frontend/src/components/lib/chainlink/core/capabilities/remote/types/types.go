@@ -39,6 +39,19 @@ type Aggregator interface {
 	Aggregate(eventID string, responses [][]byte) (commoncap.TriggerResponse, error)
 }
 
+// ResponseAggregator aggregates the successful responses received by a
+// remote executable client from the nodes of the capability DON.
+type ResponseAggregator interface {
+	// Aggregate is called with all successful responses received so far, in
+	// order of arrival, with their metadata removed. It returns the aggregated
+	// response and the indexes of the responses it was derived from, or a nil
+	// response if more responses are needed.
+	Aggregate(responses []commoncap.CapabilityResponse) (*commoncap.CapabilityResponse, []int, error)
+	// MinResponses is the smallest number of successful responses that can
+	// be aggregated
+	MinResponses() int
+}
+
 // NOTE: this type will become part of the Registry (KS-108)
 type DON struct {
 	ID      string
@@ -24,8 +24,27 @@ func ValidateMessage(msg p2ptypes.Message, expectedReceiver p2ptypes.PeerID) (*r
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal message, err: %w", err)
 	}
+	if topLevelMessage.Chunk != nil {
+		return nil, errors.New("chunked messages must be reassembled before validation")
+	}
+	return validateTopLevelMessage(&topLevelMessage, msg.Sender, expectedReceiver)
+}
+
+func validateTopLevelMessage(topLevelMessage *remotetypes.Message, sender p2ptypes.PeerID, expectedReceiver p2ptypes.PeerID) (*remotetypes.MessageBody, error) {
+	rawBody := topLevelMessage.Body
+	if topLevelMessage.Compression != remotetypes.Compression_COMPRESSION_NONE {
+		// only decompress bodies signed by the p2p sender
+		if !ed25519.Verify(sender[:], topLevelMessage.Body, topLevelMessage.Signature) {
+			return nil, errors.New("failed to verify message signature")
+		}
+		var err error
+		rawBody, err = decompressBody(topLevelMessage.Compression, topLevelMessage.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
 	var body remotetypes.MessageBody
-	err = proto.Unmarshal(topLevelMessage.Body, &body)
+	err := proto.Unmarshal(rawBody, &body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal message body, err: %w", err)
 	}
@@ -36,7 +55,7 @@ func ValidateMessage(msg p2ptypes.Message, expectedReceiver p2ptypes.PeerID) (*r
 		return &body, errors.New("failed to verify message signature")
 	}
 	// NOTE we currently don't support relaying messages so the p2p message sender needs to be the message author
-	if !bytes.Equal(body.Sender, msg.Sender[:]) {
+	if !bytes.Equal(body.Sender, sender[:]) {
 		return &body, errors.New("sender in message body does not match sender of p2p message")
 	}
 	if !bytes.Equal(body.Receiver, expectedReceiver[:]) {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -43,7 +44,10 @@ type WriteTarget struct {
 	emitter custmsg.MessageEmitter
 	lggr    logger.Logger
 
-	bound bool
+	// bindMu guards bound, as Execute and IsTransmitted can be called
+	// concurrently for staged transmissions
+	bindMu sync.Mutex
+	bound  bool
 }
 
 const (
@@ -227,16 +231,8 @@ func evaluate(rawRequest capabilities.CapabilityRequest) (r Request, err error)
 }
 
 func (cap *WriteTarget) Execute(ctx context.Context, rawRequest capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
-	// Bind to the contract address on the write path.
-	// Bind() requires a connection to the node's RPCs and
-	// cannot be run during initialization.
-	if !cap.bound {
-		cap.lggr.Debugw("Binding to forwarder address")
-		err := cap.cr.Bind(ctx, []commontypes.BoundContract{cap.binding})
-		if err != nil {
-			return capabilities.CapabilityResponse{}, err
-		}
-		cap.bound = true
+	if err := cap.bind(ctx); err != nil {
+		return capabilities.CapabilityResponse{}, err
 	}
 
 	cap.lggr.Debugw("Execute", "rawRequest", rawRequest)
@@ -398,6 +394,53 @@ func (cap *WriteTarget) Execute(ctx context.Context, rawRequest capabilities.Cap
 	}
 }
 
+// IsTransmitted reports whether the forwarder has already recorded a final
+// transmission for the request, in which case Execute would be a no-op.
+func (cap *WriteTarget) IsTransmitted(ctx context.Context, rawRequest capabilities.CapabilityRequest) (bool, error) {
+	if err := cap.bind(ctx); err != nil {
+		return false, err
+	}
+
+	request, err := evaluate(rawRequest)
+	if err != nil {
+		return false, err
+	}
+
+	rawExecutionID, err := hex.DecodeString(request.Metadata.WorkflowExecutionID)
+	if err != nil {
+		return false, err
+	}
+
+	transmissionInfo, err := cap.getTransmissionInfo(ctx, request, rawExecutionID)
+	if err != nil {
+		return false, err
+	}
+
+	switch transmissionInfo.State {
+	case TransmissionStateSucceeded, TransmissionStateInvalidReceiver:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// Bind to the contract address on the write path.
+// Bind() requires a connection to the node's RPCs and
+// cannot be run during initialization.
+func (cap *WriteTarget) bind(ctx context.Context) error {
+	cap.bindMu.Lock()
+	defer cap.bindMu.Unlock()
+	if cap.bound {
+		return nil
+	}
+	cap.lggr.Debugw("Binding to forwarder address")
+	if err := cap.cr.Bind(ctx, []commontypes.BoundContract{cap.binding}); err != nil {
+		return err
+	}
+	cap.bound = true
+	return nil
+}
+
 func (cap *WriteTarget) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
 	return nil
 }
@@ -5,9 +5,11 @@ import (
 	"encoding/hex"
 	"errors"
 	"math/big"
+	"sync"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -514,3 +516,68 @@ func TestWriteTarget_UnconfirmedTransaction(t *testing.T) {
 		require.NotNil(t, response)
 	})
 }
+
+func TestWriteTarget_IsTransmitted(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       uint8
+		transmitted bool
+	}{
+		{"not attempted", targets.TransmissionStateNotAttempted, false},
+		{"succeeded", targets.TransmissionStateSucceeded, true},
+		{"invalid receiver", targets.TransmissionStateInvalidReceiver, true},
+		{"failed", targets.TransmissionStateFailed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := setup(t)
+			th.cr.On("GetLatestValue", mock.Anything, th.binding.ReadIdentifier("getTransmissionInfo"), mock.Anything, mock.Anything, mock.Anything).Once().Return(nil).Run(func(args mock.Arguments) {
+				transmissionInfo := args.Get(4).(*targets.TransmissionInfo)
+				*transmissionInfo = targets.TransmissionInfo{
+					GasLimit:    big.NewInt(0),
+					State:       tt.state,
+					Transmitter: common.HexToAddress("0x0"),
+				}
+			})
+			req := capabilities.CapabilityRequest{
+				Metadata: th.validMetadata,
+				Config:   th.config,
+				Inputs:   th.validInputs,
+			}
+
+			transmitted, err := th.writeTarget.IsTransmitted(testutils.Context(t), req)
+			require.NoError(t, err)
+			require.Equal(t, tt.transmitted, transmitted)
+		})
+	}
+}
+
+func TestWriteTarget_BindsOnceWhenCalledConcurrently(t *testing.T) {
+	th := setup(t)
+	th.cr.On("GetLatestValue", mock.Anything, th.binding.ReadIdentifier("getTransmissionInfo"), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		transmissionInfo := args.Get(4).(*targets.TransmissionInfo)
+		*transmissionInfo = targets.TransmissionInfo{
+			GasLimit:    big.NewInt(0),
+			State:       targets.TransmissionStateSucceeded,
+			Transmitter: common.HexToAddress("0x0"),
+		}
+	})
+	req := capabilities.CapabilityRequest{
+		Metadata: th.validMetadata,
+		Config:   th.config,
+		Inputs:   th.validInputs,
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := th.writeTarget.IsTransmitted(testutils.Context(t), req)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	th.cr.AssertNumberOfCalls(t, "Bind", 1)
+}
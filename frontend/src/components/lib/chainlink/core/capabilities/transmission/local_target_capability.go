@@ -9,21 +9,34 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
+// How often a delayed transmission checks whether the request has already
+// been transmitted, when SkipIfTransmitted is set
+const defaultTransmittedCheckInterval = time.Second
+
+// TransmissionStateChecker is implemented by target capabilities that can
+// report whether a request has already been transmitted by another node,
+// e.g. by reading on-chain state.
+type TransmissionStateChecker interface {
+	IsTransmitted(ctx context.Context, req capabilities.CapabilityRequest) (bool, error)
+}
+
 // LocalTargetCapability handles the transmission protocol required for a target capability that exists in the same don as
 // the caller.
 type LocalTargetCapability struct {
 	lggr logger.Logger
 	capabilities.TargetCapability
-	localNode    capabilities.Node
-	capabilityID string
+	localNode                capabilities.Node
+	capabilityID             string
+	transmittedCheckInterval time.Duration
 }
 
 func NewLocalTargetCapability(lggr logger.Logger, capabilityID string, localDON capabilities.Node, underlying capabilities.TargetCapability) *LocalTargetCapability {
 	return &LocalTargetCapability{
-		TargetCapability: underlying,
-		capabilityID:     capabilityID,
-		lggr:             lggr,
-		localNode:        localDON,
+		TargetCapability:         underlying,
+		capabilityID:             capabilityID,
+		lggr:                     lggr,
+		localNode:                localDON,
+		transmittedCheckInterval: defaultTransmittedCheckInterval,
 	}
 }
 
@@ -38,6 +51,11 @@ func (l *LocalTargetCapability) Execute(ctx context.Context, req capabilities.Ca
 		return l.TargetCapability.Execute(ctx, req)
 	}
 
+	tc, err := ExtractTransmissionConfig(req.Config)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("capability id: %s failed to extract transmission config from request: %w", l.capabilityID, err)
+	}
+
 	peerIDToTransmissionDelay, err := GetPeerIDToTransmissionDelay(l.localNode.WorkflowDON.Members, req)
 	if err != nil {
 		return capabilities.CapabilityResponse{}, fmt.Errorf("capability id: %s failed to get peer ID to transmission delay map: %w", l.capabilityID, err)
@@ -48,10 +66,37 @@ func (l *LocalTargetCapability) Execute(ctx context.Context, req capabilities.Ca
 		return capabilities.CapabilityResponse{}, nil
 	}
 
-	select {
-	case <-ctx.Done():
-		return capabilities.CapabilityResponse{}, ctx.Err()
-	case <-time.After(delay):
-		return l.TargetCapability.Execute(ctx, req)
+	checker, canCheck := l.TargetCapability.(TransmissionStateChecker)
+	if !tc.SkipIfTransmitted || !canCheck || delay == 0 {
+		select {
+		case <-ctx.Done():
+			return capabilities.CapabilityResponse{}, ctx.Err()
+		case <-time.After(delay):
+			return l.TargetCapability.Execute(ctx, req)
+		}
+	}
+
+	deadline := time.NewTimer(delay)
+	defer deadline.Stop()
+	ticker := time.NewTicker(l.transmittedCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return capabilities.CapabilityResponse{}, ctx.Err()
+		case <-deadline.C:
+			return l.TargetCapability.Execute(ctx, req)
+		case <-ticker.C:
+			transmitted, err := checker.IsTransmitted(ctx, req)
+			if err != nil {
+				// keep waiting; the underlying capability will check again on execution
+				l.lggr.Warnw("failed to check transmission state", "capabilityID", l.capabilityID, "err", err)
+				continue
+			}
+			if transmitted {
+				l.lggr.Debugw("request already transmitted, skipping remaining delay", "capabilityID", l.capabilityID, "executionID", req.Metadata.WorkflowExecutionID)
+				return l.TargetCapability.Execute(ctx, req)
+			}
+		}
 	}
 }
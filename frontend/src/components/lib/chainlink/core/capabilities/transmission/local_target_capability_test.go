@@ -3,6 +3,7 @@ package transmission
 import (
 	"context"
 	"crypto/rand"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -191,3 +192,88 @@ func (m *mockCapability) RegisterToWorkflow(ctx context.Context, request capabil
 func (m *mockCapability) UnregisterFromWorkflow(ctx context.Context, request capabilities.UnregisterFromWorkflowRequest) error {
 	return nil
 }
+
+type mockCheckingCapability struct {
+	*mockCapability
+	transmitted atomic.Bool
+	checks      atomic.Int32
+}
+
+func (m *mockCheckingCapability) IsTransmitted(ctx context.Context, req capabilities.CapabilityRequest) (bool, error) {
+	m.checks.Add(1)
+	return m.transmitted.Load(), nil
+}
+
+func TestLocalTargetCapability_SkipIfTransmitted(t *testing.T) {
+	log := logger.TestLogger(t)
+	info := capabilities.MustNewCapabilityInfo(
+		"write_polygon-testnet-mumbai@1.0.0",
+		capabilities.CapabilityTypeTarget,
+		"a write capability targeting polygon mumbai testnet",
+	)
+	executionID := "32c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce1"
+
+	// pick the node that transmits in the second stage
+	ids := []p2ptypes.PeerID{randKey(), randKey()}
+	delays, err := GetPeerIDToTransmissionDelaysForConfig(ids, executionID, TransmissionConfig{Schedule: "[1,rest]", DeltaStage: 10 * time.Second})
+	require.NoError(t, err)
+	var delayed p2ptypes.PeerID
+	for id, d := range delays {
+		if d > 0 {
+			delayed = id
+		}
+	}
+	localDON := capabilities.Node{
+		WorkflowDON: capabilities.DON{ID: 1, Members: ids},
+		PeerID:      &delayed,
+	}
+
+	newRequest := func(t *testing.T, skip bool) capabilities.CapabilityRequest {
+		m, err := values.NewMap(map[string]any{
+			"schedule":          "[1,rest]",
+			"deltaStage":        "10s",
+			"skipIfTransmitted": skip,
+		})
+		require.NoError(t, err)
+		return capabilities.CapabilityRequest{
+			Config: m,
+			Metadata: capabilities.RequestMetadata{
+				WorkflowID:          "15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0",
+				WorkflowExecutionID: executionID,
+			},
+		}
+	}
+
+	t.Run("executes as soon as the request is reported as transmitted", func(t *testing.T) {
+		var called atomic.Bool
+		mt := &mockCheckingCapability{mockCapability: newMockCapability(info, func(capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			called.Store(true)
+			return capabilities.CapabilityResponse{}, nil
+		})}
+		mt.transmitted.Store(true)
+		ltc := NewLocalTargetCapability(log, "capabilityID", localDON, mt)
+		ltc.transmittedCheckInterval = 10 * time.Millisecond
+
+		start := time.Now()
+		_, err := ltc.Execute(t.Context(), newRequest(t, true))
+		require.NoError(t, err)
+		assert.True(t, called.Load())
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assert.Positive(t, mt.checks.Load())
+	})
+
+	t.Run("waits out the delay unless skipIfTransmitted is set", func(t *testing.T) {
+		mt := &mockCheckingCapability{mockCapability: newMockCapability(info, func(capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, nil
+		})}
+		mt.transmitted.Store(true)
+		ltc := NewLocalTargetCapability(log, "capabilityID", localDON, mt)
+		ltc.transmittedCheckInterval = 10 * time.Millisecond
+
+		ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+		defer cancel()
+		_, err := ltc.Execute(ctx, newRequest(t, false))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Zero(t, mt.checks.Load())
+	})
+}
@@ -1,7 +1,10 @@
 package transmission
 
 import (
+	"encoding/binary"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/smartcontractkit/libocr/permutation"
@@ -20,28 +23,56 @@ var (
 	Schedule_AllAtOnce = "allAtOnce"
 	// S = [1 * N]
 	Schedule_OneAtATime = "oneAtATime"
+	// Staged schedules list the number of nodes transmitting in each stage,
+	// e.g. "[2,2,rest]". The last stage may be "rest", meaning all remaining
+	// nodes. Nodes not covered by any stage do not transmit.
+	stagedScheduleRest = "rest"
 )
 
 type TransmissionConfig struct {
 	Schedule   string
 	DeltaStage time.Duration
+	// Jitter adds a delay in [0, Jitter) to each node's transmission. The
+	// jitter is derived from the transmission ID and peer ID, so all nodes
+	// compute the same delays.
+	Jitter time.Duration
+	// SkipIfTransmitted lets a node with a delayed transmission execute
+	// immediately (typically as a no-op) once the target reports the request
+	// as transmitted, rather than waiting out its delay. Only supported by
+	// targets implementing TransmissionStateChecker.
+	SkipIfTransmitted bool
 }
 
 func ExtractTransmissionConfig(config *values.Map) (TransmissionConfig, error) {
 	var tc struct {
-		DeltaStage string
-		Schedule   string
+		DeltaStage        string
+		Schedule          string
+		Jitter            string
+		SkipIfTransmitted bool
 	}
 	err := config.UnwrapTo(&tc)
 	if err != nil {
 		return TransmissionConfig{}, fmt.Errorf("failed to unwrap tranmission config from value map: %w", err)
 	}
 
+	var jitter time.Duration
+	if len(tc.Jitter) > 0 {
+		jitter, err = time.ParseDuration(tc.Jitter)
+		if err != nil {
+			return TransmissionConfig{}, fmt.Errorf("failed to parse Jitter %s as duration: %w", tc.Jitter, err)
+		}
+		if jitter < 0 {
+			return TransmissionConfig{}, fmt.Errorf("jitter must not be negative, got: %s", tc.Jitter)
+		}
+	}
+
 	// Default if no schedule and deltaStage is provided
 	if len(tc.Schedule) == 0 && len(tc.DeltaStage) == 0 {
 		return TransmissionConfig{
-			Schedule:   Schedule_AllAtOnce,
-			DeltaStage: 0,
+			Schedule:          Schedule_AllAtOnce,
+			DeltaStage:        0,
+			Jitter:            jitter,
+			SkipIfTransmitted: tc.SkipIfTransmitted,
 		}, nil
 	}
 
@@ -51,8 +82,10 @@ func ExtractTransmissionConfig(config *values.Map) (TransmissionConfig, error) {
 	}
 
 	return TransmissionConfig{
-		Schedule:   tc.Schedule,
-		DeltaStage: duration,
+		Schedule:          tc.Schedule,
+		DeltaStage:        duration,
+		Jitter:            jitter,
+		SkipIfTransmitted: tc.SkipIfTransmitted,
 	}, nil
 }
 
@@ -86,7 +119,7 @@ func GetPeerIDToTransmissionDelaysForConfig(donPeerIDs []types.PeerID, transmiss
 	for i, peerID := range donPeerIDs {
 		delay := delayFor(i, schedule, picked, tc.DeltaStage)
 		if delay != nil {
-			peerIDToTransmissionDelay[peerID] = *delay
+			peerIDToTransmissionDelay[peerID] = *delay + jitterFor(transmissionID, peerID, tc.Jitter)
 		}
 	}
 	return peerIDToTransmissionDelay, nil
@@ -116,9 +149,48 @@ func createTransmissionSchedule(scheduleType string, N int) ([]int, error) {
 		}
 		return sch, nil
 	}
+	if strings.HasPrefix(scheduleType, "[") && strings.HasSuffix(scheduleType, "]") {
+		return parseStagedSchedule(scheduleType, N)
+	}
 	return nil, fmt.Errorf("unknown schedule type %s", scheduleType)
 }
 
+// parseStagedSchedule parses a schedule of the form "[2,2,rest]"
+func parseStagedSchedule(scheduleType string, N int) ([]int, error) {
+	stages := strings.Split(strings.TrimSuffix(strings.TrimPrefix(scheduleType, "["), "]"), ",")
+	sch := make([]int, 0, len(stages))
+	sum := 0
+	for i, stage := range stages {
+		stage = strings.TrimSpace(stage)
+		if stage == stagedScheduleRest {
+			if i != len(stages)-1 {
+				return nil, fmt.Errorf("invalid schedule %s: %q is only allowed as the last stage", scheduleType, stagedScheduleRest)
+			}
+			sch = append(sch, max(N-sum, 0))
+			break
+		}
+		n, err := strconv.Atoi(stage)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid schedule %s: stage %q must be a positive integer or %q", scheduleType, stage, stagedScheduleRest)
+		}
+		sch = append(sch, n)
+		sum += n
+	}
+	return sch, nil
+}
+
+// jitterFor returns a delay in [0, jitter) that is deterministic for the
+// given transmission and peer
+func jitterFor(transmissionID string, peerID types.PeerID, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return 0
+	}
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(transmissionID))
+	hash.Write(peerID[:])
+	return time.Duration(binary.BigEndian.Uint64(hash.Sum(nil)) % uint64(jitter))
+}
+
 func transmissionScheduleSeed(transmissionID string) [16]byte {
 	hash := sha3.NewLegacyKeccak256()
 	hash.Write([]byte(transmissionID))
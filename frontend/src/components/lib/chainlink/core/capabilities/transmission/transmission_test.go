@@ -100,3 +100,91 @@ func Test_GetPeerIDToTransmissionDelay(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetPeerIDToTransmissionDelay_StagedSchedule(t *testing.T) {
+	ids := make([]p2ptypes.PeerID, 7)
+	for i := range ids {
+		ids[i] = [32]byte([]byte(fmt.Sprintf("%-32d", i)))
+	}
+	executionID := "15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0"
+
+	countStages := func(t *testing.T, schedule string) map[time.Duration]int {
+		m, err := values.NewMap(map[string]any{
+			"schedule":   schedule,
+			"deltaStage": "100ms",
+		})
+		require.NoError(t, err)
+		delays, err := GetPeerIDToTransmissionDelay(ids, capabilities.CapabilityRequest{
+			Config:   m,
+			Metadata: capabilities.RequestMetadata{WorkflowExecutionID: executionID},
+		})
+		require.NoError(t, err)
+		counts := map[time.Duration]int{}
+		for _, d := range delays {
+			counts[d]++
+		}
+		return counts
+	}
+
+	t.Run("rest covers remaining nodes", func(t *testing.T) {
+		assert.Equal(t, map[time.Duration]int{
+			0:                      2,
+			100 * time.Millisecond: 2,
+			200 * time.Millisecond: 3,
+		}, countStages(t, "[2,2,rest]"))
+	})
+
+	t.Run("nodes not covered by the schedule do not transmit", func(t *testing.T) {
+		assert.Equal(t, map[time.Duration]int{
+			0:                      1,
+			100 * time.Millisecond: 3,
+		}, countStages(t, "[1, 3]"))
+	})
+
+	t.Run("invalid schedules", func(t *testing.T) {
+		for _, schedule := range []string{"[]", "[rest,2]", "[0,rest]", "[-1]", "[a,b]"} {
+			m, err := values.NewMap(map[string]any{
+				"schedule":   schedule,
+				"deltaStage": "100ms",
+			})
+			require.NoError(t, err)
+			_, err = GetPeerIDToTransmissionDelay(ids, capabilities.CapabilityRequest{
+				Config:   m,
+				Metadata: capabilities.RequestMetadata{WorkflowExecutionID: executionID},
+			})
+			require.Error(t, err, schedule)
+		}
+	})
+}
+
+func Test_GetPeerIDToTransmissionDelay_Jitter(t *testing.T) {
+	ids := []p2ptypes.PeerID{randKey(), randKey(), randKey(), randKey()}
+	tc := TransmissionConfig{
+		Schedule:   Schedule_OneAtATime,
+		DeltaStage: 100 * time.Millisecond,
+		Jitter:     50 * time.Millisecond,
+	}
+	executionID := "15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0"
+
+	withJitter, err := GetPeerIDToTransmissionDelaysForConfig(ids, executionID, tc)
+	require.NoError(t, err)
+	again, err := GetPeerIDToTransmissionDelaysForConfig(ids, executionID, tc)
+	require.NoError(t, err)
+	assert.Equal(t, withJitter, again)
+
+	tc.Jitter = 0
+	withoutJitter, err := GetPeerIDToTransmissionDelaysForConfig(ids, executionID, tc)
+	require.NoError(t, err)
+	for _, id := range ids {
+		assertBetween(t, withJitter[id]-withoutJitter[id], 0, 50*time.Millisecond-1)
+	}
+
+	m, err := values.NewMap(map[string]any{
+		"schedule":   Schedule_OneAtATime,
+		"deltaStage": "100ms",
+		"jitter":     "-1s",
+	})
+	require.NoError(t, err)
+	_, err = ExtractTransmissionConfig(m)
+	require.ErrorContains(t, err, "jitter must not be negative")
+}
@@ -104,7 +104,7 @@ func (c *OutgoingConnectorHandler) HandleSingleNodeRequest(ctx context.Context,
 
 func (c *OutgoingConnectorHandler) handleSingleNodeRequest(ctx context.Context, messageID string, req capabilities.Request) (*api.Message, error) {
 	lggr := logger.With(c.lggr, "messageID", messageID, "workflowID", req.WorkflowID)
-	workflowAllow, globalAllow := c.outgoingRateLimiter.AllowVerbose(req.WorkflowID)
+	workflowAllow, globalAllow := c.outgoingRateLimiter.AllowVerbose(ctx, req.WorkflowID)
 	if !workflowAllow {
 		return nil, errors.New(errorOutgoingRatelimitWorkflow)
 	}
@@ -274,7 +274,7 @@ func (c *OutgoingConnectorHandler) HandleGatewayMessage(ctx context.Context, gat
 		return
 	}
 
-	senderAllow, globalAllow := c.incomingRateLimiter.AllowVerbose(body.Sender)
+	senderAllow, globalAllow := c.incomingRateLimiter.AllowVerbose(ctx, body.Sender)
 	errJSON := api.JsonRPCError{
 		Code:    500,
 		Message: "",
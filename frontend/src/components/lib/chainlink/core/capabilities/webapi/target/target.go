@@ -2,11 +2,17 @@ package target
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
@@ -32,8 +38,32 @@ const (
 	DefaultHTTPMethod   = "GET"
 	DefaultTimeoutMs    = 30000
 	MaxTimeoutMs        = 600000
+	DefaultRetryBackoff = time.Second
+	MaxRetryBackoff     = time.Minute
+)
+
+// ErrorClass classifies failed requests in the "errorClass" field of the
+// response, so that workflows can handle them without parsing messages.
+type ErrorClass string
+
+const (
+	// ErrorClassTimeout is returned if no response was received in time
+	ErrorClassTimeout ErrorClass = "Timeout"
+	// ErrorClassExecutionError is returned if the gateway could not send the request
+	ErrorClassExecutionError ErrorClass = "ExecutionError"
+	// ErrorClassServerError is returned for 5xx responses
+	ErrorClassServerError ErrorClass = "ServerError"
+	// ErrorClassClientError is returned for 4xx responses
+	ErrorClassClientError ErrorClass = "ClientError"
+	// ErrorClassInvalidResponse is returned for responses that do not
+	// conform to the configured response schema
+	ErrorClassInvalidResponse ErrorClass = "InvalidResponse"
 )
 
+func (e ErrorClass) retryable() bool {
+	return e == ErrorClassTimeout || e == ErrorClassServerError
+}
+
 // Capability is a target capability that sends HTTP requests to external clients via the Chainlink Gateway.
 type Capability struct {
 	capabilityInfo   capabilities.CapabilityInfo
@@ -105,16 +135,90 @@ func getPayload(input webapicap.TargetPayload, cfg webapicap.TargetConfig, req c
 		return ghcapabilities.Request{}, fmt.Errorf("timeoutMs must be between 0 and %d", MaxTimeoutMs)
 	}
 
+	url, err := interpolate(input.Url, input.TemplateValues, escapeURLComponent)
+	if err != nil {
+		return ghcapabilities.Request{}, fmt.Errorf("invalid url: %w", err)
+	}
+	body, err = interpolate(body, input.TemplateValues, escapeJSONString)
+	if err != nil {
+		return ghcapabilities.Request{}, fmt.Errorf("invalid body: %w", err)
+	}
+	headers := make(map[string]string, len(input.Headers)+1)
+	for k, v := range input.Headers {
+		v, err = interpolate(v, input.TemplateValues, nil)
+		if err != nil {
+			return ghcapabilities.Request{}, fmt.Errorf("invalid header %s: %w", k, err)
+		}
+		if containsLineBreak(k) || containsLineBreak(v) {
+			return ghcapabilities.Request{}, fmt.Errorf("invalid header %s: must not contain line breaks", k)
+		}
+		headers[k] = v
+	}
+	if cfg.IdempotencyKeyHeader != nil && *cfg.IdempotencyKeyHeader != "" {
+		if containsLineBreak(*cfg.IdempotencyKeyHeader) {
+			return ghcapabilities.Request{}, errors.New("invalid idempotencyKeyHeader: must not contain line breaks")
+		}
+		headers[*cfg.IdempotencyKeyHeader] = idempotencyKey(req)
+	}
+
 	return ghcapabilities.Request{
-		URL:        input.Url,
+		URL:        url,
 		Method:     method,
-		Headers:    input.Headers,
+		Headers:    headers,
 		Body:       []byte(body),
 		TimeoutMs:  timeoutMs,
 		WorkflowID: req.Metadata.WorkflowID,
 	}, nil
 }
 
+// retryDelay doubles the backoff for each attempt, up to MaxRetryBackoff
+func retryDelay(backoff time.Duration, attempt int) time.Duration {
+	delay := backoff
+	for i := 0; i < attempt && delay < MaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRetryBackoff)
+}
+
+// idempotencyKey is derived from the workflow execution and step, so that
+// it is the same for all retries and for all nodes executing the step
+func idempotencyKey(req capabilities.CapabilityRequest) string {
+	h := sha256.Sum256([]byte(req.Metadata.WorkflowExecutionID + "/" + req.Metadata.ReferenceID))
+	return hex.EncodeToString(h[:])
+}
+
+// errExternalSchemaRef is returned for the references of a response schema to
+// other documents, so that a workflow cannot make the node read local files or
+// fetch URLs while compiling its schema
+var errExternalSchemaRef = errors.New("references to external schemas are not allowed")
+
+func compileResponseSchema(cfg webapicap.TargetConfig) (*jsonschema.Schema, error) {
+	if cfg.ResponseSchema == nil || *cfg.ResponseSchema == "" {
+		return nil, nil
+	}
+	const schemaURL = "responseSchema.json"
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("%w: %s", errExternalSchemaRef, s)
+	}
+	if err := compiler.AddResource(schemaURL, strings.NewReader(*cfg.ResponseSchema)); err != nil {
+		return nil, fmt.Errorf("invalid responseSchema: %w", err)
+	}
+	schema, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid responseSchema: %w", err)
+	}
+	return schema, nil
+}
+
+func validateResponse(schema *jsonschema.Schema, body []byte) error {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Errorf("response body is not valid JSON: %w", err)
+	}
+	return schema.Validate(v)
+}
+
 func (c *Capability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
 	c.lggr.Debugw("executing http target", "capabilityRequest", req)
 
@@ -140,29 +244,65 @@ func (c *Capability) Execute(ctx context.Context, req capabilities.CapabilityReq
 		return capabilities.CapabilityResponse{}, err
 	}
 
+	schema, err := compileResponseSchema(workflowCfg)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+
 	// Default to SingleNode delivery mode
 	deliveryMode := defaultIfNil(workflowCfg.DeliveryMode, webapi.SingleNode)
 
 	switch deliveryMode {
 	case webapi.SingleNode:
-		// blocking call to handle single node request. waits for response from gateway
-		resp, err := c.connectorHandler.HandleSingleNodeRequest(ctx, messageID, payload)
-		if err != nil {
-			return capabilities.CapabilityResponse{}, err
+		retryCount := int(defaultIfNil(workflowCfg.RetryCount, 0))
+		backoff := DefaultRetryBackoff
+		if workflowCfg.RetryBackoffMs != nil {
+			backoff = time.Duration(*workflowCfg.RetryBackoffMs) * time.Millisecond
 		}
-		c.lggr.Debugw("received gateway response", "donID", resp.Body.DonId, "msgID", resp.Body.MessageId, "receiver", resp.Body.Receiver, "sender", resp.Body.Sender)
-		var payload ghcapabilities.Response
-		err = json.Unmarshal(resp.Body.Payload, &payload)
-		if err != nil {
-			return capabilities.CapabilityResponse{}, err
+
+		var resp ghcapabilities.Response
+		var errorClass ErrorClass
+		for attempt := 0; ; attempt++ {
+			// every attempt needs its own message ID, so that a late
+			// response to a previous attempt is not mistaken for this one
+			attemptMessageID := messageID
+			if attempt > 0 {
+				attemptMessageID = fmt.Sprintf("%s/%d", messageID, attempt)
+			}
+			resp, errorClass, err = c.send(ctx, attemptMessageID, payload)
+			if err != nil {
+				return capabilities.CapabilityResponse{}, err
+			}
+			if !errorClass.retryable() || attempt >= retryCount {
+				break
+			}
+			delay := retryDelay(backoff, attempt)
+			c.lggr.Debugw("retrying http target request", "msgID", messageID, "attempt", attempt+1, "errorClass", errorClass, "delay", delay)
+			select {
+			case <-ctx.Done():
+				return capabilities.CapabilityResponse{}, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		if errorClass == "" && schema != nil {
+			if err = validateResponse(schema, resp.Body); err != nil {
+				errorClass = ErrorClassInvalidResponse
+				resp.ErrorMessage = err.Error()
+			}
 		}
 
 		// TODO: check target response format and fields CM-473
-		values, err := values.NewMap(map[string]any{
-			"statusCode": payload.StatusCode,
-			"headers":    payload.Headers,
-			"body":       payload.Body,
-		})
+		m := map[string]any{
+			"statusCode": resp.StatusCode,
+			"headers":    resp.Headers,
+			"body":       resp.Body,
+		}
+		if errorClass != "" {
+			m["errorClass"] = string(errorClass)
+			m["errorMessage"] = resp.ErrorMessage
+		}
+		values, err := values.NewMap(m)
 		if err != nil {
 			return capabilities.CapabilityResponse{}, err
 		}
@@ -174,10 +314,51 @@ func (c *Capability) Execute(ctx context.Context, req capabilities.CapabilityReq
 	}
 }
 
+// send sends a single request through the gateway, and classifies the
+// outcome. Timeouts and unsuccessful responses are returned as an error
+// class, other failures as an error.
+func (c *Capability) send(ctx context.Context, messageID string, payload ghcapabilities.Request) (ghcapabilities.Response, ErrorClass, error) {
+	// blocking call to handle single node request. waits for response from gateway
+	resp, err := c.connectorHandler.HandleSingleNodeRequest(ctx, messageID, payload)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return ghcapabilities.Response{ErrorMessage: err.Error()}, ErrorClassTimeout, nil
+		}
+		return ghcapabilities.Response{}, "", err
+	}
+	c.lggr.Debugw("received gateway response", "donID", resp.Body.DonId, "msgID", resp.Body.MessageId, "receiver", resp.Body.Receiver, "sender", resp.Body.Sender)
+	var r ghcapabilities.Response
+	err = json.Unmarshal(resp.Body.Payload, &r)
+	if err != nil {
+		return ghcapabilities.Response{}, "", err
+	}
+
+	switch {
+	case r.ExecutionError:
+		return r, ErrorClassExecutionError, nil
+	case r.StatusCode >= 500:
+		r.ErrorMessage = fmt.Sprintf("request failed with status code %d", r.StatusCode)
+		return r, ErrorClassServerError, nil
+	case r.StatusCode >= 400:
+		r.ErrorMessage = fmt.Sprintf("request failed with status code %d", r.StatusCode)
+		return r, ErrorClassClientError, nil
+	default:
+		return r, "", nil
+	}
+}
+
 func (c *Capability) RegisterToWorkflow(ctx context.Context, req capabilities.RegisterToWorkflowRequest) error {
-	// Workflow engine guarantees registration requests are valid
-	// TODO: handle retry configuration CM-472
-	return nil
+	// Workflow engine guarantees registration requests are valid, but the
+	// response schema is only checked here so that it fails early
+	if req.Config == nil {
+		return nil
+	}
+	var workflowCfg webapicap.TargetConfig
+	if err := req.Config.UnwrapTo(&workflowCfg); err != nil {
+		return err
+	}
+	_, err := compileResponseSchema(workflowCfg)
+	return err
 }
 
 func (c *Capability) UnregisterFromWorkflow(ctx context.Context, req capabilities.UnregisterFromWorkflowRequest) error {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -15,6 +16,7 @@ import (
 	registrymock "github.com/smartcontractkit/chainlink-common/pkg/types/core/mocks"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/webapicap"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	gcmocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector/mocks"
@@ -386,3 +388,217 @@ func verifyResp(t *testing.T, resp capabilities.CapabilityResponse) {
 	require.True(t, ok)
 	require.Equal(t, "response body", string(respBody))
 }
+
+func gatewayResponseWithStatus(t *testing.T, msgID string, statusCode int, body string) *api.Message {
+	responsePayload, err := json.Marshal(ghcapabilities.Response{
+		StatusCode: statusCode,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		Body:       []byte(body),
+	})
+	require.NoError(t, err)
+	return &api.Message{
+		Body: api.MessageBody{
+			MessageId: msgID,
+			Method:    ghcapabilities.MethodWebAPITarget,
+			Payload:   responsePayload,
+		},
+	}
+}
+
+func TestGetPayload_Templating(t *testing.T) {
+	req := capabilityRequest(t)
+	body := `{"symbol":"{{symbol}}"}`
+	input := webapicap.TargetPayload{
+		Url:     "https://example.com/prices/{{ symbol }}?at={{time}}",
+		Headers: webapicap.TargetPayloadHeaders{"Authorization": "Bearer {{token}}"},
+		Body:    &body,
+		TemplateValues: webapicap.TargetPayloadTemplateValues{
+			"symbol": "ETH/USD",
+			"time":   "2024-01-01 00:00",
+			"token":  "secret",
+		},
+	}
+
+	payload, err := getPayload(input, webapicap.TargetConfig{}, req)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/prices/ETH%2FUSD?at=2024-01-01%2000%3A00", payload.URL)
+	require.Equal(t, "Bearer secret", payload.Headers["Authorization"])
+	require.JSONEq(t, `{"symbol":"ETH/USD"}`, string(payload.Body))
+
+	t.Run("missing template value", func(t *testing.T) {
+		input := input
+		input.Url = "https://example.com/{{unknown}}"
+		_, err := getPayload(input, webapicap.TargetConfig{}, req)
+		require.ErrorContains(t, err, "no template values for unknown")
+	})
+
+	t.Run("line breaks in headers", func(t *testing.T) {
+		input := input
+		input.TemplateValues = webapicap.TargetPayloadTemplateValues{"symbol": "ETH", "time": "now", "token": "a\r\nX-Injected: b"}
+		_, err := getPayload(input, webapicap.TargetConfig{}, req)
+		require.ErrorContains(t, err, "must not contain line breaks")
+	})
+
+	t.Run("body values are JSON escaped", func(t *testing.T) {
+		input := input
+		input.TemplateValues = webapicap.TargetPayloadTemplateValues{"symbol": `ETH","admin":true,"x":"`, "time": "now", "token": "secret"}
+		payload, err := getPayload(input, webapicap.TargetConfig{}, req)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"symbol":"ETH\",\"admin\":true,\"x\":\""}`, string(payload.Body))
+	})
+
+	t.Run("line breaks in header names", func(t *testing.T) {
+		input := input
+		input.Headers = webapicap.TargetPayloadHeaders{"X-A\r\nX-Injected": "b"}
+		_, err := getPayload(input, webapicap.TargetConfig{}, req)
+		require.ErrorContains(t, err, "must not contain line breaks")
+	})
+
+	t.Run("idempotency key", func(t *testing.T) {
+		header := "Idempotency-Key"
+		payload, err := getPayload(input, webapicap.TargetConfig{IdempotencyKeyHeader: &header}, req)
+		require.NoError(t, err)
+		key := payload.Headers[header]
+		require.Len(t, key, 64)
+
+		payload, err = getPayload(input, webapicap.TargetConfig{IdempotencyKeyHeader: &header}, req)
+		require.NoError(t, err)
+		require.Equal(t, key, payload.Headers[header])
+	})
+}
+
+func TestCapability_ExecuteRetriesAndValidation(t *testing.T) {
+	th := setup(t, defaultConfig)
+	ctx := testutils.Context(t)
+	th.connector.EXPECT().DonID().Return("donID")
+	th.connector.EXPECT().GatewayIDs().Return([]string{"gateway1", "gateway2"})
+	th.connector.EXPECT().AwaitConnection(mock.Anything, "gateway1").Return(nil).Maybe()
+
+	request := func(t *testing.T, cfg map[string]any) capabilities.CapabilityRequest {
+		inputs, _ := inputsAndConfig(t)
+		wfConfig, err := values.NewMap(cfg)
+		require.NoError(t, err)
+		return capabilities.CapabilityRequest{
+			Metadata: capabilities.RequestMetadata{
+				WorkflowID:          workflowID1,
+				WorkflowExecutionID: workflowExecutionID1,
+			},
+			Inputs: inputs,
+			Config: wfConfig,
+		}
+	}
+	respond := func(statusCodes []int, body string) *[]*api.MessageBody {
+		var sent []*api.MessageBody
+		th.connector.On("SignAndSendToGateway", mock.Anything, "gateway1", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			msg := args.Get(2).(*api.MessageBody)
+			statusCode := statusCodes[len(sent)]
+			sent = append(sent, msg)
+			th.connectorHandler.HandleGatewayMessage(ctx, "gateway1", gatewayResponseWithStatus(t, msg.MessageId, statusCode, body))
+		}).Times(len(statusCodes))
+		return &sent
+	}
+	result := func(t *testing.T, resp capabilities.CapabilityResponse) map[string]any {
+		var m map[string]any
+		require.NoError(t, resp.Value.UnwrapTo(&m))
+		return m
+	}
+
+	t.Run("retries server errors with the same idempotency key", func(t *testing.T) {
+		sent := respond([]int{503, 502, 200}, `{}`)
+		resp, err := th.capability.Execute(ctx, request(t, map[string]any{
+			"retryCount":           2,
+			"retryBackoffMs":       1,
+			"idempotencyKeyHeader": "Idempotency-Key",
+		}))
+		require.NoError(t, err)
+		m := result(t, resp)
+		require.Equal(t, int64(200), m["statusCode"])
+		require.NotContains(t, m, "errorClass")
+
+		require.Len(t, *sent, 3)
+		ids := map[string]struct{}{}
+		var keys []string
+		for _, msg := range *sent {
+			ids[msg.MessageId] = struct{}{}
+			var req ghcapabilities.Request
+			require.NoError(t, json.Unmarshal(msg.Payload, &req))
+			keys = append(keys, req.Headers["Idempotency-Key"])
+		}
+		require.Len(t, ids, 3)
+		require.NotEmpty(t, keys[0])
+		require.Equal(t, []string{keys[0], keys[0], keys[0]}, keys)
+	})
+
+	t.Run("returns error class once retries are exhausted", func(t *testing.T) {
+		respond([]int{500, 500}, `{}`)
+		resp, err := th.capability.Execute(ctx, request(t, map[string]any{
+			"retryCount":     1,
+			"retryBackoffMs": 1,
+		}))
+		require.NoError(t, err)
+		m := result(t, resp)
+		require.Equal(t, int64(500), m["statusCode"])
+		require.Equal(t, string(ErrorClassServerError), m["errorClass"])
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		respond([]int{404}, `{}`)
+		resp, err := th.capability.Execute(ctx, request(t, map[string]any{
+			"retryCount":     3,
+			"retryBackoffMs": 1,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, string(ErrorClassClientError), result(t, resp)["errorClass"])
+	})
+
+	schema := `{"type":"object","properties":{"price":{"type":"number"}},"required":["price"]}`
+
+	t.Run("validates responses against the response schema", func(t *testing.T) {
+		respond([]int{200}, `{"price":1.5}`)
+		resp, err := th.capability.Execute(ctx, request(t, map[string]any{"responseSchema": schema}))
+		require.NoError(t, err)
+		require.NotContains(t, result(t, resp), "errorClass")
+
+		respond([]int{200}, `{"price":"high"}`)
+		resp, err = th.capability.Execute(ctx, request(t, map[string]any{"responseSchema": schema}))
+		require.NoError(t, err)
+		m := result(t, resp)
+		require.Equal(t, string(ErrorClassInvalidResponse), m["errorClass"])
+		require.NotEmpty(t, m["errorMessage"])
+	})
+
+	t.Run("rejects invalid response schemas on registration", func(t *testing.T) {
+		cfg, err := values.NewMap(map[string]any{"responseSchema": `{"type":1}`})
+		require.NoError(t, err)
+		err = th.capability.RegisterToWorkflow(ctx, capabilities.RegisterToWorkflowRequest{
+			Metadata: capabilities.RegistrationMetadata{
+				WorkflowID:    workflowID1,
+				WorkflowOwner: owner1,
+			},
+			Config: cfg,
+		})
+		require.ErrorContains(t, err, "invalid responseSchema")
+	})
+
+	t.Run("rejects response schemas referencing external schemas", func(t *testing.T) {
+		for _, ref := range []string{"file:///etc/passwd", "http://127.0.0.1/schema.json", "other.json"} {
+			cfg, err := values.NewMap(map[string]any{"responseSchema": `{"$ref":"` + ref + `"}`})
+			require.NoError(t, err)
+			err = th.capability.RegisterToWorkflow(ctx, capabilities.RegisterToWorkflowRequest{
+				Metadata: capabilities.RegistrationMetadata{
+					WorkflowID:    workflowID1,
+					WorkflowOwner: owner1,
+				},
+				Config: cfg,
+			})
+			require.ErrorContains(t, err, "references to external schemas are not allowed", ref)
+		}
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, time.Second, retryDelay(time.Second, 0))
+	require.Equal(t, 8*time.Second, retryDelay(time.Second, 3))
+	require.Equal(t, MaxRetryBackoff, retryDelay(time.Minute, 10))
+	require.Equal(t, MaxRetryBackoff, retryDelay(time.Second, 100))
+}
@@ -0,0 +1,53 @@
+package target
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var placeholderRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// interpolate replaces {{name}} placeholders in s with the corresponding
+// value, after applying escape to it. Placeholders without a value are an
+// error, so that typos do not result in requests to unintended URLs.
+func interpolate(s string, values map[string]string, escape func(string) string) (string, error) {
+	var missing []string
+	out := placeholderRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		name := placeholderRegexp.FindStringSubmatch(m)[1]
+		v, ok := values[name]
+		if !ok {
+			missing = append(missing, name)
+			return m
+		}
+		if escape != nil {
+			return escape(v)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("no template values for %s", strings.Join(missing, ", "))
+	}
+	return out, nil
+}
+
+// escapeURLComponent escapes values so that they are safe in both the path
+// and the query of a URL
+func escapeURLComponent(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// escapeJSONString escapes values so that they cannot break out of a JSON
+// string in the body, e.g. by containing quotes
+func escapeJSONString(s string) string {
+	b, _ := json.Marshal(s) // marshalling a string never fails
+	return string(b[1 : len(b)-1])
+}
+
+// containsLineBreak reports whether s could be used to inject further
+// header lines into the request
+func containsLineBreak(s string) bool {
+	return strings.ContainsAny(s, "\r\n\x00")
+}
@@ -99,7 +99,7 @@ func (h *triggerConnectorHandler) processTrigger(ctx context.Context, gatewayID
 					h.lggr.Debugw(err.Error())
 					continue
 				}
-				if !trigger.rateLimiter.Allow(body.Sender) {
+				if !trigger.rateLimiter.Allow(ctx, body.Sender) {
 					err = fmt.Errorf("request rate-limited for sender %s, messageID %s", sender.String(), body.MessageId)
 					continue
 				}
@@ -13,9 +13,12 @@ func (cfg TargetConfig) New(w *sdk.WorkflowSpecFactory, input TargetInput) {
 		ID:     "web-api-target@1.0.0",
 		Inputs: input.ToSteps(),
 		Config: map[string]any{
-			"deliveryMode": cfg.DeliveryMode,
-			"retryCount":   cfg.RetryCount,
-			"timeoutMs":    cfg.TimeoutMs,
+			"deliveryMode":         cfg.DeliveryMode,
+			"idempotencyKeyHeader": cfg.IdempotencyKeyHeader,
+			"responseSchema":       cfg.ResponseSchema,
+			"retryBackoffMs":       cfg.RetryBackoffMs,
+			"retryCount":           cfg.RetryCount,
+			"timeoutMs":            cfg.TimeoutMs,
 		},
 		CapabilityType: capabilities.CapabilityTypeTarget,
 	}
@@ -25,19 +28,21 @@ func (cfg TargetConfig) New(w *sdk.WorkflowSpecFactory, input TargetInput) {
 }
 
 type TargetInput struct {
-	Body    sdk.CapDefinition[string]
-	Headers sdk.CapDefinition[TargetPayloadHeaders]
-	Method  sdk.CapDefinition[string]
-	Url     sdk.CapDefinition[string]
+	Body           sdk.CapDefinition[string]
+	Headers        sdk.CapDefinition[TargetPayloadHeaders]
+	Method         sdk.CapDefinition[string]
+	TemplateValues sdk.CapDefinition[TargetPayloadTemplateValues]
+	Url            sdk.CapDefinition[string]
 }
 
 func (input TargetInput) ToSteps() sdk.StepInputs {
 	return sdk.StepInputs{
 		Mapping: map[string]any{
-			"body":    input.Body.Ref(),
-			"headers": input.Headers.Ref(),
-			"method":  input.Method.Ref(),
-			"url":     input.Url.Ref(),
+			"body":           input.Body.Ref(),
+			"headers":        input.Headers.Ref(),
+			"method":         input.Method.Ref(),
+			"templateValues": input.TemplateValues.Ref(),
+			"url":            input.Url.Ref(),
 		},
 	}
 }
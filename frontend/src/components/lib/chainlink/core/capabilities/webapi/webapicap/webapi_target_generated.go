@@ -20,6 +20,17 @@ type TargetConfig struct {
 	// The delivery mode for the request. Defaults to SingleNode
 	DeliveryMode *string `json:"deliveryMode,omitempty" yaml:"deliveryMode,omitempty" mapstructure:"deliveryMode,omitempty"`
 
+	// The header to send an idempotency key in. The key is the same for all retries
+	// and nodes executing the same workflow step
+	IdempotencyKeyHeader *string `json:"idempotencyKeyHeader,omitempty" yaml:"idempotencyKeyHeader,omitempty" mapstructure:"idempotencyKeyHeader,omitempty"`
+
+	// A JSON schema that successful response bodies must conform to
+	ResponseSchema *string `json:"responseSchema,omitempty" yaml:"responseSchema,omitempty" mapstructure:"responseSchema,omitempty"`
+
+	// The delay in milliseconds before the first retry, doubled for each subsequent
+	// retry up to 1 minute. Defaults to 1 second
+	RetryBackoffMs *uint16 `json:"retryBackoffMs,omitempty" yaml:"retryBackoffMs,omitempty" mapstructure:"retryBackoffMs,omitempty"`
+
 	// The number of times to retry the request. Defaults to 0 retries
 	RetryCount *uint8 `json:"retryCount,omitempty" yaml:"retryCount,omitempty" mapstructure:"retryCount,omitempty"`
 
@@ -35,6 +46,9 @@ func (j *TargetConfig) UnmarshalJSON(b []byte) error {
 	if err := json.Unmarshal(b, &plain); err != nil {
 		return err
 	}
+	if plain.RetryBackoffMs != nil && 60000 < *plain.RetryBackoffMs {
+		return fmt.Errorf("field %s: must be <= %v", "retryBackoffMs", 60000)
+	}
 	if plain.RetryCount != nil && 10 < *plain.RetryCount {
 		return fmt.Errorf("field %s: must be <= %v", "retryCount", 10)
 	}
@@ -55,6 +69,10 @@ type TargetPayload struct {
 	// The HTTP method to use for the request
 	Method *string `json:"method,omitempty" yaml:"method,omitempty" mapstructure:"method,omitempty"`
 
+	// Values interpolated into {{name}} placeholders in the URL, headers and body,
+	// escaped in the URL and as JSON string contents in the body
+	TemplateValues TargetPayloadTemplateValues `json:"templateValues,omitempty" yaml:"templateValues,omitempty" mapstructure:"templateValues,omitempty"`
+
 	// The URL to send the request to
 	Url string `json:"url" yaml:"url" mapstructure:"url"`
 }
@@ -62,6 +80,10 @@ type TargetPayload struct {
 // The headers to include in the request
 type TargetPayloadHeaders map[string]string
 
+// Values interpolated into {{name}} placeholders in the URL, headers and body,
+// escaped in the URL and as JSON string contents in the body
+type TargetPayloadTemplateValues map[string]string
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (j *TargetPayload) UnmarshalJSON(b []byte) error {
 	var raw map[string]interface{}
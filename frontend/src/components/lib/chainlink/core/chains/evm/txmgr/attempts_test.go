@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
@@ -346,3 +347,34 @@ func TestTxm_EvmTxAttemptBuilder_RetryableEstimatorError(t *testing.T) {
 		assert.True(t, retryable)
 	})
 }
+
+func TestTxm_EvmTxAttemptBuilder_TxMaxGasPrice(t *testing.T) {
+	lggr := logger.Test(t)
+	ctx := t.Context()
+	kst := keystest.TxSigner(nil)
+	cfg := &feeConfig{priceMax: assets.NewWeiI(1000)}
+
+	meta := sqlutil.JSON(`{"JobID":1,"MaxGasPriceWei":"100"}`)
+	capped := txmgr.Tx{Meta: &meta}
+
+	t.Run("caps new and bumped attempts at the tx max gas price", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, assets.NewWeiI(100), mock.Anything, mock.Anything).Return(gas.EvmFee{}, uint64(0), pkgerrors.New("fail")).Once()
+		est.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, assets.NewWeiI(100), mock.Anything).Return(gas.EvmFee{}, uint64(0), pkgerrors.New("fail")).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), cfg, kst, est)
+
+		_, _, _, _, err := cks.NewTxAttempt(ctx, capped, lggr)
+		require.Error(t, err)
+		_, _, _, _, err = cks.NewBumpTxAttempt(ctx, capped, txmgr.TxAttempt{}, nil, lggr)
+		require.Error(t, err)
+	})
+
+	t.Run("uses the key max gas price if it is lower", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, assets.NewWeiI(50), mock.Anything, mock.Anything).Return(gas.EvmFee{}, uint64(0), pkgerrors.New("fail")).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), &feeConfig{priceMax: assets.NewWeiI(50)}, kst, est)
+
+		_, _, _, _, err := cks.NewTxAttempt(ctx, capped, lggr)
+		require.Error(t, err)
+	})
+}
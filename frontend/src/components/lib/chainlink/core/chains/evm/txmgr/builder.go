@@ -47,6 +47,7 @@ func NewTxm(
 	estimator gas.EvmFeeEstimator,
 	headTracker latestAndFinalizedBlockHeadTracker,
 	txmv2wrapper TxManager,
+	spendBudgets []SpendBudget,
 ) (txm TxManager,
 	err error,
 ) {
@@ -60,11 +61,11 @@ func NewTxm(
 	checker := &CheckerFactory{Client: client}
 	// create tx attempt builder
 	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
-	txStore := NewTxStore(ds, lggr)
 	txmCfg := NewEvmTxmConfig(chainConfig)             // wrap Evm specific config
 	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
 	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
 	chainID := txmClient.ConfiguredChainID()
+	txStore := NewSpendBudgetTxStore(NewTxStore(ds, lggr), chainID, spendBudgets, lggr)
 	metrics, err := NewEVMTxmMetrics(chainID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize EVM TXM metrics: %w", err)
@@ -79,7 +80,23 @@ func NewTxm(
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
 	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer, txmv2wrapper)
-	return txm, nil
+	return &evmTxm{TxManager: NewSpendBudgetTxm(txm, txStore), txStore: txStore}, nil
+}
+
+// evmTxm is a TxManager built by NewTxm, along with the tx store it uses.
+type evmTxm struct {
+	TxManager
+	txStore EvmTxStore
+}
+
+// TxStoreOf returns the tx store of txm if it was built by NewTxm, so that the transactions of a chain can be read
+// without opening another tx store. It returns false for the other transaction managers, e.g. of disabled chains.
+func TxStoreOf(txm TxManager) (EvmTxStore, bool) {
+	t, ok := txm.(*evmTxm)
+	if !ok {
+		return nil, false
+	}
+	return t.txStore, true
 }
 
 // NewEvmTxm creates a new concrete EvmTxm
@@ -215,5 +232,5 @@ func NewEvmBroadcaster(
 	metrics metrics.GenericTXMMetrics,
 ) *Broadcaster {
 	nonceTracker := NewNonceTracker(logger, txStore, client)
-	return txmgr.NewBroadcaster(txStore, client, chainConfig, feeConfig, txConfig, listenerConfig, keystore, txAttemptBuilder, nonceTracker, logger, checkerFactory, autoSyncNonce, string(chainType), metrics)
+	return txmgr.NewBroadcaster(broadcasterTxStore{txStore}, client, chainConfig, feeConfig, txConfig, listenerConfig, keystore, txAttemptBuilder, nonceTracker, logger, checkerFactory, autoSyncNonce, string(chainType), metrics)
 }
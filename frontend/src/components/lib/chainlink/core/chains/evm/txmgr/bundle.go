@@ -0,0 +1,63 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+)
+
+const (
+	// bundleAbortedError is recorded on the unsent transactions of a bundle after one of its transactions fails
+	// fatally.
+	bundleAbortedError = "bundle aborted: an earlier transaction of the bundle failed"
+	// bundleIncompleteError is recorded on the transactions of a bundle that was not created in full within
+	// bundleCreationTimeout.
+	bundleIncompleteError = "bundle aborted: not all of its transactions were created"
+	// bundleCreationTimeout is how long the transactions of a bundle wait for the rest of the bundle to be created.
+	// Incomplete bundles are aborted by the reaper once it expires, so that their transactions don't stay unstarted
+	// and count against their key forever.
+	bundleCreationTimeout = 10 * time.Minute
+)
+
+var _ txmgrtypes.TxStrategy = BundleStrategy{}
+
+// BundleStrategy is the strategy of a transaction of a bundle. Each transaction of a bundle is created like any other
+// transaction, and the broadcaster only starts a bundle once all of its transactions exist. It then sends them in
+// order and back to back, so they get consecutive nonces from the same key. A bundle that is not created in full within
+// bundleCreationTimeout is aborted, and no transaction can be added to an aborted bundle.
+type BundleStrategy struct {
+	ID string
+	// Index is the position of the transaction in the bundle, starting from 0.
+	Index int
+	// Size is the number of transactions of the bundle.
+	Size int
+}
+
+// NewBundleStrategy returns the strategy for transaction index of bundle id, made of size transactions.
+func NewBundleStrategy(id string, index, size int) txmgrtypes.TxStrategy {
+	return BundleStrategy{ID: id, Index: index, Size: size}
+}
+
+func (s BundleStrategy) Subject() uuid.NullUUID {
+	return uuid.NullUUID{}
+}
+
+func (s BundleStrategy) PruneQueue(_ context.Context, _ txmgrtypes.UnstartedTxQueuePruner) ([]int64, error) {
+	return nil, nil
+}
+
+func (s BundleStrategy) validate() error {
+	if s.ID == "" {
+		return fmt.Errorf("bundle ID is required")
+	}
+
+	if s.Index < 0 || s.Index >= s.Size {
+		return fmt.Errorf("transaction %d is out of range for bundle %s of %d transactions", s.Index, s.ID, s.Size)
+	}
+
+	return nil
+}
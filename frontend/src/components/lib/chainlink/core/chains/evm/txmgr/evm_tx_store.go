@@ -49,6 +49,10 @@ type EvmTxStore interface {
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []*types.Receipt, err error)
 	FindTxesPendingCallback(ctx context.Context, latest, finalized int64, chainID *big.Int) (receiptsPlus []ReceiptPlus, err error)
 	FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID *big.Int) (etxs []*Tx, err error)
+	FindNextUnstartedTransactionFromAddressExcludingJobs(ctx context.Context, fromAddress common.Address, chainID *big.Int, jobIDs []int32) (*Tx, error)
+	GasSpend(ctx context.Context, filter GasSpendFilter, chainID *big.Int) (*big.Int, error)
+	CountPendingTransactionsByFromAddress(ctx context.Context, addresses []common.Address, chainID *big.Int) (map[common.Address]uint32, error)
+	FindFromAddressesWithStuckTransactions(ctx context.Context, addresses []common.Address, chainID *big.Int) ([]common.Address, error)
 	SaveFetchedReceipts(ctx context.Context, r []*types.Receipt) (err error)
 	UpdateTxStatesToFinalizedUsingTxHashes(ctx context.Context, txHashes []common.Hash, chainID *big.Int) error
 }
@@ -1416,11 +1420,46 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAt
 
 // Finds earliest saved transaction that has yet to be broadcast from the given address
 func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
+	return o.findNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID, nil)
+}
+
+// FindNextUnstartedTransactionFromAddressExcludingJobs is FindNextUnstartedTransactionFromAddress, skipping the
+// transactions of the given jobs.
+func (o *evmTxStore) FindNextUnstartedTransactionFromAddressExcludingJobs(ctx context.Context, fromAddress common.Address, chainID *big.Int, jobIDs []int32) (*Tx, error) {
+	return o.findNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID, jobIDs)
+}
+
+func (o *evmTxStore) findNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, excludedJobIDs []int32) (*Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	// the next transaction of a bundle is eligible once the previous one has started, and takes priority over other
+	// transactions, so a bundle is sent with consecutive nonces
+	err := o.q.GetContext(ctx, &dbEtx, `
+SELECT t.* FROM evm.txes t
+JOIN evm.tx_bundles b ON b.eth_tx_id = t.id AND b.bundle_index > 0
+JOIN evm.tx_bundles pb ON pb.bundle_id = b.bundle_id AND pb.bundle_index = b.bundle_index - 1
+JOIN evm.txes pt ON pt.id = pb.eth_tx_id AND pt.evm_chain_id = t.evm_chain_id
+WHERE t.from_address = $1 AND t.state = 'unstarted' AND t.evm_chain_id = $2 AND pt.state <> 'unstarted'
+AND NOT COALESCE((t.meta->>'JobID')::int = ANY($3), false)
+ORDER BY t.id ASC
+LIMIT 1`, fromAddress, chainID.String(), pq.Array(excludedJobIDs))
+	if errors.Is(err, sql.ErrNoRows) {
+		// bundles start once all of their transactions have been created
+		err = o.q.GetContext(ctx, &dbEtx, `
+SELECT * FROM evm.txes t
+WHERE t.from_address = $1 AND t.state = 'unstarted' AND t.evm_chain_id = $2 AND NOT EXISTS (
+	SELECT 1 FROM evm.tx_bundles b
+	WHERE b.eth_tx_id = t.id AND (b.bundle_index > 0 OR b.bundle_size > (
+		SELECT count(*) FROM evm.tx_bundles c
+		JOIN evm.txes ct ON ct.id = c.eth_tx_id
+		WHERE c.bundle_id = b.bundle_id AND ct.evm_chain_id = t.evm_chain_id
+	))
+) AND NOT COALESCE((t.meta->>'JobID')::int = ANY($3), false)
+ORDER BY t.value ASC, t.created_at ASC, t.id ASC
+LIMIT 1`, fromAddress, chainID.String(), pq.Array(excludedJobIDs))
+	}
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1449,7 +1488,10 @@ func (o *evmTxStore) UpdateTxFatalErrorAndDeleteAttempts(ctx context.Context, et
 		dbEtx.FromTx(etx)
 		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 		dbEtx.ToTx(etx)
-		return err
+		if err != nil {
+			return err
+		}
+		return orm.abortBundles(ctx, []int64{etx.ID})
 	})
 }
 
@@ -1670,21 +1712,104 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 				return nil
 			}
 		}
-		err = orm.q.GetContext(ctx, &dbEtx, `
+		bundle, isBundle := txRequest.Strategy.(BundleStrategy)
+		if isBundle {
+			if err = orm.validateBundleTx(ctx, bundle, txRequest, chainID); err != nil {
+				return pkgerrors.Wrap(err, "CreateEthTransaction invalid bundle")
+			}
+		}
+
+		dbEtx, err = orm.insertTx(ctx, txRequest, chainID)
+		if err != nil || !isBundle {
+			return err
+		}
+
+		if _, err = orm.q.ExecContext(ctx, `INSERT INTO evm.tx_bundles (eth_tx_id, bundle_id, bundle_index, bundle_size) VALUES ($1, $2, $3, $4)`, dbEtx.ID, bundle.ID, bundle.Index, bundle.Size); err != nil {
+			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx bundle")
+		}
+		return nil
+	})
+	var etx Tx
+	dbEtx.ToTx(&etx)
+	return etx, err
+}
+
+func (o *evmTxStore) insertTx(ctx context.Context, txRequest TxRequest, chainID *big.Int) (dbEtx DbEthTx, err error) {
+	err = o.q.GetContext(ctx, &dbEtx, `
 INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
 VALUES (
 $1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
 )
 RETURNING "txes".*
 `, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+	if err != nil {
+		return dbEtx, pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
+	}
+	if s, ok := txRequest.Strategy.(MaxGasPriceStrategy); ok && s.MaxGasPrice != nil {
+		err = o.q.GetContext(ctx, &dbEtx, `
+UPDATE evm.txes SET meta = jsonb_set(COALESCE(meta, '{}'::jsonb), $1, to_jsonb($2::text))
+WHERE id = $3
+RETURNING "txes".*
+`, pq.StringArray{maxGasPriceMetaKey}, s.MaxGasPrice.ToInt().String(), dbEtx.ID)
 		if err != nil {
-			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
+			return dbEtx, pkgerrors.Wrap(err, "CreateEthTransaction failed to record max gas price")
 		}
-		return nil
-	})
-	var etx Tx
-	dbEtx.ToTx(&etx)
-	return etx, err
+	}
+	return dbEtx, nil
+}
+
+// validateBundleTx checks that the transaction fits the bundle, is sent from the same key as the transactions of the
+// bundle created so far, and that the bundle was not aborted.
+func (o *evmTxStore) validateBundleTx(ctx context.Context, bundle BundleStrategy, txRequest TxRequest, chainID *big.Int) error {
+	if err := bundle.validate(); err != nil {
+		return err
+	}
+	var check struct {
+		Mismatched bool
+		Aborted    bool
+	}
+	err := o.q.GetContext(ctx, &check, `
+SELECT
+	COALESCE(bool_or(t.from_address <> $3 OR b.bundle_size <> $4), false) AS mismatched,
+	COALESCE(bool_or(t.state = 'fatal_error'), false) AS aborted
+FROM evm.tx_bundles b
+JOIN evm.txes t ON t.id = b.eth_tx_id
+WHERE b.bundle_id = $1 AND t.evm_chain_id = $2`, bundle.ID, chainID.String(), txRequest.FromAddress, bundle.Size)
+	if err != nil {
+		return fmt.Errorf("failed to check bundle %s: %w", bundle.ID, err)
+	}
+	if check.Mismatched {
+		return fmt.Errorf("transaction %d of bundle %s is sent from %s or has a size of %d, which does not match the rest of the bundle", bundle.Index, bundle.ID, txRequest.FromAddress, bundle.Size)
+	}
+	if check.Aborted {
+		// the rest of the bundle would otherwise be sent without its failed transactions
+		return fmt.Errorf("bundle %s was aborted, submit it again with another ID", bundle.ID)
+	}
+	return nil
+}
+
+// abortIncompleteBundles aborts the unsent transactions of the bundles of chainID that were not created in full by
+// createdBefore, e.g. because the queue of their key filled up or the node stopped while creating them.
+func (o *evmTxStore) abortIncompleteBundles(ctx context.Context, createdBefore time.Time, chainID *big.Int) error {
+	res, err := o.q.ExecContext(ctx, `
+WITH incomplete AS (
+	SELECT b.bundle_id FROM evm.tx_bundles b
+	JOIN evm.txes t ON t.id = b.eth_tx_id
+	WHERE t.evm_chain_id = $1
+	GROUP BY b.bundle_id
+	HAVING count(*) < max(b.bundle_size) AND min(t.created_at) < $2 AND bool_or(t.state = 'unstarted')
+)
+UPDATE evm.txes SET state = 'fatal_error', error = $3
+FROM evm.tx_bundles b, incomplete
+WHERE b.eth_tx_id = evm.txes.id AND b.bundle_id = incomplete.bundle_id AND evm.txes.state = 'unstarted' AND evm.txes.evm_chain_id = $1`,
+		chainID.String(), createdBefore, bundleIncompleteError)
+	if err != nil {
+		return fmt.Errorf("failed to abort incomplete bundles: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n > 0 {
+		o.logger.Warnw("Aborted the transactions of incomplete bundles", "count", n)
+	}
+	return nil
 }
 
 func (o *evmTxStore) PruneUnstartedTxQueue(ctx context.Context, queueSize uint32, subject uuid.UUID) (ids []int64, err error) {
@@ -1720,6 +1845,11 @@ func (o *evmTxStore) ReapTxHistory(ctx context.Context, timeThreshold time.Time,
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 
+	// Abort bundles which will never be sent in full
+	if err := o.abortIncompleteBundles(ctx, time.Now().Add(-bundleCreationTimeout), chainID); err != nil {
+		return pkgerrors.Wrap(err, "TxmReaper#reapEthTxes failed")
+	}
+
 	// Delete old confirmed evm.txes
 	// NOTE that this relies on foreign key triggers automatically removing
 	// the evm.tx_attempts and evm.receipts linked to every eth_tx
@@ -2068,9 +2198,29 @@ func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etxIDs []int64, err
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
-	sql := `UPDATE evm.txes SET state = 'fatal_error', error = $1 WHERE id = ANY($2)`
-	_, err := o.q.ExecContext(ctx, sql, errMsg, pq.Array(etxIDs))
-	return err
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		sql := `UPDATE evm.txes SET state = 'fatal_error', error = $1 WHERE id = ANY($2)`
+		if _, err := orm.q.ExecContext(ctx, sql, errMsg, pq.Array(etxIDs)); err != nil {
+			return err
+		}
+		return orm.abortBundles(ctx, etxIDs)
+	})
+}
+
+// abortBundles marks the unsent transactions of the bundles of the failed transactions as fatally errored, as a bundle
+// is never sent in part once one of its transactions has failed.
+func (o *evmTxStore) abortBundles(ctx context.Context, failedIDs []int64) error {
+	_, err := o.q.ExecContext(ctx, `
+UPDATE evm.txes SET state = 'fatal_error', error = $2
+WHERE state = 'unstarted' AND id IN (
+	SELECT b.eth_tx_id FROM evm.tx_bundles fb
+	JOIN evm.tx_bundles b ON b.bundle_id = fb.bundle_id AND b.bundle_index > fb.bundle_index
+	WHERE fb.eth_tx_id = ANY($1)
+)`, pq.Array(failedIDs), bundleAbortedError)
+	if err != nil {
+		return fmt.Errorf("failed to abort bundles: %w", err)
+	}
+	return nil
 }
 
 func (o *evmTxStore) FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID *big.Int) (etxs []*Tx, err error) {
@@ -2086,3 +2236,108 @@ func (o *evmTxStore) FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID
 	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
 	return
 }
+
+// GasSpendFilter selects the transactions counted by GasSpend. Nil fields match all transactions.
+type GasSpendFilter struct {
+	FromAddress *common.Address
+	JobID       *int32
+	Since       time.Time
+}
+
+// hexToNumericSQL converts the hex encoded uint64 receipt field %s to numeric, or NULL if it is missing.
+const hexToNumericSQL = `('x' || lpad(substr(%s, 3), 16, '0'))::bit(64)::bigint::numeric`
+
+// GasSpend returns the fees, in wei, of the transactions matching the filter: the fees paid by the transactions mined
+// since filter.Since, plus the most the pending transactions can pay, at the gas limit and highest price of their
+// attempts.
+func (o *evmTxStore) GasSpend(ctx context.Context, filter GasSpendFilter, chainID *big.Int) (*big.Int, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	gasUsed := fmt.Sprintf(hexToNumericSQL, "receipt->>'gasUsed'")
+	effectiveGasPrice := fmt.Sprintf(hexToNumericSQL, "receipt->>'effectiveGasPrice'")
+	var spend string
+	// receipts of chains predating EIP-1559 have no effective gas price, the attempt paid what it offered
+	err := o.q.GetContext(ctx, &spend, `
+WITH mined AS (
+	SELECT DISTINCT ON (t.id) t.id, r.receipt, a.gas_price, a.gas_fee_cap FROM evm.receipts r
+	JOIN evm.tx_attempts a ON a.hash = r.tx_hash
+	JOIN evm.txes t ON t.id = a.eth_tx_id
+	WHERE t.evm_chain_id = $1 AND r.created_at >= $2
+	AND ($3::bytea IS NULL OR t.from_address = $3)
+	AND ($4::int IS NULL OR (t.meta->>'JobID')::int = $4)
+	ORDER BY t.id, r.block_number DESC
+), pending AS (
+	SELECT t.gas_limit * MAX(COALESCE(a.gas_price, a.gas_fee_cap)) AS cost FROM evm.txes t
+	JOIN evm.tx_attempts a ON a.eth_tx_id = t.id
+	WHERE t.evm_chain_id = $1 AND t.state IN ('in_progress', 'unconfirmed', 'confirmed_missing_receipt')
+	AND ($3::bytea IS NULL OR t.from_address = $3)
+	AND ($4::int IS NULL OR (t.meta->>'JobID')::int = $4)
+	AND NOT EXISTS (SELECT 1 FROM mined m WHERE m.id = t.id)
+	GROUP BY t.id, t.gas_limit
+)
+SELECT (
+	COALESCE((SELECT SUM(`+gasUsed+` * COALESCE(`+effectiveGasPrice+`, gas_price, gas_fee_cap)) FROM mined), 0) +
+	COALESCE((SELECT SUM(cost) FROM pending), 0)
+)::text`, chainID.String(), filter.Since, filter.FromAddress, filter.JobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gas spend: %w", err)
+	}
+
+	spent, ok := new(big.Int).SetString(spend, 10)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse gas spend %q", spend)
+	}
+	return spent, nil
+}
+
+type dbFromAddressCount struct {
+	FromAddress common.Address
+	Count       uint32
+}
+
+// CountPendingTransactionsByFromAddress returns the number of transactions not yet confirmed of each address, which
+// is zero for the addresses missing from the map.
+func (o *evmTxStore) CountPendingTransactionsByFromAddress(ctx context.Context, addresses []common.Address, chainID *big.Int) (map[common.Address]uint32, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	addrsBytea := make([][]byte, len(addresses))
+	for i, addr := range addresses {
+		addrsBytea[i] = addr.Bytes()
+	}
+	var rows []dbFromAddressCount
+	err := o.q.SelectContext(ctx, &rows, `
+SELECT from_address, count(*) AS count FROM evm.txes
+WHERE from_address = ANY($1) AND evm_chain_id = $2 AND state IN ('unstarted', 'in_progress', 'unconfirmed')
+GROUP BY from_address`, addrsBytea, chainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("CountPendingTransactionsByFromAddress failed: %w", err)
+	}
+	counts := make(map[common.Address]uint32, len(rows))
+	for _, row := range rows {
+		counts[row.FromAddress] = row.Count
+	}
+	return counts, nil
+}
+
+// FindFromAddressesWithStuckTransactions returns the addresses with an unconfirmed transaction that the stuck
+// transaction detector is purging.
+func (o *evmTxStore) FindFromAddressesWithStuckTransactions(ctx context.Context, addresses []common.Address, chainID *big.Int) ([]common.Address, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	addrsBytea := make([][]byte, len(addresses))
+	for i, addr := range addresses {
+		addrsBytea[i] = addr.Bytes()
+	}
+	var stuck []common.Address
+	err := o.q.SelectContext(ctx, &stuck, `
+SELECT DISTINCT t.from_address FROM evm.txes t
+JOIN evm.tx_attempts a ON a.eth_tx_id = t.id
+WHERE t.from_address = ANY($1) AND t.evm_chain_id = $2 AND t.state = 'unconfirmed' AND a.is_purge_attempt`, addrsBytea, chainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("FindFromAddressesWithStuckTransactions failed: %w", err)
+	}
+	return stuck, nil
+}
@@ -2,6 +2,7 @@ package txmgr_test
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"testing"
@@ -1230,6 +1231,239 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 	})
 }
 
+func TestORM_FindNextUnstartedTransactionFromAddress_Bundles(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := testutils.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	chainID := testutils.FixtureChainID
+
+	newRequest := func(fromAddress common.Address, value int64) txmgr.TxRequest {
+		return txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Value:          *big.NewInt(value),
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		}
+	}
+
+	bundleTxIDs := func(t *testing.T, bundleID string) (ids []int64) {
+		require.NoError(t, db.SelectContext(ctx, &ids, `SELECT eth_tx_id FROM evm.tx_bundles WHERE bundle_id = $1 ORDER BY bundle_index`, bundleID))
+		return ids
+	}
+
+	markStarted := func(t *testing.T, id int64, nonce int64) {
+		_, err := db.ExecContext(ctx, `UPDATE evm.txes SET state = 'unconfirmed', nonce = $1, broadcast_at = NOW(), initial_broadcast_at = NOW() WHERE id = $2`, nonce, id)
+		require.NoError(t, err)
+	}
+
+	createBundle := func(t *testing.T, bundleID string, reqs ...txmgr.TxRequest) (ids []int64) {
+		for i, req := range reqs {
+			req.Strategy = txmgr.NewBundleStrategy(bundleID, i, len(reqs))
+			etx, err := txStore.CreateTransaction(ctx, req, chainID)
+			require.NoError(t, err)
+			ids = append(ids, etx.ID)
+		}
+		require.Equal(t, ids, bundleTxIDs(t, bundleID))
+		return ids
+	}
+
+	t.Run("sends bundles in order and back to back", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+		// the later transactions of the bundle have lower values, which would otherwise be sent first
+		ids := createBundle(t, "bundle", newRequest(fromAddress, 10), newRequest(fromAddress, 5), newRequest(fromAddress, 0))
+
+		other, err := txStore.CreateTransaction(ctx, newRequest(fromAddress, 0), chainID)
+		require.NoError(t, err)
+
+		etx, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID)
+		require.NoError(t, err)
+		require.Equal(t, other.ID, etx.ID)
+		markStarted(t, etx.ID, 0)
+
+		for i, id := range ids {
+			etx, err = txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID)
+			require.NoError(t, err)
+			require.Equal(t, id, etx.ID)
+			markStarted(t, etx.ID, int64(i+1))
+		}
+
+		_, err = txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("waits for all transactions of a bundle", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+		first := newRequest(fromAddress, 0)
+		first.Strategy = txmgr.NewBundleStrategy("partial-bundle", 0, 2)
+		etx, err := txStore.CreateTransaction(ctx, first, chainID)
+		require.NoError(t, err)
+
+		_, err = txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		second := newRequest(fromAddress, 0)
+		second.Strategy = txmgr.NewBundleStrategy("partial-bundle", 1, 2)
+		_, err = txStore.CreateTransaction(ctx, second, chainID)
+		require.NoError(t, err)
+
+		next, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID)
+		require.NoError(t, err)
+		require.Equal(t, etx.ID, next.ID)
+	})
+
+	t.Run("aborts the rest of a bundle after a fatal error", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+		ids := createBundle(t, "failing-bundle", newRequest(fromAddress, 0), newRequest(fromAddress, 0))
+
+		require.NoError(t, txStore.UpdateTxFatalError(ctx, ids[:1], "reverted"))
+
+		_, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		aborted, err := txStore.FindTxWithAttempts(ctx, ids[1])
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, aborted.State)
+		assert.Equal(t, "bundle aborted: an earlier transaction of the bundle failed", aborted.Error.String)
+	})
+
+	t.Run("aborts bundles that are not created in full", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+		first := newRequest(fromAddress, 0)
+		first.Strategy = txmgr.NewBundleStrategy("stale-bundle", 0, 2)
+		stale, err := txStore.CreateTransaction(ctx, first, chainID)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, `UPDATE evm.txes SET created_at = NOW() - interval '1 hour' WHERE id = $1`, stale.ID)
+		require.NoError(t, err)
+
+		first.Strategy = txmgr.NewBundleStrategy("recent-bundle", 0, 2)
+		recent, err := txStore.CreateTransaction(ctx, first, chainID)
+		require.NoError(t, err)
+
+		require.NoError(t, txStore.ReapTxHistory(ctx, time.Unix(0, 0), chainID))
+
+		aborted, err := txStore.FindTxWithAttempts(ctx, stale.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, aborted.State)
+		assert.Equal(t, "bundle aborted: not all of its transactions were created", aborted.Error.String)
+
+		waiting, err := txStore.FindTxWithAttempts(ctx, recent.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, waiting.State)
+
+		second := newRequest(fromAddress, 0)
+		second.Strategy = txmgr.NewBundleStrategy("stale-bundle", 1, 2)
+		_, err = txStore.CreateTransaction(ctx, second, chainID)
+		require.ErrorContains(t, err, "bundle stale-bundle was aborted")
+	})
+
+	t.Run("rejects bundles sent from several keys", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+		createBundle(t, "invalid-bundle", newRequest(fromAddress, 0))
+
+		other := newRequest(testutils.NewAddress(), 0)
+		other.Strategy = txmgr.NewBundleStrategy("invalid-bundle", 1, 1)
+		_, err := txStore.CreateTransaction(ctx, other, chainID)
+		require.Error(t, err)
+
+		other.Strategy = txmgr.NewBundleStrategy("invalid-bundle", 1, 2)
+		_, err = txStore.CreateTransaction(ctx, other, chainID)
+		require.ErrorContains(t, err, "does not match the rest of the bundle")
+	})
+}
+
+func TestORM_FindNextUnstartedTransactionFromAddressExcludingJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := testutils.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	jobID := int32(7)
+	jobTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{1, 2, 3},
+		FeeLimit:       21000,
+		Meta:           &txmgr.TxMeta{JobID: &jobID},
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}, testutils.FixtureChainID)
+	otherTx := mustCreateUnstartedTx(t, txStore, fromAddress, testutils.NewAddress(), []byte{1, 2, 3}, 21000, *big.NewInt(1), testutils.FixtureChainID)
+
+	etx, err := txStore.FindNextUnstartedTransactionFromAddressExcludingJobs(ctx, fromAddress, testutils.FixtureChainID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, jobTx.ID, etx.ID)
+
+	etx, err = txStore.FindNextUnstartedTransactionFromAddressExcludingJobs(ctx, fromAddress, testutils.FixtureChainID, []int32{jobID})
+	require.NoError(t, err)
+	assert.Equal(t, otherTx.ID, etx.ID)
+
+	_, err = db.ExecContext(ctx, `UPDATE evm.txes SET state = 'unconfirmed', nonce = 0, broadcast_at = NOW(), initial_broadcast_at = NOW() WHERE id = $1`, otherTx.ID)
+	require.NoError(t, err)
+	_, err = txStore.FindNextUnstartedTransactionFromAddressExcludingJobs(ctx, fromAddress, testutils.FixtureChainID, []int32{jobID})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_GasSpend(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := testutils.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	start := time.Now().Add(-time.Minute)
+
+	// each receipt pays 123 gas at an effective price of 55 wei
+	jobEtx := mustInsertConfirmedEthTxWithReceipt(t, txStore, fromAddress, 0, 10)
+	mustInsertConfirmedEthTxWithReceipt(t, txStore, fromAddress, 1, 11)
+	mustInsertConfirmedEthTxWithReceipt(t, txStore, otherAddress, 0, 12)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 2, 13, fromAddress)
+	_, err := db.ExecContext(ctx, `UPDATE evm.txes SET meta = '{"JobID": 7}' WHERE id = $1`, jobEtx.ID)
+	require.NoError(t, err)
+	jobID := int32(7)
+	// a transaction is counted once, even with receipts from several blocks
+	mustInsertEthReceipt(t, txStore, 14, utils.NewHash(), jobEtx.TxAttempts[0].Hash)
+
+	// pending transactions count for the most they can pay, here 1000 gas at 2 wei
+	pendingEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+	_, err = db.ExecContext(ctx, `UPDATE evm.txes SET gas_limit = 1000 WHERE id = $1`, pendingEtx.ID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `UPDATE evm.tx_attempts SET gas_price = 2 WHERE eth_tx_id = $1`, pendingEtx.ID)
+	require.NoError(t, err)
+	pending := int64(1000 * 2)
+
+	for _, tc := range []struct {
+		name   string
+		filter txmgr.GasSpendFilter
+		spend  int64
+	}{
+		{name: "chain", filter: txmgr.GasSpendFilter{Since: start}, spend: 3*123*55 + pending},
+		{name: "key", filter: txmgr.GasSpendFilter{FromAddress: &fromAddress, Since: start}, spend: 2*123*55 + pending},
+		{name: "other key", filter: txmgr.GasSpendFilter{FromAddress: &otherAddress, Since: start}, spend: 123 * 55},
+		{name: "job", filter: txmgr.GasSpendFilter{JobID: &jobID, Since: start}, spend: 123 * 55},
+		{name: "outside window", filter: txmgr.GasSpendFilter{Since: time.Now().Add(time.Minute)}, spend: pending},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			spend, err := txStore.GasSpend(ctx, tc.filter, testutils.FixtureChainID)
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(tc.spend), spend)
+		})
+	}
+}
+
 func TestORM_UpdateTxFatalErrorAndDeleteAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -1771,6 +2005,26 @@ func TestORM_CreateTransaction(t *testing.T) {
 		assert.Equal(t, fromAddress, dbEthTx.FromAddress)
 		assert.True(t, dbEthTx.SignalCallback)
 	})
+
+	t.Run("records the max gas price in the meta", func(t *testing.T) {
+		etx, err := txStore.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			FeeLimit:       gasLimit,
+			Meta:           &txmgr.TxMeta{JobID: ptr(int32(1))},
+			Strategy:       txmgr.NewMaxGasPriceStrategy(txmgrcommon.NewSendEveryStrategy(), assets.NewWeiI(100)),
+		}, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+
+		require.NotNil(t, etx.Meta)
+		var raw map[string]interface{}
+		require.NoError(t, json.Unmarshal(*etx.Meta, &raw))
+		assert.Equal(t, "100", raw["MaxGasPriceWei"])
+		meta, err := etx.GetMeta()
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), *meta.JobID)
+	})
 }
 
 func TestORM_PruneUnstartedTxQueue(t *testing.T) {
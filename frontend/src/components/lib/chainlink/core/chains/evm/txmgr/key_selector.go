@@ -0,0 +1,208 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// keyBalanceCacheTTL is how long the balance of a key is reused by the highest-balance strategy before it is fetched
+// again.
+const keyBalanceCacheTTL = 15 * time.Second
+
+type KeySelectionStrategy string
+
+const (
+	// KeySelectionRoundRobin sends from the least recently used key.
+	KeySelectionRoundRobin KeySelectionStrategy = "round-robin"
+	// KeySelectionLeastPending sends from the key with the fewest transactions not yet confirmed.
+	KeySelectionLeastPending KeySelectionStrategy = "least-pending"
+	// KeySelectionHighestBalance sends from the key with the highest balance.
+	KeySelectionHighestBalance KeySelectionStrategy = "highest-balance"
+)
+
+// ParseKeySelectionStrategy returns the strategy named s, or round-robin if s is empty.
+func ParseKeySelectionStrategy(s string) (KeySelectionStrategy, error) {
+	switch strategy := KeySelectionStrategy(s); strategy {
+	case "":
+		return KeySelectionRoundRobin, nil
+	case KeySelectionRoundRobin, KeySelectionLeastPending, KeySelectionHighestBalance:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown key selection strategy %q, must be one of %q, %q or %q", s, KeySelectionRoundRobin, KeySelectionLeastPending, KeySelectionHighestBalance)
+	}
+}
+
+// KeySelection configures how the sending key of a transaction is picked among several keys.
+type KeySelection struct {
+	ChainID  *big.Int
+	Strategy KeySelectionStrategy
+	// ExcludeStuck skips the keys with a transaction that the stuck transaction detector is purging, which requires
+	// the auto-purge feature to be enabled on the chain.
+	ExcludeStuck bool
+}
+
+// KeySelectionForChain returns the selection configured for chainID, or round-robin if there is none.
+func KeySelectionForChain(selections []KeySelection, chainID *big.Int) KeySelection {
+	for _, s := range selections {
+		if s.ChainID != nil && s.ChainID.Cmp(chainID) == 0 {
+			return s
+		}
+	}
+	return KeySelection{ChainID: chainID, Strategy: KeySelectionRoundRobin}
+}
+
+type keySelectorTxStore interface {
+	CountPendingTransactionsByFromAddress(ctx context.Context, addresses []common.Address, chainID *big.Int) (map[common.Address]uint32, error)
+	FindFromAddressesWithStuckTransactions(ctx context.Context, addresses []common.Address, chainID *big.Int) ([]common.Address, error)
+}
+
+// NextAddressFunc returns the least recently used of the enabled addresses.
+type NextAddressFunc func(ctx context.Context, addresses ...common.Address) (common.Address, error)
+
+// KeySelector narrows down the sending keys a transaction can be sent from according to a KeySelection, leaving the
+// pick among the remaining keys to round-robin.
+type KeySelector struct {
+	lggr      logger.SugaredLogger
+	chainID   *big.Int
+	txStore   keySelectorTxStore
+	balances  *keyBalances
+	selection KeySelection
+}
+
+type keyBalance struct {
+	balance   *big.Int
+	fetchedAt time.Time
+}
+
+// keyBalances caches the balances of the keys, which are shared by the copies of a KeySelector.
+type keyBalances struct {
+	balanceAt func(ctx context.Context, address common.Address) (*big.Int, error)
+
+	mu       sync.Mutex
+	balances map[common.Address]keyBalance
+}
+
+// get returns the balance of address, fetching it again once the cached value expires.
+func (b *keyBalances) get(ctx context.Context, address common.Address) (*big.Int, error) {
+	b.mu.Lock()
+	cached, ok := b.balances[address]
+	b.mu.Unlock()
+	now := time.Now()
+	if ok && now.Sub(cached.fetchedAt) < keyBalanceCacheTTL {
+		return cached.balance, nil
+	}
+
+	balance, err := b.balanceAt(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	b.balances[address] = keyBalance{balance: balance, fetchedAt: now}
+	b.mu.Unlock()
+	return balance, nil
+}
+
+// NewKeySelector creates a new KeySelector instance.
+func NewKeySelector(lggr logger.Logger, chainID *big.Int, txStore keySelectorTxStore, balanceAt func(ctx context.Context, address common.Address) (*big.Int, error), selection KeySelection) *KeySelector {
+	return &KeySelector{
+		lggr:      logger.Sugared(logger.Named(lggr, "KeySelector")),
+		chainID:   chainID,
+		txStore:   txStore,
+		balances:  &keyBalances{balanceAt: balanceAt, balances: make(map[common.Address]keyBalance)},
+		selection: selection,
+	}
+}
+
+// Selection returns the key selection applied by s.
+func (s *KeySelector) Selection() KeySelection {
+	return s.selection
+}
+
+// WithSelection returns a copy of s applying selection instead.
+func (s *KeySelector) WithSelection(selection KeySelection) *KeySelector {
+	c := *s
+	c.selection = selection
+	return &c
+}
+
+// SelectAddress picks the sending key among addresses. The keys left by the strategy are passed to next, so that
+// ties go to the least recently used key. Failing to load the state of the keys falls back to round-robin over all
+// of them, rather than holding the transaction back.
+func (s *KeySelector) SelectAddress(ctx context.Context, addresses []common.Address, next NextAddressFunc) (common.Address, error) {
+	if len(addresses) < 2 || (s.selection.Strategy == KeySelectionRoundRobin && !s.selection.ExcludeStuck) {
+		return next(ctx, addresses...)
+	}
+
+	candidates, err := s.candidates(ctx, addresses)
+	if err != nil {
+		s.lggr.Warnw("Failed to select sending key, falling back to round-robin", "strategy", s.selection.Strategy, "err", err)
+		return next(ctx, addresses...)
+	}
+	return next(ctx, candidates...)
+}
+
+func (s *KeySelector) candidates(ctx context.Context, addresses []common.Address) ([]common.Address, error) {
+	candidates := addresses
+	if s.selection.ExcludeStuck {
+		stuck, err := s.txStore.FindFromAddressesWithStuckTransactions(ctx, addresses, s.chainID)
+		if err != nil {
+			return nil, err
+		}
+		candidates = slices.DeleteFunc(slices.Clone(addresses), func(a common.Address) bool {
+			return slices.Contains(stuck, a)
+		})
+		if len(candidates) == 0 {
+			s.lggr.Warnw("All sending keys have a stuck transaction", "addresses", addresses)
+			candidates = addresses
+		} else if len(stuck) > 0 {
+			s.lggr.Debugw("Skipping sending keys with a stuck transaction", "stuck", stuck)
+		}
+	}
+
+	switch s.selection.Strategy {
+	case KeySelectionLeastPending:
+		counts, err := s.txStore.CountPendingTransactionsByFromAddress(ctx, candidates, s.chainID)
+		if err != nil {
+			return nil, err
+		}
+		return best(candidates, func(a common.Address) *big.Int {
+			return new(big.Int).Neg(new(big.Int).SetUint64(uint64(counts[a])))
+		}), nil
+	case KeySelectionHighestBalance:
+		balances := make(map[common.Address]*big.Int, len(candidates))
+		for _, a := range candidates {
+			balance, err := s.balances.get(ctx, a)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get balance of %s: %w", a, err)
+			}
+			balances[a] = balance
+		}
+		return best(candidates, func(a common.Address) *big.Int { return balances[a] }), nil
+	default:
+		return candidates, nil
+	}
+}
+
+// best returns the addresses with the highest score.
+func best(addresses []common.Address, score func(common.Address) *big.Int) []common.Address {
+	var top []common.Address
+	var topScore *big.Int
+	for _, a := range addresses {
+		sc := score(a)
+		switch {
+		case topScore == nil || sc.Cmp(topScore) > 0:
+			top, topScore = []common.Address{a}, sc
+		case sc.Cmp(topScore) == 0:
+			top = append(top, a)
+		}
+	}
+	return top
+}
@@ -0,0 +1,165 @@
+package txmgr_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestParseKeySelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	s, err := txmgr.ParseKeySelectionStrategy("")
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.KeySelectionRoundRobin, s)
+
+	s, err = txmgr.ParseKeySelectionStrategy("least-pending")
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.KeySelectionLeastPending, s)
+
+	_, err = txmgr.ParseKeySelectionStrategy("random")
+	require.EqualError(t, err, `unknown key selection strategy "random", must be one of "round-robin", "least-pending" or "highest-balance"`)
+}
+
+func TestKeySelectionForChain(t *testing.T) {
+	t.Parallel()
+
+	selections := []txmgr.KeySelection{{ChainID: big.NewInt(1), Strategy: txmgr.KeySelectionLeastPending, ExcludeStuck: true}}
+	assert.Equal(t, selections[0], txmgr.KeySelectionForChain(selections, big.NewInt(1)))
+	assert.Equal(t, txmgr.KeySelection{ChainID: big.NewInt(2), Strategy: txmgr.KeySelectionRoundRobin}, txmgr.KeySelectionForChain(selections, big.NewInt(2)))
+}
+
+func TestKeySelector_SelectAddress(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1)
+	a, b, c := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	addresses := []common.Address{a, b, c}
+	balances := map[common.Address]*big.Int{a: big.NewInt(10), b: big.NewInt(30), c: big.NewInt(30)}
+	balanceAt := func(_ context.Context, address common.Address) (*big.Int, error) {
+		return balances[address], nil
+	}
+
+	// next returns the first of the addresses it is given, recording them.
+	newNext := func() (txmgr.NextAddressFunc, *[]common.Address) {
+		var given []common.Address
+		return func(_ context.Context, addresses ...common.Address) (common.Address, error) {
+			given = addresses
+			return addresses[0], nil
+		}, &given
+	}
+
+	t.Run("round-robin does not load the keys", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		selector := txmgr.NewKeySelector(logger.Test(t), chainID, txStore, balanceAt, txmgr.KeySelection{Strategy: txmgr.KeySelectionRoundRobin})
+		next, given := newNext()
+
+		addr, err := selector.SelectAddress(tests.Context(t), addresses, next)
+		require.NoError(t, err)
+		assert.Equal(t, a, addr)
+		assert.Equal(t, addresses, *given)
+	})
+
+	t.Run("least-pending picks the key with the fewest pending transactions", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("CountPendingTransactionsByFromAddress", mock.Anything, addresses, chainID).Return(map[common.Address]uint32{a: 3, b: 1}, nil).Once()
+		selector := txmgr.NewKeySelector(logger.Test(t), chainID, txStore, balanceAt, txmgr.KeySelection{Strategy: txmgr.KeySelectionLeastPending})
+		next, given := newNext()
+
+		addr, err := selector.SelectAddress(tests.Context(t), addresses, next)
+		require.NoError(t, err)
+		assert.Equal(t, c, addr)
+		assert.Equal(t, []common.Address{c}, *given)
+	})
+
+	t.Run("highest-balance leaves ties to round-robin", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		selector := txmgr.NewKeySelector(logger.Test(t), chainID, txStore, balanceAt, txmgr.KeySelection{Strategy: txmgr.KeySelectionHighestBalance})
+		next, given := newNext()
+
+		addr, err := selector.SelectAddress(tests.Context(t), addresses, next)
+		require.NoError(t, err)
+		assert.Equal(t, b, addr)
+		assert.Equal(t, []common.Address{b, c}, *given)
+	})
+
+	t.Run("highest-balance caches the balances", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		var calls int
+		countingBalanceAt := func(ctx context.Context, address common.Address) (*big.Int, error) {
+			calls++
+			return balanceAt(ctx, address)
+		}
+		selector := txmgr.NewKeySelector(logger.Test(t), chainID, txStore, countingBalanceAt, txmgr.KeySelection{Strategy: txmgr.KeySelectionHighestBalance})
+		next, _ := newNext()
+
+		for range 2 {
+			addr, err := selector.SelectAddress(tests.Context(t), addresses, next)
+			require.NoError(t, err)
+			assert.Equal(t, b, addr)
+		}
+		// copies made for the jobs share the cache
+		_, err := selector.WithSelection(selector.Selection()).SelectAddress(tests.Context(t), addresses, next)
+		require.NoError(t, err)
+		assert.Equal(t, len(addresses), calls)
+	})
+
+	t.Run("excludes keys with a stuck transaction", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("FindFromAddressesWithStuckTransactions", mock.Anything, addresses, chainID).Return([]common.Address{c}, nil).Once()
+		selector := txmgr.NewKeySelector(logger.Test(t), chainID, txStore, balanceAt, txmgr.KeySelection{Strategy: txmgr.KeySelectionHighestBalance, ExcludeStuck: true})
+		next, given := newNext()
+
+		addr, err := selector.SelectAddress(tests.Context(t), addresses, next)
+		require.NoError(t, err)
+		assert.Equal(t, b, addr)
+		assert.Equal(t, []common.Address{b}, *given)
+	})
+
+	t.Run("uses all keys if they are all stuck", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("FindFromAddressesWithStuckTransactions", mock.Anything, addresses, chainID).Return(addresses, nil).Once()
+		selector := txmgr.NewKeySelector(logger.Test(t), chainID, txStore, balanceAt, txmgr.KeySelection{Strategy: txmgr.KeySelectionRoundRobin, ExcludeStuck: true})
+		next, given := newNext()
+
+		_, err := selector.SelectAddress(tests.Context(t), addresses, next)
+		require.NoError(t, err)
+		assert.Equal(t, addresses, *given)
+	})
+
+	t.Run("falls back to round-robin on error", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("CountPendingTransactionsByFromAddress", mock.Anything, addresses, chainID).Return(nil, errors.New("connection refused")).Once()
+		selector := txmgr.NewKeySelector(logger.Test(t), chainID, txStore, balanceAt, txmgr.KeySelection{Strategy: txmgr.KeySelectionLeastPending})
+		next, given := newNext()
+
+		_, err := selector.SelectAddress(tests.Context(t), addresses, next)
+		require.NoError(t, err)
+		assert.Equal(t, addresses, *given)
+	})
+
+	t.Run("job selection overrides the chain selection", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		selector := txmgr.NewKeySelector(logger.Test(t), chainID, txStore, balanceAt, txmgr.KeySelection{Strategy: txmgr.KeySelectionLeastPending})
+		selector = selector.WithSelection(txmgr.KeySelection{Strategy: txmgr.KeySelectionHighestBalance})
+		next, _ := newNext()
+
+		addr, err := selector.SelectAddress(tests.Context(t), addresses, next)
+		require.NoError(t, err)
+		assert.Equal(t, b, addr)
+		assert.Equal(t, txmgr.KeySelectionHighestBalance, selector.Selection().Strategy)
+	})
+}
@@ -0,0 +1,57 @@
+package txmgr
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+)
+
+// maxGasPriceMetaKey is the key of the transaction meta under which the tx store records the maximum gas price of a
+// transaction created with MaxGasPriceStrategy.
+const maxGasPriceMetaKey = "MaxGasPriceWei"
+
+var _ txmgrtypes.TxStrategy = MaxGasPriceStrategy{}
+
+// MaxGasPriceStrategy wraps the strategy of a transaction that must not be sent, or bumped, above MaxGasPrice. The
+// key specific maximum gas price still applies if it is lower.
+type MaxGasPriceStrategy struct {
+	txmgrtypes.TxStrategy
+	MaxGasPrice *assets.Wei
+}
+
+// NewMaxGasPriceStrategy returns strategy, capping the gas price of the transaction at maxGasPrice.
+func NewMaxGasPriceStrategy(strategy txmgrtypes.TxStrategy, maxGasPrice *assets.Wei) txmgrtypes.TxStrategy {
+	return MaxGasPriceStrategy{TxStrategy: strategy, MaxGasPrice: maxGasPrice}
+}
+
+// txMaxGasPrice returns the lower of the key specific maximum gas price and the maximum gas price recorded for etx.
+func txMaxGasPrice(priceMaxKey func(common.Address) *assets.Wei, etx Tx) *assets.Wei {
+	keyMax := priceMaxKey(etx.FromAddress)
+	if etx.Meta == nil {
+		return keyMax
+	}
+	var meta map[string]json.RawMessage
+	if err := json.Unmarshal(*etx.Meta, &meta); err != nil {
+		return keyMax
+	}
+	raw, ok := meta[maxGasPriceMetaKey]
+	if !ok {
+		return keyMax
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return keyMax
+	}
+	wei, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return keyMax
+	}
+	if txMax := assets.NewWei(wei); txMax.Cmp(keyMax) < 0 {
+		return txMax
+	}
+	return keyMax
+}
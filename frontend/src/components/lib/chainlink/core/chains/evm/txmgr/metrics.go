@@ -35,6 +35,22 @@ var (
 		Name: "tx_manager_num_finalized_transactions",
 		Help: "Total number of finalized transactions",
 	}, []string{"chainID"})
+	promGasSpend = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_gas_spend_wei",
+		Help: "Fees paid in wei over the window of a gas spend budget",
+	}, []string{"chainID", "jobID", "key", "action"})
+	promGasSpendLimit = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_gas_spend_limit_wei",
+		Help: "Limit in wei of a gas spend budget",
+	}, []string{"chainID", "jobID", "key", "action"})
+	promGasSpendExceeded = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_gas_spend_budget_exceeded",
+		Help: "Set to 1 while the spend of a gas spend budget is over its limit",
+	}, []string{"chainID", "jobID", "key", "action"})
+	promGasSpendRejectedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_gas_spend_rejected_transactions",
+		Help: "Number of transactions refused because a gas spend budget was exceeded",
+	}, []string{"chainID", "jobID", "key", "action"})
 )
 
 type evmTxmMetrics struct {
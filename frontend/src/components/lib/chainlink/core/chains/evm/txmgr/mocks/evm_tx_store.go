@@ -18,6 +18,8 @@ import (
 
 	time "time"
 
+	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+
 	types "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
 
 	uuid "github.com/google/uuid"
@@ -165,6 +167,66 @@ func (_c *EvmTxStore_Close_Call) RunAndReturn(run func()) *EvmTxStore_Close_Call
 	return _c
 }
 
+// CountPendingTransactionsByFromAddress provides a mock function with given fields: ctx, addresses, chainID
+func (_m *EvmTxStore) CountPendingTransactionsByFromAddress(ctx context.Context, addresses []common.Address, chainID *big.Int) (map[common.Address]uint32, error) {
+	ret := _m.Called(ctx, addresses, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPendingTransactionsByFromAddress")
+	}
+
+	var r0 map[common.Address]uint32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, *big.Int) (map[common.Address]uint32, error)); ok {
+		return rf(ctx, addresses, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, *big.Int) map[common.Address]uint32); ok {
+		r0 = rf(ctx, addresses, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[common.Address]uint32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, addresses, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_CountPendingTransactionsByFromAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPendingTransactionsByFromAddress'
+type EvmTxStore_CountPendingTransactionsByFromAddress_Call struct {
+	*mock.Call
+}
+
+// CountPendingTransactionsByFromAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []common.Address
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) CountPendingTransactionsByFromAddress(ctx interface{}, addresses interface{}, chainID interface{}) *EvmTxStore_CountPendingTransactionsByFromAddress_Call {
+	return &EvmTxStore_CountPendingTransactionsByFromAddress_Call{Call: _e.mock.On("CountPendingTransactionsByFromAddress", ctx, addresses, chainID)}
+}
+
+func (_c *EvmTxStore_CountPendingTransactionsByFromAddress_Call) Run(run func(ctx context.Context, addresses []common.Address, chainID *big.Int)) *EvmTxStore_CountPendingTransactionsByFromAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]common.Address), args[2].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_CountPendingTransactionsByFromAddress_Call) Return(_a0 map[common.Address]uint32, _a1 error) *EvmTxStore_CountPendingTransactionsByFromAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_CountPendingTransactionsByFromAddress_Call) RunAndReturn(run func(context.Context, []common.Address, *big.Int) (map[common.Address]uint32, error)) *EvmTxStore_CountPendingTransactionsByFromAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountTransactionsByState provides a mock function with given fields: ctx, state, chainID
 func (_m *EvmTxStore) CountTransactionsByState(ctx context.Context, state types.TxState, chainID *big.Int) (uint32, error) {
 	ret := _m.Called(ctx, state, chainID)
@@ -724,6 +786,66 @@ func (_c *EvmTxStore_FindEarliestUnconfirmedTxAttemptBlock_Call) RunAndReturn(ru
 	return _c
 }
 
+// FindFromAddressesWithStuckTransactions provides a mock function with given fields: ctx, addresses, chainID
+func (_m *EvmTxStore) FindFromAddressesWithStuckTransactions(ctx context.Context, addresses []common.Address, chainID *big.Int) ([]common.Address, error) {
+	ret := _m.Called(ctx, addresses, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFromAddressesWithStuckTransactions")
+	}
+
+	var r0 []common.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, *big.Int) ([]common.Address, error)); ok {
+		return rf(ctx, addresses, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, *big.Int) []common.Address); ok {
+		r0 = rf(ctx, addresses, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, addresses, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindFromAddressesWithStuckTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFromAddressesWithStuckTransactions'
+type EvmTxStore_FindFromAddressesWithStuckTransactions_Call struct {
+	*mock.Call
+}
+
+// FindFromAddressesWithStuckTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []common.Address
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindFromAddressesWithStuckTransactions(ctx interface{}, addresses interface{}, chainID interface{}) *EvmTxStore_FindFromAddressesWithStuckTransactions_Call {
+	return &EvmTxStore_FindFromAddressesWithStuckTransactions_Call{Call: _e.mock.On("FindFromAddressesWithStuckTransactions", ctx, addresses, chainID)}
+}
+
+func (_c *EvmTxStore_FindFromAddressesWithStuckTransactions_Call) Run(run func(ctx context.Context, addresses []common.Address, chainID *big.Int)) *EvmTxStore_FindFromAddressesWithStuckTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]common.Address), args[2].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindFromAddressesWithStuckTransactions_Call) Return(_a0 []common.Address, _a1 error) *EvmTxStore_FindFromAddressesWithStuckTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindFromAddressesWithStuckTransactions_Call) RunAndReturn(run func(context.Context, []common.Address, *big.Int) ([]common.Address, error)) *EvmTxStore_FindFromAddressesWithStuckTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLatestSequence provides a mock function with given fields: ctx, fromAddress, chainID
 func (_m *EvmTxStore) FindLatestSequence(ctx context.Context, fromAddress common.Address, chainID *big.Int) (pkgtypes.Nonce, error) {
 	ret := _m.Called(ctx, fromAddress, chainID)
@@ -842,6 +964,67 @@ func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(
 	return _c
 }
 
+// FindNextUnstartedTransactionFromAddressExcludingJobs provides a mock function with given fields: ctx, fromAddress, chainID, jobIDs
+func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddressExcludingJobs(ctx context.Context, fromAddress common.Address, chainID *big.Int, jobIDs []int32) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, chainID, jobIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNextUnstartedTransactionFromAddressExcludingJobs")
+	}
+
+	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, []int32) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, chainID, jobIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, []int32) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID, jobIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, []int32) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, jobIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNextUnstartedTransactionFromAddressExcludingJobs'
+type EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call struct {
+	*mock.Call
+}
+
+// FindNextUnstartedTransactionFromAddressExcludingJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+//   - jobIDs []int32
+func (_e *EvmTxStore_Expecter) FindNextUnstartedTransactionFromAddressExcludingJobs(ctx interface{}, fromAddress interface{}, chainID interface{}, jobIDs interface{}) *EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call {
+	return &EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call{Call: _e.mock.On("FindNextUnstartedTransactionFromAddressExcludingJobs", ctx, fromAddress, chainID, jobIDs)}
+}
+
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, jobIDs []int32)) *EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].([]int32))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call) Return(_a0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], _a1 error) *EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, []int32) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindNextUnstartedTransactionFromAddressExcludingJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindReceiptWithIdempotencyKey provides a mock function with given fields: ctx, idempotencyKey, chainID
 func (_m *EvmTxStore) FindReceiptWithIdempotencyKey(ctx context.Context, idempotencyKey string, chainID *big.Int) (types.ChainReceipt[common.Hash, common.Hash], error) {
 	ret := _m.Called(ctx, idempotencyKey, chainID)
@@ -1997,6 +2180,66 @@ func (_c *EvmTxStore_FindTxsRequiringResubmissionDueToInsufficientFunds_Call) Ru
 	return _c
 }
 
+// GasSpend provides a mock function with given fields: ctx, filter, chainID
+func (_m *EvmTxStore) GasSpend(ctx context.Context, filter txmgr.GasSpendFilter, chainID *big.Int) (*big.Int, error) {
+	ret := _m.Called(ctx, filter, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GasSpend")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, txmgr.GasSpendFilter, *big.Int) (*big.Int, error)); ok {
+		return rf(ctx, filter, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, txmgr.GasSpendFilter, *big.Int) *big.Int); ok {
+		r0 = rf(ctx, filter, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, txmgr.GasSpendFilter, *big.Int) error); ok {
+		r1 = rf(ctx, filter, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_GasSpend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GasSpend'
+type EvmTxStore_GasSpend_Call struct {
+	*mock.Call
+}
+
+// GasSpend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter txmgr.GasSpendFilter
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) GasSpend(ctx interface{}, filter interface{}, chainID interface{}) *EvmTxStore_GasSpend_Call {
+	return &EvmTxStore_GasSpend_Call{Call: _e.mock.On("GasSpend", ctx, filter, chainID)}
+}
+
+func (_c *EvmTxStore_GasSpend_Call) Run(run func(ctx context.Context, filter txmgr.GasSpendFilter, chainID *big.Int)) *EvmTxStore_GasSpend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(txmgr.GasSpendFilter), args[2].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_GasSpend_Call) Return(_a0 *big.Int, _a1 error) *EvmTxStore_GasSpend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_GasSpend_Call) RunAndReturn(run func(context.Context, txmgr.GasSpendFilter, *big.Int) (*big.Int, error)) *EvmTxStore_GasSpend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAbandonedTransactionsByBatch provides a mock function with given fields: ctx, chainID, enabledAddrs, offset, limit
 func (_m *EvmTxStore) GetAbandonedTransactionsByBatch(ctx context.Context, chainID *big.Int, enabledAddrs []common.Address, offset uint, limit uint) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, chainID, enabledAddrs, offset, limit)
@@ -0,0 +1,317 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// ErrSpendBudgetExceeded is returned when a transaction is refused because a gas spend budget is exhausted.
+var ErrSpendBudgetExceeded = errors.New("gas spend budget exceeded")
+
+// ErrSpendBudgetQueued is returned instead of the next unstarted transaction of an address while a queue budget holds
+// its transactions.
+var ErrSpendBudgetQueued = fmt.Errorf("%w: transactions queued", ErrSpendBudgetExceeded)
+
+const (
+	// spendBudgetCacheTTL is how long the spend of a budget is reused before it is loaded again.
+	spendBudgetCacheTTL = 15 * time.Second
+	// spendBudgetRefreshPeriod is how often the spend of every budget is loaded in the background, so that the gauges
+	// and the exceeded/recovered logs stay current while no transaction is created or broadcast.
+	spendBudgetRefreshPeriod = time.Minute
+)
+
+type SpendBudgetAction string
+
+const (
+	// SpendBudgetReject refuses new transactions once the budget is spent.
+	SpendBudgetReject SpendBudgetAction = "reject"
+	// SpendBudgetQueue holds unsent transactions until the spend over the window drops back under the limit.
+	SpendBudgetQueue SpendBudgetAction = "queue"
+)
+
+// SpendBudget limits the fees paid by the transactions of a chain over a rolling window. The budget covers every
+// transaction of the chain, or only those of a job and/or sent from a key when JobID and/or Key are set.
+type SpendBudget struct {
+	ChainID *big.Int
+	JobID   *int32
+	Key     *common.Address
+	Window  time.Duration
+	// Limit is in wei.
+	Limit  *big.Int
+	Action SpendBudgetAction
+}
+
+func (b SpendBudget) String() string {
+	s := "chain " + b.ChainID.String()
+	if b.Key != nil {
+		s = "key " + b.Key.String() + " on " + s
+	}
+	if b.JobID != nil {
+		s = "job " + strconv.Itoa(int(*b.JobID)) + " on " + s
+	}
+	return fmt.Sprintf("%s (%s per %s)", s, b.Limit, b.Window)
+}
+
+// Filter returns the filter selecting the transactions counted against the budget at now.
+func (b SpendBudget) Filter(now time.Time) GasSpendFilter {
+	return GasSpendFilter{FromAddress: b.Key, JobID: b.JobID, Since: now.Add(-b.Window)}
+}
+
+func (b SpendBudget) matches(fromAddress common.Address, jobID *int32) bool {
+	if b.Key != nil && *b.Key != fromAddress {
+		return false
+	}
+	if b.JobID != nil && (jobID == nil || *jobID != *b.JobID) {
+		return false
+	}
+	return true
+}
+
+func (b SpendBudget) promLabels() []string {
+	var jobID, key string
+	if b.JobID != nil {
+		jobID = strconv.Itoa(int(*b.JobID))
+	}
+	if b.Key != nil {
+		key = b.Key.String()
+	}
+	return []string{b.ChainID.String(), jobID, key, string(b.Action)}
+}
+
+type budgetSpend struct {
+	spent    *big.Int
+	loadedAt time.Time
+}
+
+// spendBudgetTxStore enforces gas spend budgets in front of the tx store: reject budgets fail CreateTransaction, and
+// queue budgets hide the unstarted transactions they cover from the broadcaster.
+type spendBudgetTxStore struct {
+	EvmTxStore
+	chainID *big.Int
+	budgets []SpendBudget
+	lggr    logger.SugaredLogger
+
+	mu     sync.Mutex
+	spends map[int]budgetSpend
+}
+
+// NewSpendBudgetTxStore returns txStore wrapped to enforce the budgets of chainID, or txStore if there are none.
+func NewSpendBudgetTxStore(txStore EvmTxStore, chainID *big.Int, budgets []SpendBudget, lggr logger.Logger) EvmTxStore {
+	var chainBudgets []SpendBudget
+	for _, b := range budgets {
+		if b.ChainID != nil && b.ChainID.Cmp(chainID) == 0 {
+			chainBudgets = append(chainBudgets, b)
+		}
+	}
+
+	if len(chainBudgets) == 0 {
+		return txStore
+	}
+
+	for _, b := range chainBudgets {
+		promGasSpendLimit.WithLabelValues(b.promLabels()...).Set(weiToFloat(b.Limit))
+	}
+
+	return &spendBudgetTxStore{
+		EvmTxStore: txStore,
+		chainID:    chainID,
+		budgets:    chainBudgets,
+		lggr:       logger.Sugared(logger.Named(lggr, "SpendBudget")),
+		spends:     make(map[int]budgetSpend),
+	}
+}
+
+func (s *spendBudgetTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest, chainID *big.Int) (Tx, error) {
+	var jobID *int32
+	if txRequest.Meta != nil {
+		jobID = txRequest.Meta.JobID
+	}
+
+	for i, b := range s.budgets {
+		if b.Action != SpendBudgetReject || !b.matches(txRequest.FromAddress, jobID) {
+			continue
+		}
+
+		spent, err := s.spent(ctx, i)
+		if err != nil {
+			return Tx{}, err
+		}
+
+		if spent.Cmp(b.Limit) >= 0 {
+			promGasSpendRejectedTxs.WithLabelValues(b.promLabels()...).Inc()
+			return Tx{}, fmt.Errorf("%w: %s wei spent by %s", ErrSpendBudgetExceeded, spent, b)
+		}
+	}
+
+	return s.EvmTxStore.CreateTransaction(ctx, txRequest, chainID)
+}
+
+func (s *spendBudgetTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
+	var excludedJobIDs []int32
+	for i, b := range s.budgets {
+		if b.Action != SpendBudgetQueue || (b.Key != nil && *b.Key != fromAddress) {
+			continue
+		}
+
+		spent, err := s.spent(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+
+		if spent.Cmp(b.Limit) < 0 {
+			continue
+		}
+
+		if b.JobID == nil {
+			return nil, fmt.Errorf("%w: holding transactions from %s", ErrSpendBudgetQueued, fromAddress)
+		}
+		excludedJobIDs = append(excludedJobIDs, *b.JobID)
+	}
+
+	if len(excludedJobIDs) == 0 {
+		return s.EvmTxStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID)
+	}
+
+	return s.EvmTxStore.FindNextUnstartedTransactionFromAddressExcludingJobs(ctx, fromAddress, chainID, excludedJobIDs)
+}
+
+// broadcasterTxStore is the tx store of the broadcaster, which sees the transactions held by a queue budget as an empty
+// queue, so that they stay unstarted until the budget frees up.
+type broadcasterTxStore struct {
+	TransactionStore
+}
+
+func (s broadcasterTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
+	etx, err := s.TransactionStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, chainID)
+	if errors.Is(err, ErrSpendBudgetQueued) {
+		return nil, sql.ErrNoRows
+	}
+	return etx, err
+}
+
+// spent returns the spend of budget i over its window, loading it again once the cached value expires.
+func (s *spendBudgetTxStore) spent(ctx context.Context, i int) (*big.Int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if cached, ok := s.spends[i]; ok && now.Sub(cached.loadedAt) < spendBudgetCacheTTL {
+		return cached.spent, nil
+	}
+	return s.loadLocked(ctx, i, now)
+}
+
+// refresh loads the spend of every budget, regardless of the cache.
+func (s *spendBudgetTxStore) refresh(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var errs error
+	now := time.Now()
+	for i := range s.budgets {
+		if _, err := s.loadLocked(ctx, i, now); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+// loadLocked loads the spend of budget i at now, updates its gauges and logs when it exceeds or recovers.
+func (s *spendBudgetTxStore) loadLocked(ctx context.Context, i int, now time.Time) (*big.Int, error) {
+	cached, ok := s.spends[i]
+	b := s.budgets[i]
+	spent, err := s.EvmTxStore.GasSpend(ctx, b.Filter(now), s.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gas spend of %s: %w", b, err)
+	}
+	s.spends[i] = budgetSpend{spent: spent, loadedAt: now}
+
+	exceeded := spent.Cmp(b.Limit) >= 0
+	labels := b.promLabels()
+	promGasSpend.WithLabelValues(labels...).Set(weiToFloat(spent))
+	if exceeded {
+		promGasSpendExceeded.WithLabelValues(labels...).Set(1)
+	} else {
+		promGasSpendExceeded.WithLabelValues(labels...).Set(0)
+	}
+
+	wasExceeded := ok && cached.spent.Cmp(b.Limit) >= 0
+	switch {
+	case exceeded && !wasExceeded:
+		s.lggr.Criticalw("Gas spend budget exceeded", "budget", b.String(), "spentWei", spent.String(), "action", b.Action)
+	case !exceeded && wasExceeded:
+		s.lggr.Infow("Gas spend back under budget", "budget", b.String(), "spentWei", spent.String())
+	}
+
+	return spent, nil
+}
+
+// spendBudgetTxm is a TxManager whose spend budgets are refreshed in the background while it runs.
+type spendBudgetTxm struct {
+	TxManager
+	store *spendBudgetTxStore
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewSpendBudgetTxm returns txm refreshing the spend budgets of txStore every spendBudgetRefreshPeriod while it runs,
+// or txm if txStore enforces no budgets.
+func NewSpendBudgetTxm(txm TxManager, txStore EvmTxStore) TxManager {
+	store, ok := txStore.(*spendBudgetTxStore)
+	if !ok {
+		return txm
+	}
+	return &spendBudgetTxm{TxManager: txm, store: store, stopCh: make(services.StopChan)}
+}
+
+func (t *spendBudgetTxm) Start(ctx context.Context) error {
+	if err := t.TxManager.Start(ctx); err != nil {
+		return err
+	}
+	t.wg.Add(1)
+	go t.runRefreshLoop()
+	return nil
+}
+
+func (t *spendBudgetTxm) Close() error {
+	close(t.stopCh)
+	t.wg.Wait()
+	return t.TxManager.Close()
+}
+
+func (t *spendBudgetTxm) runRefreshLoop() {
+	defer t.wg.Done()
+
+	ctx, cancel := t.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(spendBudgetRefreshPeriod)
+	defer ticker.Stop()
+	for {
+		if err := t.store.refresh(ctx); err != nil && ctx.Err() == nil {
+			t.store.lggr.Warnw("Failed to refresh gas spend budgets", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func weiToFloat(wei *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(wei).Float64()
+	return f
+}
@@ -0,0 +1,134 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestSpendBudgetTxStore(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1)
+	fromAddress := testutils.NewAddress()
+	jobID := int32(7)
+	otherJobID := int32(8)
+
+	newStore := func(t *testing.T, spent int64, budgets ...txmgr.SpendBudget) (txmgr.EvmTxStore, *mocks.EvmTxStore) {
+		inner := mocks.NewEvmTxStore(t)
+		inner.On("GasSpend", mock.Anything, mock.Anything, chainID).Return(big.NewInt(spent), nil).Maybe()
+		return txmgr.NewSpendBudgetTxStore(inner, chainID, budgets, logger.Test(t)), inner
+	}
+
+	newRequest := func(jobID *int32) txmgr.TxRequest {
+		return txmgr.TxRequest{FromAddress: fromAddress, Meta: &txmgr.TxMeta{JobID: jobID}}
+	}
+
+	t.Run("without budgets for the chain returns the tx store", func(t *testing.T) {
+		inner := mocks.NewEvmTxStore(t)
+		store := txmgr.NewSpendBudgetTxStore(inner, chainID, []txmgr.SpendBudget{{ChainID: big.NewInt(2), Window: time.Hour, Limit: big.NewInt(1)}}, logger.Test(t))
+		assert.Equal(t, txmgr.EvmTxStore(inner), store)
+	})
+
+	t.Run("reject budget refuses transactions once spent", func(t *testing.T) {
+		store, inner := newStore(t, 100, txmgr.SpendBudget{ChainID: chainID, JobID: &jobID, Window: time.Hour, Limit: big.NewInt(100), Action: txmgr.SpendBudgetReject})
+
+		_, err := store.CreateTransaction(tests.Context(t), newRequest(&jobID), chainID)
+		require.ErrorIs(t, err, txmgr.ErrSpendBudgetExceeded)
+		assert.Contains(t, err.Error(), "job 7 on chain 1")
+
+		// other jobs are not covered by the budget
+		inner.On("CreateTransaction", mock.Anything, mock.Anything, chainID).Return(txmgr.Tx{ID: 1}, nil).Once()
+		etx, err := store.CreateTransaction(tests.Context(t), newRequest(&otherJobID), chainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), etx.ID)
+	})
+
+	t.Run("reject budget accepts transactions under the limit", func(t *testing.T) {
+		store, inner := newStore(t, 99, txmgr.SpendBudget{ChainID: chainID, Key: &fromAddress, Window: time.Hour, Limit: big.NewInt(100), Action: txmgr.SpendBudgetReject})
+
+		inner.On("CreateTransaction", mock.Anything, mock.Anything, chainID).Return(txmgr.Tx{ID: 1}, nil).Once()
+		_, err := store.CreateTransaction(tests.Context(t), newRequest(nil), chainID)
+		require.NoError(t, err)
+	})
+
+	t.Run("queue budget of a job skips its transactions", func(t *testing.T) {
+		store, inner := newStore(t, 100, txmgr.SpendBudget{ChainID: chainID, JobID: &jobID, Window: time.Hour, Limit: big.NewInt(100), Action: txmgr.SpendBudgetQueue})
+
+		inner.On("FindNextUnstartedTransactionFromAddressExcludingJobs", mock.Anything, fromAddress, chainID, []int32{jobID}).Return(&txmgr.Tx{ID: 2}, nil).Once()
+		etx, err := store.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), etx.ID)
+	})
+
+	t.Run("queue budget of a key holds all its transactions", func(t *testing.T) {
+		store, _ := newStore(t, 100, txmgr.SpendBudget{ChainID: chainID, Key: &fromAddress, Window: time.Hour, Limit: big.NewInt(100), Action: txmgr.SpendBudgetQueue})
+
+		_, err := store.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, chainID)
+		require.ErrorIs(t, err, txmgr.ErrSpendBudgetQueued)
+		require.ErrorIs(t, err, txmgr.ErrSpendBudgetExceeded)
+	})
+
+	t.Run("caches the spend", func(t *testing.T) {
+		inner := mocks.NewEvmTxStore(t)
+		inner.On("GasSpend", mock.Anything, mock.Anything, chainID).Return(big.NewInt(0), nil).Once()
+		inner.On("FindNextUnstartedTransactionFromAddress", mock.Anything, fromAddress, chainID).Return(&txmgr.Tx{ID: 3}, nil).Twice()
+		store := txmgr.NewSpendBudgetTxStore(inner, chainID, []txmgr.SpendBudget{{ChainID: chainID, Window: time.Hour, Limit: big.NewInt(100), Action: txmgr.SpendBudgetQueue}}, logger.Test(t))
+
+		for range 2 {
+			etx, err := store.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, chainID)
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), etx.ID)
+		}
+	})
+}
+
+func TestSpendBudgetTxm(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1)
+
+	t.Run("without budgets returns the txm", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		inner := mocks.NewEvmTxStore(t)
+		assert.Equal(t, txmgr.TxManager(txm), txmgr.NewSpendBudgetTxm(txm, inner))
+	})
+
+	t.Run("refreshes the budgets while running", func(t *testing.T) {
+		txm := mocks.NewMockEvmTxManager(t)
+		txm.On("Start", mock.Anything).Return(nil).Once()
+		txm.On("Close").Return(nil).Once()
+		inner := mocks.NewEvmTxStore(t)
+		refreshed := make(chan struct{}, 2)
+		inner.On("GasSpend", mock.Anything, mock.Anything, chainID).Return(big.NewInt(0), nil).Run(func(mock.Arguments) {
+			refreshed <- struct{}{}
+		}).Twice()
+		store := txmgr.NewSpendBudgetTxStore(inner, chainID, []txmgr.SpendBudget{
+			{ChainID: chainID, Window: time.Hour, Limit: big.NewInt(100)},
+			{ChainID: chainID, Window: time.Hour, Limit: big.NewInt(100), Action: txmgr.SpendBudgetQueue},
+		}, logger.Test(t))
+
+		budgetTxm := txmgr.NewSpendBudgetTxm(txm, store)
+		require.NoError(t, budgetTxm.Start(tests.Context(t)))
+		// every budget is loaded on start, without waiting for a transaction
+		for range 2 {
+			select {
+			case <-refreshed:
+			case <-time.After(tests.WaitTimeout(t)):
+				t.Fatal("budgets were not refreshed")
+			}
+		}
+		require.NoError(t, budgetTxm.Close())
+	})
+}
@@ -86,6 +86,7 @@ func makeTestEvmTxm(
 		keyStore,
 		estimator,
 		ht,
+		nil,
 		nil)
 }
 
@@ -110,6 +111,26 @@ func TestTxm_SendNativeToken_DoesNotSendToZero(t *testing.T) {
 	require.EqualError(t, err, "cannot send native token to zero address")
 }
 
+func TestTxStoreOf(t *testing.T) {
+	t.Parallel()
+	db := testutils.NewSqlxDB(t)
+
+	config, dbConfig, evmConfig := txmgr.MakeTestConfigs(t)
+
+	ethClient := clienttest.NewClientWithDefaultChainID(t)
+	estimator, err := gas.NewEstimator(logger.Test(t), ethClient, config.ChainType(), ethClient.ConfiguredChainID(), evmConfig.GasEstimator(), nil)
+	require.NoError(t, err)
+	txm, err := makeTestEvmTxm(t, db, ethClient, estimator, evmConfig, evmConfig.GasEstimator(), evmConfig.Transactions(), dbConfig, dbConfig.Listener(), &keystest.FakeChainStore{})
+	require.NoError(t, err)
+
+	txStore, ok := txmgr.TxStoreOf(txm)
+	require.True(t, ok)
+	assert.NotNil(t, txStore)
+
+	_, ok = txmgr.TxStoreOf(&txmgr.NullTxManager{ErrMsg: "disabled"})
+	assert.False(t, ok)
+}
+
 func TestTxm_CreateTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -152,6 +152,10 @@ type ChainOpts struct {
 
 	MailMon      *mailbox.Monitor
 	GasEstimator gas.EvmFeeEstimator
+	// SpendBudgets are the gas spend budgets enforced by the transaction managers of the chains.
+	SpendBudgets []txmgr.SpendBudget
+	// OCRKeySelections are the default sending key selections of the OCR jobs of the chains.
+	OCRKeySelections []txmgr.KeySelection
 
 	DS sqlutil.DataSource
 
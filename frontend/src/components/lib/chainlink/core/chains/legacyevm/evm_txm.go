@@ -74,7 +74,8 @@ func newEvmTxm(
 			opts.KeyStore,
 			estimator,
 			headTracker,
-			txmv2)
+			txmv2,
+			opts.SpendBudgets)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
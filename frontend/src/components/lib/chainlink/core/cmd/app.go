@@ -268,6 +268,7 @@ func NewApp(s *Shell) *cli.App {
 					FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
 					FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
 					SentryEnabled:  s.Config.Sentry().DSN() != "",
+					LevelOverrides: s.Config.LogLevelOverrides(),
 				}
 				l, closeFn := lggrCfg.New()
 
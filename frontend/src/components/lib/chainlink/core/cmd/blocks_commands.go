@@ -2,15 +2,19 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initBlocksSubCmds(s *Shell) []cli.Command {
@@ -53,6 +57,50 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "backfill-bhs",
+			Usage:  "Store the missing blockhashes of a block range in a blockhash store, resuming the backfill of the same range if there is one",
+			Action: s.BackfillBlockhashStore,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "batch-bhs-address",
+					Usage:    "Address of the batch blockhash store contract",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:     "from-block",
+					Usage:    "First block of the range",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:     "to-block",
+					Usage:    "Last block of the range",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "from-address",
+					Usage: "Sending key used for all the transactions of the backfill, if left empty one is picked round robin",
+				},
+				cli.UintFlag{
+					Name:  "batch-size",
+					Usage: "Number of blockhashes stored per transaction",
+				},
+				cli.Uint64Flag{
+					Name:  "gas-limit",
+					Usage: "Gas limit of the transactions, if left empty the chain's default gas limit is used",
+				},
+			},
+		},
+		{
+			Name:   "bhs-backfills",
+			Usage:  "List blockhash store backfills",
+			Action: s.ListBlockhashStoreBackfills,
+		},
 	}
 }
 
@@ -140,3 +188,105 @@ func (s *Shell) FindLCA(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &LCAPresenter{}, "Last Common Ancestor")
 }
+
+// BHSBackfillPresenter implements TableRenderer for a BHSBackfillResource.
+type BHSBackfillPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.BHSBackfillResource
+}
+
+var bhsBackfillHeaders = []string{"ID", "Chain ID", "Batch BHS", "From Block", "To Block", "Next Block", "Stored", "Skipped", "State", "Error"}
+
+// ToRow presents the BHSBackfillResource as a slice of strings.
+func (p *BHSBackfillPresenter) ToRow() []string {
+	errStr := ""
+	if p.Error != nil {
+		errStr = *p.Error
+	}
+	return []string{
+		p.GetID(),
+		p.EVMChainID.String(),
+		p.BatchBHSAddress.String(),
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		strconv.FormatInt(p.NextBlock, 10),
+		strconv.FormatInt(p.StoredBlocks, 10),
+		strconv.FormatInt(p.SkippedBlocks, 10),
+		p.State,
+		errStr,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *BHSBackfillPresenter) RenderTable(rt RendererTable) error {
+	renderList(bhsBackfillHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	return nil
+}
+
+// BHSBackfillPresenters implements TableRenderer for a slice of BHSBackfillPresenter.
+type BHSBackfillPresenters []BHSBackfillPresenter
+
+// RenderTable implements TableRenderer
+func (ps BHSBackfillPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(bhsBackfillHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// BackfillBlockhashStore starts backfilling the blockhashes of a block range.
+func (s *Shell) BackfillBlockhashStore(c *cli.Context) (err error) {
+	if !common.IsHexAddress(c.String("batch-bhs-address")) {
+		return s.errorOut(errors.New("Must pass a hex address in '--batch-bhs-address' parameter"))
+	}
+	request := web.CreateBHSBackfillRequest{
+		EVMChainID:                 ubig.NewI(c.Int64("evm-chain-id")),
+		BatchBlockhashStoreAddress: common.HexToAddress(c.String("batch-bhs-address")),
+		FromBlock:                  c.Int64("from-block"),
+		ToBlock:                    c.Int64("to-block"),
+		BatchSize:                  uint32(c.Uint("batch-size")),
+		GasLimit:                   c.Uint64("gas-limit"),
+	}
+	if c.IsSet("from-address") {
+		if !common.IsHexAddress(c.String("from-address")) {
+			return s.errorOut(errors.New("Must pass a hex address in '--from-address' parameter"))
+		}
+		from := common.HexToAddress(c.String("from-address"))
+		request.FromAddress = &from
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/bhs_backfills", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BHSBackfillPresenter{}, "Blockhash store backfill")
+}
+
+// ListBlockhashStoreBackfills lists blockhash store backfills.
+func (s *Shell) ListBlockhashStoreBackfills(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/bhs_backfills")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BHSBackfillPresenters{}, "Blockhash store backfills")
+}
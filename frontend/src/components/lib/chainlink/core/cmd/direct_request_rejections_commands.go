@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initDirectRequestRejectionsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:      "dr-rejections",
+			Usage:     "List the oracle requests a Direct Request job did not run, newest first",
+			ArgsUsage: "<job ID>",
+			Action:    s.ListDirectRequestRejections,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "limit",
+					Usage: "maximum number of rejections to list",
+					Value: 100,
+				},
+			},
+		},
+	}
+}
+
+// DirectRequestRejectionPresenter wraps the JSONAPI Direct Request rejection
+// resource and adds rendering functionality
+type DirectRequestRejectionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.DirectRequestRejectionResource
+}
+
+var directRequestRejectionHeaders = []string{"ID", "Request ID", "Requester", "Payment", "Reason", "Detail", "Block", "Created At"}
+
+// ToRow presents the DirectRequestRejectionResource as a slice of strings.
+func (p *DirectRequestRejectionPresenter) ToRow() []string {
+	payment := ""
+	if p.Payment != nil {
+		payment = p.Payment.String()
+	}
+	return []string{
+		p.GetID(),
+		p.RequestID,
+		p.Requester,
+		payment,
+		p.Reason,
+		p.Detail,
+		strconv.FormatInt(p.BlockNumber, 10),
+		p.CreatedAt.String(),
+	}
+}
+
+// DirectRequestRejectionPresenters implements TableRenderer for a slice of DirectRequestRejectionPresenter.
+type DirectRequestRejectionPresenters []DirectRequestRejectionPresenter
+
+// RenderTable implements TableRenderer
+func (ps DirectRequestRejectionPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(directRequestRejectionHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ListDirectRequestRejections lists the rejected requests of a Direct
+// Request job
+func (s *Shell) ListDirectRequestRejections(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	query := url.Values{"limit": {strconv.Itoa(c.Int("limit"))}}
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/jobs/%s/direct_request/rejections?%s", c.Args().First(), query.Encode()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &DirectRequestRejectionPresenters{}, "Direct Request rejections")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,7 +19,7 @@ import (
 )
 
 func initJobsSubCmds(s *Shell) []cli.Command {
-	return []cli.Command{
+	return append([]cli.Command{
 		{
 			Name:   "list",
 			Usage:  "List all jobs",
@@ -50,7 +51,7 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
-	}
+	}, slices.Concat(initDirectRequestRejectionsSubCmds(s), initKeeperDryRunSubCmds(s), initVRFBacklogSubCmds(s))...)
 }
 
 // JobPresenter wraps the JSONAPI Job Resource and adds rendering functionality
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeeperDryRunSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:      "keeper-dry-runs",
+			Usage:     "List the perform transactions a keeper job in dry-run mode would have broadcast, newest first",
+			ArgsUsage: "<job ID>",
+			Action:    s.ListKeeperDryRunTransactions,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "limit",
+					Usage: "maximum number of transactions to list",
+					Value: 100,
+				},
+			},
+		},
+	}
+}
+
+// KeeperDryRunTransactionPresenter wraps the JSONAPI keeper dry run
+// transaction resource and adds rendering functionality
+type KeeperDryRunTransactionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.KeeperDryRunTransactionResource
+}
+
+var keeperDryRunTransactionHeaders = []string{"ID", "Upkeep ID", "Block", "Gas Limit", "Gas Estimate", "Simulation Success", "Simulation Error", "Created At"}
+
+// ToRow presents the KeeperDryRunTransactionResource as a slice of strings.
+func (p *KeeperDryRunTransactionPresenter) ToRow() []string {
+	gasEstimate := ""
+	if p.GasEstimate != nil {
+		gasEstimate = strconv.FormatInt(*p.GasEstimate, 10)
+	}
+	simulationError := ""
+	if p.SimulationError != nil {
+		simulationError = *p.SimulationError
+	}
+	return []string{
+		p.GetID(),
+		p.UpkeepID,
+		strconv.FormatInt(p.BlockNumber, 10),
+		strconv.FormatUint(p.GasLimit, 10),
+		gasEstimate,
+		strconv.FormatBool(p.SimulationSuccess),
+		simulationError,
+		p.CreatedAt.String(),
+	}
+}
+
+// KeeperDryRunTransactionPresenters implements TableRenderer for a slice of KeeperDryRunTransactionPresenter.
+type KeeperDryRunTransactionPresenters []KeeperDryRunTransactionPresenter
+
+// RenderTable implements TableRenderer
+func (ps KeeperDryRunTransactionPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(keeperDryRunTransactionHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ListKeeperDryRunTransactions lists the dry run transactions of a keeper job
+func (s *Shell) ListKeeperDryRunTransactions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	query := url.Values{"limit": {strconv.Itoa(c.Int("limit"))}}
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/jobs/%s/keeper/dry_run_transactions?%s", c.Args().First(), query.Encode()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &KeeperDryRunTransactionPresenters{}, "Keeper dry run transactions")
+}
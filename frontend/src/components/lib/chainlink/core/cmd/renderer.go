@@ -79,6 +79,18 @@ func (rt RendererTable) renderLogPkgConfig(serviceLevelLog webpresenters.Service
 	}
 
 	render("ServiceLogConfig", table)
+
+	if len(serviceLevelLog.Overrides) > 0 {
+		table = rt.newTable([]string{"Logger", "LogLevel", "Expires At"})
+		for _, o := range serviceLevelLog.Overrides {
+			var expiresAt string
+			if o.ExpiresAt != nil {
+				expiresAt = o.ExpiresAt.String()
+			}
+			table.Append([]string{o.Name, o.Level, expiresAt})
+		}
+		render("LogLevelOverrides", table)
+	}
 	return nil
 }
 
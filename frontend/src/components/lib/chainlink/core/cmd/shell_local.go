@@ -8,9 +8,11 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -31,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -548,6 +551,11 @@ func (s *Shell) runNode(c *cli.Context) error {
 
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
 
+	grp.Go(func() error {
+		reloadConfigOnSIGHUP(grpCtx, app)
+		return nil
+	})
+
 	grp.Go(func() error {
 		errInternal := s.Runner.Run(grpCtx, app)
 		if errors.Is(errInternal, http.ErrServerClosed) {
@@ -562,6 +570,25 @@ func (s *Shell) runNode(c *cli.Context) error {
 	return grp.Wait()
 }
 
+// reloadConfigOnSIGHUP reloads the config of app whenever SIGHUP is received,
+// until ctx is done
+func reloadConfigOnSIGHUP(ctx context.Context, app chainlink.Application) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			app.GetLogger().Info("Reloading config due to SIGHUP signal received...")
+			// ReloadConfig logs the outcome
+			_, _ = app.ReloadConfig(ctx)
+		}
+	}
+}
+
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
 	// Ensure tls sub directory (and children) permissions are <= `ownerPermsMask``
 	tlsDir := filepath.Join(rootDir, "tls")
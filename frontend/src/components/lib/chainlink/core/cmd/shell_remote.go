@@ -42,15 +42,32 @@ func initRemoteConfigSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "reload",
+			Usage:  "Reload the config and secrets files, applying the changes that do not require a restart",
+			Action: s.ReloadConfig,
+		},
 		{
 			Name:   "loglevel",
-			Usage:  "Set log level",
+			Usage:  "Set log level, globally or for named loggers",
 			Action: s.SetLogLevel,
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  "level",
 					Usage: "set log level for node (debug||info||warn||error)",
 				},
+				cli.StringFlag{
+					Name:  "logger",
+					Usage: "only set the log level of the named logger, e.g. EVM.1.Txm, or of all loggers below a name, e.g. OCR2.*",
+				},
+				cli.DurationFlag{
+					Name:  "expires-in",
+					Usage: "revert the log level of the named logger after this duration, e.g. 30m",
+				},
+				cli.BoolFlag{
+					Name:  "remove",
+					Usage: "remove the log level override of the named logger",
+				},
 			},
 		},
 		{
@@ -337,14 +354,68 @@ func (s *Shell) configV2Str(userOnly bool) (string, error) {
 	return configV2Resource.Config, nil
 }
 
+// ReloadConfig reloads the config and secrets files of the node
+func (s *Shell) ReloadConfig(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/config/reload", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	respPayload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if resp.StatusCode != 200 {
+		return s.errorOut(errors.Errorf("got HTTP status %d: %s", resp.StatusCode, respPayload))
+	}
+	var reload web.ConfigReloadResource
+	if err = web.ParseJSONAPIResponse(respPayload, &reload); err != nil {
+		return s.errorOut(err)
+	}
+
+	if len(reload.Applied) == 0 && len(reload.RequiresRestart) == 0 {
+		fmt.Println("No config changes found")
+		return nil
+	}
+	if len(reload.Applied) > 0 {
+		fmt.Printf("Applied:\n  %s\n", strings.Join(reload.Applied, "\n  "))
+	}
+	if len(reload.RequiresRestart) > 0 {
+		fmt.Printf("Requires restart:\n  %s\n", strings.Join(reload.RequiresRestart, "\n  "))
+	}
+	if len(reload.Notes) > 0 {
+		fmt.Printf("Notes:\n  %s\n", strings.Join(reload.Notes, "\n  "))
+	}
+	return nil
+}
+
 func normalizePassword(password string) string {
 	return url.QueryEscape(strings.TrimSpace(password))
 }
 
-// SetLogLevel sets the log level on the node
+// SetLogLevel sets the log level on the node, or overrides the log level of
+// named loggers
 func (s *Shell) SetLogLevel(c *cli.Context) (err error) {
 	logLevel := c.String("level")
 	request := web.LogPatchRequest{Level: logLevel}
+	if name := c.String("logger"); name != "" {
+		request.Level = ""
+		if c.Bool("remove") {
+			request.RemoveOverrides = []string{name}
+		} else {
+			override := web.LogLevelOverrideRequest{Name: name, Level: logLevel}
+			if expiresIn := c.Duration("expires-in"); expiresIn > 0 {
+				override.ExpiresIn = expiresIn.String()
+			}
+			request.Overrides = []web.LogLevelOverrideRequest{override}
+		}
+	} else if c.Bool("remove") || c.IsSet("expires-in") {
+		return s.errorOut(errors.New("--remove and --expires-in require --logger"))
+	}
 	requestData, err := json.Marshal(request)
 	if err != nil {
 		return s.errorOut(err)
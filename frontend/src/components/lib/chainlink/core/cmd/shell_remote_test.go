@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/freeport"
 
@@ -736,3 +737,33 @@ func TestShell_SetLogConfig(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, sqlEnabled, app.Config.Database().LogSQL())
 }
+
+func TestShell_SetLogLevelOverride(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	globalLevel := app.Config.Log().Level()
+	set := flag.NewFlagSet("loglevel", 0)
+	flagSetApplyFromAction(client.SetLogLevel, set, "")
+	require.NoError(t, set.Set("level", "error"))
+	require.NoError(t, set.Set("logger", "EVM.1.Txm"))
+	require.NoError(t, set.Set("expires-in", "30m"))
+
+	require.NoError(t, client.SetLogLevel(cli.NewContext(nil, set, nil)))
+	overrides := app.Config.LogLevelOverrides().List()
+	require.Len(t, overrides, 1)
+	assert.Equal(t, "EVM.1.Txm", overrides[0].Name)
+	assert.Equal(t, zapcore.ErrorLevel, overrides[0].Level)
+	assert.NotNil(t, overrides[0].ExpiresAt)
+	assert.Equal(t, globalLevel, app.Config.Log().Level(), "global level is unchanged")
+
+	set = flag.NewFlagSet("loglevel", 0)
+	flagSetApplyFromAction(client.SetLogLevel, set, "")
+	require.NoError(t, set.Set("logger", "EVM.1.Txm"))
+	require.NoError(t, set.Set("remove", "true"))
+
+	require.NoError(t, client.SetLogLevel(cli.NewContext(nil, set, nil)))
+	assert.Empty(t, app.Config.LogLevelOverrides().List())
+}
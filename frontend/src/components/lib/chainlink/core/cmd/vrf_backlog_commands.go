@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initVRFBacklogSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:      "vrf-backlog",
+			Usage:     "List the pending requests of a running VRF job and why they have not been fulfilled",
+			ArgsUsage: "<job ID>",
+			Action:    s.ListVRFPendingRequests,
+		},
+		{
+			Name:      "vrf-fulfill",
+			Usage:     "Simulate and enqueue a fulfillment of a pending VRF request, skipping the node's subscription balance check (the coordinator still charges the subscription)",
+			ArgsUsage: "<job ID> <request ID>",
+			Action:    s.FulfillVRFRequest,
+			Flags: []cli.Flag{
+				cli.Uint64Flag{
+					Name:  "gas-limit",
+					Usage: "gas limit of the fulfillment transaction, if left empty it is computed from the request's callback gas limit",
+				},
+				cli.StringFlag{
+					Name:  "max-gas-price",
+					Usage: "maximum gas price used to simulate the fulfillment and estimate its cost (e.g. '50 gwei'), if left empty the key's maximum is used",
+				},
+				cli.StringFlag{
+					Name:  "from",
+					Usage: "sending key of the job to use (in hex format), if left empty one is picked round robin",
+				},
+			},
+		},
+	}
+}
+
+// VRFPendingRequestPresenter wraps the JSONAPI VRF pending request resource
+// and adds rendering functionality
+type VRFPendingRequestPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFPendingRequestResource
+}
+
+var vrfPendingRequestHeaders = []string{"Request ID", "Sub ID", "Block", "Confirmed At", "Attempts", "Last Try", "Reason", "Detail", "Max Fee", "Tx ID"}
+
+// ToRow presents the VRFPendingRequestResource as a slice of strings.
+func (p *VRFPendingRequestPresenter) ToRow() []string {
+	lastTry := ""
+	if p.LastTry != nil {
+		lastTry = p.LastTry.Format(time.RFC3339)
+	}
+	txID := ""
+	if p.TxID != nil {
+		txID = strconv.FormatInt(*p.TxID, 10)
+	}
+	return []string{
+		p.GetID(),
+		p.SubID,
+		strconv.FormatUint(p.RequestBlockNumber, 10),
+		strconv.FormatUint(p.ConfirmedAtBlock, 10),
+		strconv.Itoa(p.Attempts),
+		lastTry,
+		p.SkipReason,
+		p.SkipDetail,
+		p.MaxFee,
+		txID,
+	}
+}
+
+// VRFPendingRequestPresenters implements TableRenderer for a slice of VRFPendingRequestPresenter.
+type VRFPendingRequestPresenters []VRFPendingRequestPresenter
+
+// RenderTable implements TableRenderer
+func (ps VRFPendingRequestPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(vrfPendingRequestHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// VRFFulfillmentPresenter wraps the JSONAPI VRF fulfillment resource and adds
+// rendering functionality
+type VRFFulfillmentPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFFulfillmentResource
+}
+
+// RenderTable implements TableRenderer
+func (p *VRFFulfillmentPresenter) RenderTable(rt RendererTable) error {
+	row := []string{p.GetID(), strconv.FormatInt(p.TxID, 10), p.FromAddress.String(), strconv.FormatUint(p.GasLimit, 10), p.MaxFee}
+	renderList([]string{"Request ID", "Tx ID", "From", "Gas Limit", "Max Fee"}, [][]string{row}, rt.Writer)
+
+	return nil
+}
+
+// ListVRFPendingRequests lists the pending requests of a running VRF job
+func (s *Shell) ListVRFPendingRequests(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/jobs/%s/vrf/pending_requests", c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &VRFPendingRequestPresenters{}, "Pending VRF requests")
+}
+
+// FulfillVRFRequest enqueues a fulfillment of a pending VRF request
+func (s *Shell) FulfillVRFRequest(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must provide the id of the job and the id of the request"))
+	}
+
+	request := web.VRFFulfillRequest{GasLimit: c.Uint64("gas-limit")}
+	if c.IsSet("max-gas-price") {
+		request.MaxGasPrice = new(assets.Wei)
+		if err = request.MaxGasPrice.UnmarshalText([]byte(c.String("max-gas-price"))); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid max-gas-price"))
+		}
+	}
+	if c.IsSet("from") {
+		if !gethCommon.IsHexAddress(c.String("from")) {
+			return s.errorOut(errors.Errorf("invalid from address %q", c.String("from")))
+		}
+		from := gethCommon.HexToAddress(c.String("from"))
+		request.FromAddress = &from
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/jobs/%s/vrf/pending_requests/%s/fulfill", c.Args().Get(0), c.Args().Get(1)), bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &VRFFulfillmentPresenter{}, "VRF fulfillment enqueued")
+}
@@ -41,9 +41,12 @@ type AppConfig interface {
 	Database() Database
 	Feature() Feature
 	FluxMonitor() FluxMonitor
+	GasSpend() GasSpend
 	Insecure() Insecure
 	JobPipeline() JobPipeline
 	Keeper() Keeper
+	OCRKeySelection() OCRKeySelection
+	KeyTopUp() KeyTopUp
 	Log() Log
 	Mercury() Mercury
 	OCR() OCR
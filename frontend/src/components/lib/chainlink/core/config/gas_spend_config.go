@@ -0,0 +1,25 @@
+package config
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+)
+
+type GasSpend interface {
+	Budgets() []GasSpendBudget
+}
+
+type GasSpendBudget interface {
+	ChainID() *big.Int
+	// JobID and Key are nil for budgets that are not limited to a job or a key.
+	JobID() *int32
+	Key() *common.Address
+	Window() time.Duration
+	Limit() *assets.Wei
+	// Action is either reject or queue.
+	Action() string
+}
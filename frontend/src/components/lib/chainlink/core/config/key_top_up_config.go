@@ -0,0 +1,27 @@
+package config
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+)
+
+type KeyTopUp interface {
+	Chains() []KeyTopUpChain
+}
+
+type KeyTopUpChain interface {
+	ChainID() *big.Int
+	TreasuryKey() common.Address
+	// Threshold is the balance under which a sending key is topped up to Target.
+	Threshold() *assets.Wei
+	Target() *assets.Wei
+	// MaxSpend is the most sent from the treasury key over SpendWindow.
+	MaxSpend() *assets.Wei
+	SpendWindow() time.Duration
+	Cooldown() time.Duration
+	PollInterval() time.Duration
+}
@@ -17,6 +17,8 @@ type Log interface {
 	DefaultLevel() zapcore.Level
 	JSONConsole() bool
 	Level() zapcore.Level
+	// Overrides returns the configured levels of named loggers.
+	Overrides() map[string]zapcore.Level
 	UnixTimestamps() bool
 
 	File() File
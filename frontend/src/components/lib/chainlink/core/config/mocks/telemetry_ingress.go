@@ -114,6 +114,53 @@ func (_c *TelemetryIngress_Endpoints_Call) RunAndReturn(run func() []config.Tele
 	return _c
 }
 
+// FileSinks provides a mock function with no fields
+func (_m *TelemetryIngress) FileSinks() []config.TelemetryIngressFileSink {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FileSinks")
+	}
+
+	var r0 []config.TelemetryIngressFileSink
+	if rf, ok := ret.Get(0).(func() []config.TelemetryIngressFileSink); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.TelemetryIngressFileSink)
+		}
+	}
+
+	return r0
+}
+
+// TelemetryIngress_FileSinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FileSinks'
+type TelemetryIngress_FileSinks_Call struct {
+	*mock.Call
+}
+
+// FileSinks is a helper method to define mock.On call
+func (_e *TelemetryIngress_Expecter) FileSinks() *TelemetryIngress_FileSinks_Call {
+	return &TelemetryIngress_FileSinks_Call{Call: _e.mock.On("FileSinks")}
+}
+
+func (_c *TelemetryIngress_FileSinks_Call) Run(run func()) *TelemetryIngress_FileSinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelemetryIngress_FileSinks_Call) Return(_a0 []config.TelemetryIngressFileSink) *TelemetryIngress_FileSinks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelemetryIngress_FileSinks_Call) RunAndReturn(run func() []config.TelemetryIngressFileSink) *TelemetryIngress_FileSinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Logging provides a mock function with no fields
 func (_m *TelemetryIngress) Logging() bool {
 	ret := _m.Called()
@@ -204,6 +251,53 @@ func (_c *TelemetryIngress_MaxBatchSize_Call) RunAndReturn(run func() uint) *Tel
 	return _c
 }
 
+// OTLPSinks provides a mock function with no fields
+func (_m *TelemetryIngress) OTLPSinks() []config.TelemetryIngressOTLPSink {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OTLPSinks")
+	}
+
+	var r0 []config.TelemetryIngressOTLPSink
+	if rf, ok := ret.Get(0).(func() []config.TelemetryIngressOTLPSink); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.TelemetryIngressOTLPSink)
+		}
+	}
+
+	return r0
+}
+
+// TelemetryIngress_OTLPSinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OTLPSinks'
+type TelemetryIngress_OTLPSinks_Call struct {
+	*mock.Call
+}
+
+// OTLPSinks is a helper method to define mock.On call
+func (_e *TelemetryIngress_Expecter) OTLPSinks() *TelemetryIngress_OTLPSinks_Call {
+	return &TelemetryIngress_OTLPSinks_Call{Call: _e.mock.On("OTLPSinks")}
+}
+
+func (_c *TelemetryIngress_OTLPSinks_Call) Run(run func()) *TelemetryIngress_OTLPSinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelemetryIngress_OTLPSinks_Call) Return(_a0 []config.TelemetryIngressOTLPSink) *TelemetryIngress_OTLPSinks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelemetryIngress_OTLPSinks_Call) RunAndReturn(run func() []config.TelemetryIngressOTLPSink) *TelemetryIngress_OTLPSinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendInterval provides a mock function with no fields
 func (_m *TelemetryIngress) SendInterval() time.Duration {
 	ret := _m.Called()
@@ -0,0 +1,18 @@
+package config
+
+import (
+	"math/big"
+)
+
+// OCRKeySelection is the selection of the sending keys of the OCR2, OCR3 and CCIP transmissions. OCR (v1) jobs
+// transmit from a single key and are not covered.
+type OCRKeySelection interface {
+	Chains() []OCRKeySelectionChain
+}
+
+type OCRKeySelectionChain interface {
+	ChainID() *big.Int
+	// Strategy is one of round-robin, least-pending or highest-balance.
+	Strategy() string
+	ExcludeStuck() bool
+}
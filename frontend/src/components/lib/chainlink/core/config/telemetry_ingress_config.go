@@ -3,6 +3,8 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type TelemetryIngress interface {
@@ -14,6 +16,8 @@ type TelemetryIngress interface {
 	SendTimeout() time.Duration
 	UseBatchSend() bool
 	Endpoints() []TelemetryIngressEndpoint
+	FileSinks() []TelemetryIngressFileSink
+	OTLPSinks() []TelemetryIngressOTLPSink
 }
 
 type TelemetryIngressEndpoint interface {
@@ -22,3 +26,24 @@ type TelemetryIngressEndpoint interface {
 	ServerPubKey() string
 	URL() *url.URL
 }
+
+// TelemetryIngressSinkFilter limits the telemetry sent to a sink. Empty lists match everything.
+type TelemetryIngressSinkFilter interface {
+	Networks() []string
+	TelemetryTypes() []string
+}
+
+type TelemetryIngressFileSink interface {
+	TelemetryIngressSinkFilter
+	Path() string
+	// Format is either jsonl or protobuf.
+	Format() string
+	MaxSize() utils.FileSize
+	MaxBackups() int64
+}
+
+type TelemetryIngressOTLPSink interface {
+	TelemetryIngressSinkFilter
+	Endpoint() string
+	InsecureConnection() bool
+}
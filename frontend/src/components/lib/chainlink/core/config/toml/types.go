@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -18,10 +19,14 @@ import (
 	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -61,6 +66,9 @@ type Core struct {
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
 	Workflows        Workflows        `toml:",omitempty"`
+	GasSpend         GasSpend         `toml:",omitempty"`
+	KeyTopUp         KeyTopUp         `toml:",omitempty"`
+	OCRKeySelection  OCRKeySelection  `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -95,6 +103,9 @@ func (c *Core) SetFrom(f *Core) {
 	c.Mercury.setFrom(&f.Mercury)
 	c.Capabilities.setFrom(&f.Capabilities)
 	c.Workflows.setFrom(&f.Workflows)
+	c.GasSpend.setFrom(&f.GasSpend)
+	c.KeyTopUp.setFrom(&f.KeyTopUp)
+	c.OCRKeySelection.setFrom(&f.OCRKeySelection)
 
 	c.AutoPprof.setFrom(&f.AutoPprof)
 	c.Pyroscope.setFrom(&f.Pyroscope)
@@ -596,6 +607,9 @@ type TelemetryIngress struct {
 	SendTimeout  *commonconfig.Duration
 	UseBatchSend *bool
 	Endpoints    []TelemetryIngressEndpoint `toml:",omitempty"`
+	// FileSinks and OTLPSinks receive telemetry in addition to, or instead of, the Endpoints.
+	FileSinks []TelemetryIngressFileSink `toml:",omitempty"`
+	OTLPSinks []TelemetryIngressOTLPSink `toml:",omitempty"`
 }
 
 type TelemetryIngressEndpoint struct {
@@ -630,6 +644,66 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.Endpoints; v != nil {
 		t.Endpoints = v
 	}
+	if v := f.FileSinks; v != nil {
+		t.FileSinks = v
+	}
+	if v := f.OTLPSinks; v != nil {
+		t.OTLPSinks = v
+	}
+}
+
+const (
+	TelemetrySinkFormatJSONL    = "jsonl"
+	TelemetrySinkFormatProtobuf = "protobuf"
+)
+
+// TelemetryIngressFileSink writes telemetry to local files, rotated by size.
+type TelemetryIngressFileSink struct {
+	Path       *string
+	Format     *string
+	MaxSize    *utils.FileSize
+	MaxBackups *int64
+	// Networks and TelemetryTypes filter the telemetry written, if set.
+	Networks       []string `toml:",omitempty"`
+	TelemetryTypes []string `toml:",omitempty"`
+}
+
+func (s *TelemetryIngressFileSink) ValidateConfig() (err error) {
+	if s.Path == nil || *s.Path == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Path", Msg: "must be set"})
+	}
+	if s.Format != nil {
+		switch *s.Format {
+		case TelemetrySinkFormatJSONL, TelemetrySinkFormatProtobuf:
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Format", Value: *s.Format, Msg: "must be either 'jsonl' or 'protobuf'"})
+		}
+	}
+	if s.MaxSize != nil && *s.MaxSize > 0 && *s.MaxSize < utils.MB {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxSize", Value: *s.MaxSize, Msg: "must be at least 1mb"})
+	}
+	if s.MaxBackups != nil && *s.MaxBackups < 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxBackups", Value: *s.MaxBackups, Msg: "must not be negative"})
+	}
+	return err
+}
+
+// TelemetryIngressOTLPSink exports telemetry as OTLP log records over gRPC.
+type TelemetryIngressOTLPSink struct {
+	Endpoint           *string
+	InsecureConnection *bool
+	// Networks and TelemetryTypes filter the telemetry exported, if set.
+	Networks       []string `toml:",omitempty"`
+	TelemetryTypes []string `toml:",omitempty"`
+}
+
+func (s *TelemetryIngressOTLPSink) ValidateConfig() (err error) {
+	if s.Endpoint == nil || *s.Endpoint == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Endpoint", Msg: "must be set"})
+	} else if !isValidURI(*s.Endpoint) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Endpoint", Value: *s.Endpoint, Msg: "must be a valid URI"})
+	}
+	return err
 }
 
 type AuditLogger struct {
@@ -690,6 +764,8 @@ type Log struct {
 	Level       *LogLevel
 	JSONConsole *bool
 	UnixTS      *bool
+	// Overrides are the levels of named loggers, e.g. EVM.1.Txm or OCR2.*
+	Overrides map[string]LogLevel `toml:",omitempty"`
 
 	File LogFile `toml:",omitempty"`
 }
@@ -704,9 +780,24 @@ func (l *Log) setFrom(f *Log) {
 	if v := f.UnixTS; v != nil {
 		l.UnixTS = v
 	}
+	if f.Overrides != nil {
+		if l.Overrides == nil {
+			l.Overrides = make(map[string]LogLevel, len(f.Overrides))
+		}
+		maps.Copy(l.Overrides, f.Overrides)
+	}
 	l.File.setFrom(&f.File)
 }
 
+func (l *Log) ValidateConfig() (err error) {
+	for _, name := range slices.Sorted(maps.Keys(l.Overrides)) {
+		if verr := logger.ValidateLevelOverrideName(name); verr != nil {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Overrides", Value: name, Msg: verr.Error()})
+		}
+	}
+	return
+}
+
 type LogFile struct {
 	Dir        *string
 	MaxSize    *utils.FileSize
@@ -1652,6 +1743,186 @@ func (r *Limits) setFrom(f *Limits) {
 	}
 }
 
+const (
+	GasSpendActionReject = "reject"
+	GasSpendActionQueue  = "queue"
+)
+
+// GasSpend configures budgets for the gas spent by the transactions of the node.
+type GasSpend struct {
+	Budgets []GasSpendBudget `toml:",omitempty"`
+}
+
+func (g *GasSpend) setFrom(f *GasSpend) {
+	if v := f.Budgets; v != nil {
+		g.Budgets = v
+	}
+}
+
+// GasSpendBudget limits the gas spent on a chain over a rolling window. The budget applies to the whole chain, or
+// only to the transactions of a job and/or sent from a key when JobID and/or Key are set.
+type GasSpendBudget struct {
+	ChainID *ubig.Big
+	JobID   *int32
+	Key     *types.EIP55Address
+	Window  *commonconfig.Duration
+	Limit   *assets.Wei
+	// Action is either reject, to refuse new transactions once the budget is spent, or queue, to hold unsent
+	// transactions until spend drops back under the limit.
+	Action *string
+}
+
+func (b *GasSpendBudget) ValidateConfig() (err error) {
+	if b.ChainID == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "ChainID", Msg: "must be set"})
+	}
+	if b.JobID != nil && *b.JobID <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "JobID", Value: *b.JobID, Msg: "must be positive"})
+	}
+	if b.Window == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Window", Msg: "must be set"})
+	} else if b.Window.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Window", Value: b.Window.String(), Msg: "must be positive"})
+	}
+	if b.Limit == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Limit", Msg: "must be set"})
+	} else if b.Limit.ToInt().Sign() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Limit", Value: b.Limit.String(), Msg: "must be positive"})
+	}
+	if b.Action != nil {
+		switch *b.Action {
+		case GasSpendActionReject, GasSpendActionQueue:
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Action", Value: *b.Action, Msg: "must be either 'reject' or 'queue'"})
+		}
+	}
+	return err
+}
+
+// KeyTopUp configures the automatic funding of sending keys from a treasury key.
+type KeyTopUp struct {
+	Chains []KeyTopUpChain `toml:",omitempty"`
+}
+
+func (k *KeyTopUp) setFrom(f *KeyTopUp) {
+	if v := f.Chains; v != nil {
+		k.Chains = v
+	}
+}
+
+func (k *KeyTopUp) ValidateConfig() (err error) {
+	seen := make(map[string]struct{})
+	for _, c := range k.Chains {
+		if c.ChainID == nil {
+			continue
+		}
+		id := c.ChainID.String()
+		if _, ok := seen[id]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Chains.ChainID", Value: id, Msg: "duplicate chain"})
+		}
+		seen[id] = struct{}{}
+	}
+	return err
+}
+
+// KeyTopUpChain tops up the enabled sending keys of a chain whose balance is below Threshold back to Target, from
+// TreasuryKey. At most MaxSpend is sent per SpendWindow, and a key is not topped up again before Cooldown.
+type KeyTopUpChain struct {
+	ChainID      *ubig.Big
+	TreasuryKey  *types.EIP55Address
+	Threshold    *assets.Wei
+	Target       *assets.Wei
+	MaxSpend     *assets.Wei
+	SpendWindow  *commonconfig.Duration
+	Cooldown     *commonconfig.Duration
+	PollInterval *commonconfig.Duration
+}
+
+func (c *KeyTopUpChain) ValidateConfig() (err error) {
+	if c.ChainID == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "ChainID", Msg: "must be set"})
+	}
+	if c.TreasuryKey == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "TreasuryKey", Msg: "must be set"})
+	}
+	if c.Threshold == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Threshold", Msg: "must be set"})
+	}
+	if c.Target == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Target", Msg: "must be set"})
+	} else if c.Threshold != nil && c.Target.Cmp(c.Threshold) <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Target", Value: c.Target.String(), Msg: "must be greater than Threshold"})
+	}
+	if c.MaxSpend == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "MaxSpend", Msg: "must be set"})
+	} else if c.MaxSpend.ToInt().Sign() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxSpend", Value: c.MaxSpend.String(), Msg: "must be positive"})
+	}
+	for _, d := range []struct {
+		name string
+		v    *commonconfig.Duration
+	}{{"SpendWindow", c.SpendWindow}, {"Cooldown", c.Cooldown}, {"PollInterval", c.PollInterval}} {
+		if d.v != nil && d.v.Duration() <= 0 {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: d.name, Value: d.v.String(), Msg: "must be positive"})
+		}
+	}
+	return err
+}
+
+// OCRKeySelection configures how the sending key of an OCR2, OCR3 or CCIP transmission is picked among the sending
+// keys of its job. It does not apply to OCR (v1) and keeper jobs, which transmit from a single key, nor to VRF jobs
+// and ethtx tasks, e.g. of Direct Request jobs, which always pick their sending keys round-robin.
+type OCRKeySelection struct {
+	Chains []OCRKeySelectionChain `toml:",omitempty"`
+}
+
+func (k *OCRKeySelection) setFrom(f *OCRKeySelection) {
+	if v := f.Chains; v != nil {
+		k.Chains = v
+	}
+}
+
+func (k *OCRKeySelection) ValidateConfig() (err error) {
+	seen := make(map[string]struct{})
+	for _, c := range k.Chains {
+		if c.ChainID == nil {
+			continue
+		}
+		id := c.ChainID.String()
+		if _, ok := seen[id]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Chains.ChainID", Value: id, Msg: "duplicate chain"})
+		}
+		seen[id] = struct{}{}
+	}
+	return err
+}
+
+// OCRKeySelectionChain is the default key selection of the OCR jobs of a chain, which jobs can override.
+type OCRKeySelectionChain struct {
+	ChainID *ubig.Big
+	// Strategy is either round-robin, least-pending, to send from the key with the fewest transactions not yet
+	// confirmed, or highest-balance.
+	Strategy *string
+	// ExcludeStuck skips the keys with a transaction being purged by the stuck transaction detector, which requires
+	// Transactions.AutoPurge to be enabled on the chain.
+	ExcludeStuck *bool
+}
+
+func (c *OCRKeySelectionChain) ValidateConfig() (err error) {
+	if c.ChainID == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "ChainID", Msg: "must be set"})
+	}
+	if c.Strategy != nil {
+		switch txmgr.KeySelectionStrategy(*c.Strategy) {
+		case txmgr.KeySelectionRoundRobin, txmgr.KeySelectionLeastPending, txmgr.KeySelectionHighestBalance:
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Strategy", Value: *c.Strategy, Msg: fmt.Sprintf("must be one of '%s', '%s' or '%s'",
+				txmgr.KeySelectionRoundRobin, txmgr.KeySelectionLeastPending, txmgr.KeySelectionHighestBalance)})
+		}
+	}
+	return err
+}
+
 type WorkflowRegistry struct {
 	Address                 *string
 	NetworkID               *string
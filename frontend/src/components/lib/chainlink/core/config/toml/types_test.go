@@ -6,12 +6,16 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -634,5 +638,177 @@ func TestEthKeys_SetFrom(t *testing.T) {
 	assert.Equal(t, ethKeysWrapper2, *ethKeysWrapper1)
 }
 
+func TestTelemetryIngressFileSink_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		sink   TelemetryIngressFileSink
+		errMsg string
+	}{
+		{
+			name: "valid",
+			sink: TelemetryIngressFileSink{Path: ptr("telemetry.jsonl")},
+		},
+		{
+			name: "valid protobuf",
+			sink: TelemetryIngressFileSink{Path: ptr("telemetry.pb"), Format: ptr("protobuf"), MaxSize: ptr[utils.FileSize](10 * utils.MB), MaxBackups: ptr[int64](2)},
+		},
+		{
+			name:   "missing path",
+			sink:   TelemetryIngressFileSink{},
+			errMsg: configutils.ErrMissing{Name: "Path", Msg: "must be set"}.Error(),
+		},
+		{
+			name:   "invalid format",
+			sink:   TelemetryIngressFileSink{Path: ptr("telemetry.csv"), Format: ptr("csv")},
+			errMsg: configutils.ErrInvalid{Name: "Format", Value: "csv", Msg: "must be either 'jsonl' or 'protobuf'"}.Error(),
+		},
+		{
+			name:   "max size too small",
+			sink:   TelemetryIngressFileSink{Path: ptr("telemetry.jsonl"), MaxSize: ptr[utils.FileSize](10 * utils.KB)},
+			errMsg: configutils.ErrInvalid{Name: "MaxSize", Value: utils.FileSize(10 * utils.KB), Msg: "must be at least 1mb"}.Error(),
+		},
+		{
+			name:   "negative max backups",
+			sink:   TelemetryIngressFileSink{Path: ptr("telemetry.jsonl"), MaxBackups: ptr[int64](-1)},
+			errMsg: configutils.ErrInvalid{Name: "MaxBackups", Value: int64(-1), Msg: "must not be negative"}.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sink.ValidateConfig()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTelemetryIngressOTLPSink_ValidateConfig(t *testing.T) {
+	assert.NoError(t, (&TelemetryIngressOTLPSink{Endpoint: ptr("localhost:4317")}).ValidateConfig())
+	assert.NoError(t, (&TelemetryIngressOTLPSink{Endpoint: ptr("https://otel.test:4317")}).ValidateConfig())
+	assert.EqualError(t, (&TelemetryIngressOTLPSink{}).ValidateConfig(), configutils.ErrMissing{Name: "Endpoint", Msg: "must be set"}.Error())
+	assert.EqualError(t, (&TelemetryIngressOTLPSink{Endpoint: ptr("not a uri")}).ValidateConfig(),
+		configutils.ErrInvalid{Name: "Endpoint", Value: "not a uri", Msg: "must be a valid URI"}.Error())
+}
+
+func TestGasSpendBudget_ValidateConfig(t *testing.T) {
+	valid := func() GasSpendBudget {
+		return GasSpendBudget{
+			ChainID: ubig.NewI(1),
+			Window:  commonconfig.MustNewDuration(24 * time.Hour),
+			Limit:   assets.GWei(1),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*GasSpendBudget)
+		errMsg string
+	}{
+		{name: "valid", modify: func(*GasSpendBudget) {}},
+		{name: "valid queue for job", modify: func(b *GasSpendBudget) { b.JobID = ptr[int32](42); b.Action = ptr("queue") }},
+		{
+			name:   "missing chain ID",
+			modify: func(b *GasSpendBudget) { b.ChainID = nil },
+			errMsg: configutils.ErrMissing{Name: "ChainID", Msg: "must be set"}.Error(),
+		},
+		{
+			name:   "invalid job ID",
+			modify: func(b *GasSpendBudget) { b.JobID = ptr[int32](0) },
+			errMsg: configutils.ErrInvalid{Name: "JobID", Value: int32(0), Msg: "must be positive"}.Error(),
+		},
+		{
+			name:   "missing window",
+			modify: func(b *GasSpendBudget) { b.Window = nil },
+			errMsg: configutils.ErrMissing{Name: "Window", Msg: "must be set"}.Error(),
+		},
+		{
+			name:   "zero limit",
+			modify: func(b *GasSpendBudget) { b.Limit = assets.NewWeiI(0) },
+			errMsg: configutils.ErrInvalid{Name: "Limit", Value: assets.NewWeiI(0).String(), Msg: "must be positive"}.Error(),
+		},
+		{
+			name:   "invalid action",
+			modify: func(b *GasSpendBudget) { b.Action = ptr("drop") },
+			errMsg: configutils.ErrInvalid{Name: "Action", Value: "drop", Msg: "must be either 'reject' or 'queue'"}.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := valid()
+			tt.modify(&b)
+			err := b.ValidateConfig()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKeyTopUpChain_ValidateConfig(t *testing.T) {
+	valid := func() KeyTopUpChain {
+		return KeyTopUpChain{
+			ChainID:     ubig.NewI(1),
+			TreasuryKey: ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+			Threshold:   assets.GWei(1),
+			Target:      assets.GWei(2),
+			MaxSpend:    assets.GWei(10),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*KeyTopUpChain)
+		errMsg string
+	}{
+		{name: "valid", modify: func(*KeyTopUpChain) {}},
+		{
+			name:   "missing treasury key",
+			modify: func(c *KeyTopUpChain) { c.TreasuryKey = nil },
+			errMsg: configutils.ErrMissing{Name: "TreasuryKey", Msg: "must be set"}.Error(),
+		},
+		{
+			name:   "target not above threshold",
+			modify: func(c *KeyTopUpChain) { c.Target = assets.GWei(1) },
+			errMsg: configutils.ErrInvalid{Name: "Target", Value: assets.GWei(1).String(), Msg: "must be greater than Threshold"}.Error(),
+		},
+		{
+			name:   "zero max spend",
+			modify: func(c *KeyTopUpChain) { c.MaxSpend = assets.NewWeiI(0) },
+			errMsg: configutils.ErrInvalid{Name: "MaxSpend", Value: assets.NewWeiI(0).String(), Msg: "must be positive"}.Error(),
+		},
+		{
+			name:   "zero cooldown",
+			modify: func(c *KeyTopUpChain) { c.Cooldown = commonconfig.MustNewDuration(0) },
+			errMsg: configutils.ErrInvalid{Name: "Cooldown", Value: "0s", Msg: "must be positive"}.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid()
+			tt.modify(&c)
+			err := c.ValidateConfig()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOCRKeySelectionChain_ValidateConfig(t *testing.T) {
+	assert.NoError(t, (&OCRKeySelectionChain{ChainID: ubig.NewI(1), Strategy: ptr("least-pending"), ExcludeStuck: ptr(true)}).ValidateConfig())
+	assert.EqualError(t, (&OCRKeySelectionChain{Strategy: ptr("random")}).ValidateConfig(), configutils.ErrMissing{Name: "ChainID", Msg: "must be set"}.Error()+"; "+
+		configutils.ErrInvalid{Name: "Strategy", Value: "random", Msg: "must be one of 'round-robin', 'least-pending' or 'highest-balance'"}.Error())
+}
+
 // ptr is a utility function for converting a value to a pointer to the value.
 func ptr[T any](t T) *T { return &t }
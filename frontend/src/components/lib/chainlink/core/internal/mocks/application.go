@@ -5,8 +5,12 @@ package mocks
 import (
 	big "math/big"
 
+	archive "github.com/smartcontractkit/chainlink/v2/core/services/llo/archive"
+
 	audit "github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 
+	blockheaderfeeder "github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
+
 	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
 
 	chainlink "github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
@@ -21,6 +25,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 
+	keytopup "github.com/smartcontractkit/chainlink/v2/core/services/keytopup"
+
 	logger "github.com/smartcontractkit/chainlink/v2/core/logger"
 
 	logpoller "github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
@@ -43,6 +49,8 @@ import (
 
 	uuid "github.com/google/uuid"
 
+	vrfcommon "github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
 	zapcore "go.uber.org/zap/zapcore"
@@ -202,6 +210,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BlockhashStoreBackfills provides a mock function with no fields
+func (_m *Application) BlockhashStoreBackfills() *blockheaderfeeder.BackfillRunner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockhashStoreBackfills")
+	}
+
+	var r0 *blockheaderfeeder.BackfillRunner
+	if rf, ok := ret.Get(0).(func() *blockheaderfeeder.BackfillRunner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*blockheaderfeeder.BackfillRunner)
+		}
+	}
+
+	return r0
+}
+
+// Application_BlockhashStoreBackfills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockhashStoreBackfills'
+type Application_BlockhashStoreBackfills_Call struct {
+	*mock.Call
+}
+
+// BlockhashStoreBackfills is a helper method to define mock.On call
+func (_e *Application_Expecter) BlockhashStoreBackfills() *Application_BlockhashStoreBackfills_Call {
+	return &Application_BlockhashStoreBackfills_Call{Call: _e.mock.On("BlockhashStoreBackfills")}
+}
+
+func (_c *Application_BlockhashStoreBackfills_Call) Run(run func()) *Application_BlockhashStoreBackfills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BlockhashStoreBackfills_Call) Return(_a0 *blockheaderfeeder.BackfillRunner) *Application_BlockhashStoreBackfills_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BlockhashStoreBackfills_Call) RunAndReturn(run func() *blockheaderfeeder.BackfillRunner) *Application_BlockhashStoreBackfills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with no fields
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -1153,6 +1208,53 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// KeyTopUps provides a mock function with no fields
+func (_m *Application) KeyTopUps() *keytopup.Manager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyTopUps")
+	}
+
+	var r0 *keytopup.Manager
+	if rf, ok := ret.Get(0).(func() *keytopup.Manager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keytopup.Manager)
+		}
+	}
+
+	return r0
+}
+
+// Application_KeyTopUps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyTopUps'
+type Application_KeyTopUps_Call struct {
+	*mock.Call
+}
+
+// KeyTopUps is a helper method to define mock.On call
+func (_e *Application_Expecter) KeyTopUps() *Application_KeyTopUps_Call {
+	return &Application_KeyTopUps_Call{Call: _e.mock.On("KeyTopUps")}
+}
+
+func (_c *Application_KeyTopUps_Call) Run(run func()) *Application_KeyTopUps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_KeyTopUps_Call) Return(_a0 *keytopup.Manager) *Application_KeyTopUps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_KeyTopUps_Call) RunAndReturn(run func() *keytopup.Manager) *Application_KeyTopUps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with no fields
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1200,6 +1302,62 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// ReloadConfig provides a mock function with given fields: ctx
+func (_m *Application) ReloadConfig(ctx context.Context) (chainlink.ConfigReload, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 chainlink.ConfigReload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (chainlink.ConfigReload, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) chainlink.ConfigReload); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(chainlink.ConfigReload)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReloadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadConfig'
+type Application_ReloadConfig_Call struct {
+	*mock.Call
+}
+
+// ReloadConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Application_Expecter) ReloadConfig(ctx interface{}) *Application_ReloadConfig_Call {
+	return &Application_ReloadConfig_Call{Call: _e.mock.On("ReloadConfig", ctx)}
+}
+
+func (_c *Application_ReloadConfig_Call) Run(run func(ctx context.Context)) *Application_ReloadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) Return(_a0 chainlink.ConfigReload, _a1 error) *Application_ReloadConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) RunAndReturn(run func(context.Context) (chainlink.ConfigReload, error)) *Application_ReloadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: ctx, chainFamily, chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(ctx, chainFamily, chainID, number, forceBroadcast)
@@ -1250,6 +1408,53 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ReportArchiveReplays provides a mock function with no fields
+func (_m *Application) ReportArchiveReplays() *archive.ReplayRunner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportArchiveReplays")
+	}
+
+	var r0 *archive.ReplayRunner
+	if rf, ok := ret.Get(0).(func() *archive.ReplayRunner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*archive.ReplayRunner)
+		}
+	}
+
+	return r0
+}
+
+// Application_ReportArchiveReplays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportArchiveReplays'
+type Application_ReportArchiveReplays_Call struct {
+	*mock.Call
+}
+
+// ReportArchiveReplays is a helper method to define mock.On call
+func (_e *Application_Expecter) ReportArchiveReplays() *Application_ReportArchiveReplays_Call {
+	return &Application_ReportArchiveReplays_Call{Call: _e.mock.On("ReportArchiveReplays")}
+}
+
+func (_c *Application_ReportArchiveReplays_Call) Run(run func()) *Application_ReportArchiveReplays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_ReportArchiveReplays_Call) Return(_a0 *archive.ReplayRunner) *Application_ReportArchiveReplays_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ReportArchiveReplays_Call) RunAndReturn(run func() *archive.ReplayRunner) *Application_ReportArchiveReplays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -1646,6 +1851,53 @@ func (_c *Application_TxmStorageService_Call) RunAndReturn(run func() txmgr.EvmT
 	return _c
 }
 
+// VRFBacklog provides a mock function with no fields
+func (_m *Application) VRFBacklog() *vrfcommon.BacklogRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VRFBacklog")
+	}
+
+	var r0 *vrfcommon.BacklogRegistry
+	if rf, ok := ret.Get(0).(func() *vrfcommon.BacklogRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vrfcommon.BacklogRegistry)
+		}
+	}
+
+	return r0
+}
+
+// Application_VRFBacklog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VRFBacklog'
+type Application_VRFBacklog_Call struct {
+	*mock.Call
+}
+
+// VRFBacklog is a helper method to define mock.On call
+func (_e *Application_Expecter) VRFBacklog() *Application_VRFBacklog_Call {
+	return &Application_VRFBacklog_Call{Call: _e.mock.On("VRFBacklog")}
+}
+
+func (_c *Application_VRFBacklog_Call) Run(run func()) *Application_VRFBacklog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_VRFBacklog_Call) Return(_a0 *vrfcommon.BacklogRegistry) *Application_VRFBacklog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_VRFBacklog_Call) RunAndReturn(run func() *vrfcommon.BacklogRegistry) *Application_VRFBacklog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WakeSessionReaper provides a mock function with no fields
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
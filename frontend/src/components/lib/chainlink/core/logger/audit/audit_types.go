@@ -23,6 +23,8 @@ const (
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
+	FeedsManApprovalPoliciesUpdated EventID = "FEEDS_MAN_APPROVAL_POLICIES_UPDATED"
+
 	FeedsManEnabled  EventID = "FEEDS_MAN_ENABLED"
 	FeedsManDisabled EventID = "FEEDS_MAN_DISABLED"
 
@@ -81,13 +83,18 @@ const (
 	JobProposalSpecRejected EventID = "JOB_PROPOSAL_SPEC_REJECTED"
 
 	ConfigUpdated            EventID = "CONFIG_UPDATED"
+	ConfigReloaded           EventID = "CONFIG_RELOADED"
 	ConfigSqlLoggingEnabled  EventID = "CONFIG_SQL_LOGGING_ENABLED"
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
 	GlobalLogLevelSet        EventID = "GLOBAL_LOG_LEVEL_SET"
+	LogLevelOverrideSet      EventID = "LOG_LEVEL_OVERRIDE_SET"
+	LogLevelOverrideRemoved  EventID = "LOG_LEVEL_OVERRIDE_REMOVED"
 
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
 
+	VRFRequestForceFulfilled EventID = "VRF_REQUEST_FORCE_FULFILLED"
+
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
@@ -0,0 +1,209 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// LevelOverride sets the level of the logger with Name, ignoring the global
+// level. A Name ending in ".*" matches all the loggers named below it, e.g.
+// OCR2.* matches OCR2.Mercury and OCR2.Mercury.Transmitter.
+type LevelOverride struct {
+	Name  string
+	Level zapcore.Level
+	// ExpiresAt is when the override is removed, if set.
+	ExpiresAt *time.Time
+}
+
+func (o LevelOverride) expired(now time.Time) bool {
+	return o.ExpiresAt != nil && !now.Before(*o.ExpiresAt)
+}
+
+// matches returns the length of the matched name, or -1 if the override does
+// not match the logger name. Longer matches are more specific.
+func (o LevelOverride) matches(loggerName string) int {
+	if prefix, ok := strings.CutSuffix(o.Name, ".*"); ok {
+		if strings.HasPrefix(loggerName, prefix+".") {
+			return len(prefix)
+		}
+		return -1
+	}
+	if loggerName == o.Name {
+		// an exact name beats any wildcard
+		return len(o.Name) + 1
+	}
+	return -1
+}
+
+// ValidateLevelOverrideName returns an error if name is not a logger name,
+// optionally ending in ".*".
+func ValidateLevelOverrideName(name string) error {
+	if name == "" {
+		return errors.New("logger name cannot be empty")
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if part == "" {
+			return fmt.Errorf("invalid logger name %q: empty name segment", name)
+		}
+		if strings.Contains(part, "*") && (part != "*" || i == 0 || i != len(parts)-1) {
+			return fmt.Errorf("invalid logger name %q: wildcard must be the last name segment, e.g. OCR2.*", name)
+		}
+	}
+	return nil
+}
+
+// LevelOverrides holds the log level overrides of named loggers. The most
+// specific override of a logger name applies, and loggers without an override
+// use the global level.
+type LevelOverrides struct {
+	count atomic.Int32 // len(overrides), to skip locking when there are none
+
+	mu        sync.RWMutex
+	overrides map[string]LevelOverride
+
+	now func() time.Time
+}
+
+// NewLevelOverrides returns LevelOverrides without any override.
+func NewLevelOverrides() *LevelOverrides {
+	return &LevelOverrides{
+		overrides: make(map[string]LevelOverride),
+		now:       time.Now,
+	}
+}
+
+// Set overrides the level of the loggers matching name, replacing any
+// override of the same name. A positive ttl removes the override once it
+// elapses.
+func (o *LevelOverrides) Set(name string, lvl zapcore.Level, ttl time.Duration) (LevelOverride, error) {
+	if err := ValidateLevelOverrideName(name); err != nil {
+		return LevelOverride{}, err
+	}
+	if ttl < 0 {
+		return LevelOverride{}, fmt.Errorf("invalid expiry %s: must not be negative", ttl)
+	}
+	override := LevelOverride{Name: name, Level: lvl}
+	if ttl > 0 {
+		expiresAt := o.now().Add(ttl)
+		override.ExpiresAt = &expiresAt
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.overrides[name] = override
+	o.count.Store(int32(len(o.overrides)))
+	return override, nil
+}
+
+// Remove removes the override of name, returning false if there was none.
+func (o *LevelOverrides) Remove(name string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, ok := o.overrides[name]
+	delete(o.overrides, name)
+	o.count.Store(int32(len(o.overrides)))
+	return ok
+}
+
+// List returns the overrides that have not expired, sorted by name.
+func (o *LevelOverrides) List() []LevelOverride {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := o.now()
+	list := make([]LevelOverride, 0, len(o.overrides))
+	for name, override := range o.overrides {
+		if override.expired(now) {
+			delete(o.overrides, name)
+			continue
+		}
+		list = append(list, override)
+	}
+	o.count.Store(int32(len(o.overrides)))
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// level returns the level of the most specific override matching loggerName.
+func (o *LevelOverrides) level(loggerName string) (lvl zapcore.Level, ok bool) {
+	if o.count.Load() == 0 {
+		return
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	now := o.now()
+	best := -1
+	for _, override := range o.overrides {
+		if override.expired(now) {
+			continue
+		}
+		if n := override.matches(loggerName); n > best {
+			best, lvl, ok = n, override.Level, true
+		}
+	}
+	return
+}
+
+// minLevel returns the lowest level of the overrides.
+func (o *LevelOverrides) minLevel() (lvl zapcore.Level, ok bool) {
+	if o.count.Load() == 0 {
+		return
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	now := o.now()
+	for _, override := range o.overrides {
+		if override.expired(now) {
+			continue
+		}
+		if !ok || override.Level < lvl {
+			lvl, ok = override.Level, true
+		}
+	}
+	return
+}
+
+var _ zapcore.Core = &levelOverrideCore{}
+
+// levelOverrideCore filters the entries of its core by the level override of
+// their logger name, or by the global level if there is none.
+type levelOverrideCore struct {
+	zapcore.Core
+	level     zap.AtomicLevel
+	overrides *LevelOverrides
+}
+
+func newLevelOverrideCore(core zapcore.Core, level zap.AtomicLevel, overrides *LevelOverrides) zapcore.Core {
+	return &levelOverrideCore{Core: core, level: level, overrides: overrides}
+}
+
+func (c *levelOverrideCore) Enabled(lvl zapcore.Level) bool {
+	if minLvl, ok := c.overrides.minLevel(); ok && lvl >= minLvl {
+		return true
+	}
+	return c.level.Enabled(lvl)
+}
+
+func (c *levelOverrideCore) With(fields []zapcore.Field) zapcore.Core {
+	return newLevelOverrideCore(c.Core.With(fields), c.level, c.overrides)
+}
+
+func (c *levelOverrideCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	lvl, ok := c.overrides.level(ent.LoggerName)
+	if !ok {
+		return c.Core.Check(ent, ce)
+	}
+	if ent.Level >= lvl {
+		// the wrapped core would filter the entry by the global level
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
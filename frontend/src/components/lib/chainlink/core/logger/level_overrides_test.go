@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestValidateLevelOverrideName(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"EVM", "EVM.1.Txm", "OCR2.*"} {
+		assert.NoError(t, ValidateLevelOverrideName(name), name)
+	}
+	for _, name := range []string{"", "*", "EVM..Txm", "EVM.", "OCR2.*.Mercury", "OCR2.Merc*", "OCR2.*.*"} {
+		assert.Error(t, ValidateLevelOverrideName(name), name)
+	}
+}
+
+func TestLevelOverrides(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	overrides := NewLevelOverrides()
+	overrides.now = func() time.Time { return now }
+
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	observedCore, logs := observer.New(level)
+	lggr := zap.New(newLevelOverrideCore(observedCore, level, overrides)).Sugar()
+
+	logged := func(l *zap.SugaredLogger, lvl zapcore.Level) bool {
+		before := logs.Len()
+		l.Log(lvl, "test")
+		return logs.Len() > before
+	}
+
+	txm := lggr.Named("EVM").Named("1").Named("Txm")
+	mercury := lggr.Named("OCR2").Named("Mercury")
+	transmitter := mercury.Named("Transmitter")
+
+	assert.False(t, logged(txm, zapcore.DebugLevel))
+	assert.True(t, logged(mercury, zapcore.InfoLevel))
+
+	_, err := overrides.Set("EVM.1.Txm", zapcore.DebugLevel, time.Minute)
+	require.NoError(t, err)
+	_, err = overrides.Set("OCR2.*", zapcore.WarnLevel, 0)
+	require.NoError(t, err)
+	_, err = overrides.Set("OCR2.Mercury.Transmitter", zapcore.DebugLevel, 0)
+	require.NoError(t, err)
+	_, err = overrides.Set("OCR2.*.Mercury", zapcore.DebugLevel, 0)
+	require.Error(t, err)
+
+	assert.True(t, logged(txm, zapcore.DebugLevel))
+	assert.False(t, logged(lggr.Named("EVM"), zapcore.DebugLevel), "override does not apply to parent loggers")
+	assert.False(t, logged(txm.Named("Broadcaster"), zapcore.DebugLevel), "exact override does not apply to sub-loggers")
+	assert.False(t, logged(mercury, zapcore.InfoLevel))
+	assert.True(t, logged(mercury, zapcore.WarnLevel))
+	assert.True(t, logged(transmitter, zapcore.DebugLevel), "exact override beats wildcard")
+	assert.True(t, logged(lggr.Named("OCR2"), zapcore.InfoLevel), "wildcard only applies to sub-loggers")
+	assert.True(t, logged(lggr, zapcore.InfoLevel))
+
+	list := overrides.List()
+	require.Len(t, list, 3)
+	assert.Equal(t, "EVM.1.Txm", list[0].Name)
+	require.NotNil(t, list[0].ExpiresAt)
+	assert.Equal(t, now.Add(time.Minute), *list[0].ExpiresAt)
+	assert.Equal(t, "OCR2.*", list[1].Name)
+	assert.Nil(t, list[1].ExpiresAt)
+
+	// expired overrides revert to the global level
+	now = now.Add(time.Minute)
+	assert.False(t, logged(txm, zapcore.DebugLevel))
+	assert.Len(t, overrides.List(), 2)
+
+	assert.True(t, overrides.Remove("OCR2.*"))
+	assert.False(t, overrides.Remove("OCR2.*"))
+	assert.True(t, logged(mercury, zapcore.InfoLevel))
+
+	level.SetLevel(zapcore.ErrorLevel)
+	assert.False(t, logged(mercury, zapcore.WarnLevel))
+	assert.True(t, logged(transmitter, zapcore.DebugLevel))
+}
@@ -163,6 +163,8 @@ type Config struct {
 	FileMaxAgeDays int
 	FileMaxBackups int // files
 	SentryEnabled  bool
+	// LevelOverrides are the log level overrides of named loggers, optional.
+	LevelOverrides *LevelOverrides
 
 	diskSpaceAvailableFn diskSpaceAvailableFn
 	diskPollConfig       zapDiskPollConfig
@@ -188,7 +190,7 @@ func (c *Config) New() (Logger, func() error) {
 		err         error
 	)
 	if !c.DebugLogsToDisk() {
-		l, closeLogger, err = newDefaultLogger(cfg, c.UnixTS)
+		l, closeLogger, err = newDefaultLogger(cfg, c.UnixTS, c.LevelOverrides)
 	} else {
 		l, closeLogger, err = newRotatingFileLogger(cfg, *c)
 	}
@@ -240,8 +242,8 @@ func newZapConfigBase() zap.Config {
 	return cfg
 }
 
-func newDefaultLogger(zcfg zap.Config, unixTS bool) (Logger, func() error, error) {
-	core, coreCloseFn, err := newDefaultLoggingCore(zcfg, unixTS)
+func newDefaultLogger(zcfg zap.Config, unixTS bool, overrides *LevelOverrides) (Logger, func() error, error) {
+	core, coreCloseFn, err := newDefaultLoggingCore(zcfg, unixTS, overrides)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -271,7 +273,7 @@ func newLoggerForCore(zcfg zap.Config, core zapcore.Core) (*zapLogger, func(), e
 	}, closeFn, nil
 }
 
-func newDefaultLoggingCore(zcfg zap.Config, unixTS bool) (zapcore.Core, func(), error) {
+func newDefaultLoggingCore(zcfg zap.Config, unixTS bool, overrides *LevelOverrides) (zapcore.Core, func(), error) {
 	encoder := zapcore.NewJSONEncoder(makeEncoderConfig(unixTS))
 
 	sink, closeOut, err := zap.Open(zcfg.OutputPaths...)
@@ -286,6 +288,9 @@ func newDefaultLoggingCore(zcfg zap.Config, unixTS bool) (zapcore.Core, func(),
 	filteredLogLevels := zap.LevelEnablerFunc(zcfg.Level.Enabled)
 
 	core := zapcore.NewCore(encoder, sink, filteredLogLevels)
+	if overrides != nil {
+		core = newLevelOverrideCore(core, zcfg.Level, overrides)
+	}
 	return core, closeOut, nil
 }
 
@@ -87,7 +87,7 @@ func (l *zapDiskLogger) pollDiskSpace() {
 }
 
 func newRotatingFileLogger(zcfg zap.Config, c Config, cores ...zapcore.Core) (*zapDiskLogger, func() error, error) {
-	defaultCore, defaultCloseFn, err := newDefaultLoggingCore(zcfg, c.UnixTS)
+	defaultCore, defaultCloseFn, err := newDefaultLoggingCore(zcfg, c.UnixTS, c.LevelOverrides)
 	if err != nil {
 		return nil, nil, err
 	}
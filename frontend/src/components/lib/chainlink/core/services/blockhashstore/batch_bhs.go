@@ -70,3 +70,26 @@ func (b *BatchBlockhashStore) StoreVerifyHeader(ctx context.Context, blockNumber
 
 	return nil
 }
+
+// Store stores the blockhashes of the given block numbers, which must be
+// within the last 256 blocks when the transaction is mined. Blocks that are
+// too old are skipped by the contract.
+func (b *BatchBlockhashStore) Store(ctx context.Context, blockNumbers []*big.Int, fromAddress common.Address) error {
+	payload, err := b.abi.Pack("store", blockNumbers)
+	if err != nil {
+		return errors.Wrap(err, "packing args")
+	}
+
+	_, err = b.txm.CreateTransaction(ctx, txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      b.batchbhs.Address(),
+		EncodedPayload: payload,
+		FeeLimit:       b.config.LimitDefault(),
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "creating transaction")
+	}
+
+	return nil
+}
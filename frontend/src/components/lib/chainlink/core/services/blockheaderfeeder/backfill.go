@@ -0,0 +1,457 @@
+package blockheaderfeeder
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/batch_blockhash_store"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+const (
+	// DefaultBackfillBatchSize is the number of blockhashes stored per
+	// transaction, the same as the default storeBlockhashesBatchSize of block
+	// header feeder jobs
+	DefaultBackfillBatchSize = 10
+
+	// anchorSearchBatchSize is the number of blockhashes read per call when
+	// looking for a stored blockhash to verify headers against
+	anchorSearchBatchSize = 1000
+
+	// anchorLookback is how far behind the chain head a blockhash is stored
+	// when no stored blockhash is found, leaving enough of the 256 block
+	// window for the transaction to be mined
+	anchorLookback = 128
+
+	// backfillStorePollPeriod and backfillStoreTimeout control how a backfill
+	// waits for its store transactions to be mined
+	backfillStorePollPeriod = 5 * time.Second
+	backfillStoreTimeout    = 10 * time.Minute
+)
+
+// BackfillBatchBHS defines the BatchBlockhashStore calls used by a backfill.
+type BackfillBatchBHS interface {
+	BatchBHS
+
+	// Store stores the blockhashes of recent blocks
+	Store(ctx context.Context, blockNumbers []*big.Int, fromAddress common.Address) error
+}
+
+// Backfiller stores the blockhashes of a block range, skipping the blocks
+// that are already stored. The blockhash of a block is verified against the
+// header of the following block, so blocks are stored from the top of the
+// range downwards, starting from the closest stored blockhash above the range.
+// Progress only advances once the blockhashes of a batch are stored on-chain,
+// so a reverted or dropped transaction is sent again when the backfill is
+// resumed.
+type Backfiller struct {
+	lggr                logger.Logger
+	orm                 BackfillORM
+	batchBHS            BackfillBatchBHS
+	blockHeaderProvider BlockHeaderProvider
+	latestBlock         func(ctx context.Context) (uint64, error)
+	storePollPeriod     time.Duration
+	storeTimeout        time.Duration
+}
+
+// NewBackfiller creates a new Backfiller instance.
+func NewBackfiller(
+	lggr logger.Logger,
+	orm BackfillORM,
+	batchBHS BackfillBatchBHS,
+	blockHeaderProvider BlockHeaderProvider,
+	latestBlock func(ctx context.Context) (uint64, error),
+	storePollPeriod time.Duration,
+	storeTimeout time.Duration,
+) *Backfiller {
+	return &Backfiller{
+		lggr:                lggr,
+		orm:                 orm,
+		batchBHS:            batchBHS,
+		blockHeaderProvider: blockHeaderProvider,
+		latestBlock:         latestBlock,
+		storePollPeriod:     storePollPeriod,
+		storeTimeout:        storeTimeout,
+	}
+}
+
+// Run processes the backfill from b.NextBlock down to b.FromBlock, saving its
+// progress after the blockhashes of each batch are stored so that it can be
+// resumed.
+func (f *Backfiller) Run(ctx context.Context, b *Backfill) error {
+	lggr := f.lggr.With("backfillID", b.ID, "fromBlock", b.FromBlock, "toBlock", b.ToBlock)
+	if b.Done() {
+		return nil
+	}
+
+	anchor, err := f.findAnchor(ctx, lggr, b)
+	if err != nil {
+		return errors.Wrap(err, "finding stored blockhash")
+	}
+	if anchor > b.NextBlock+1 {
+		// the blockhashes between the range and the anchor have to be stored
+		// first, for the headers in the range to be verified
+		lggr.Infow("Storing blockhashes above the range to reach a stored blockhash", "anchor", anchor, "extraBlocks", anchor-b.NextBlock-1)
+		b.NextBlock = anchor - 1
+	}
+
+	for !b.Done() {
+		low := b.NextBlock - int64(b.BatchSize) + 1
+		if low < b.FromBlock {
+			low = b.FromBlock
+		}
+		blocks, err := blockhashstore.DecreasingBlockRange(big.NewInt(b.NextBlock), big.NewInt(low))
+		if err != nil {
+			return err
+		}
+
+		blockhashes, err := f.batchBHS.GetBlockhashes(ctx, blocks)
+		if err != nil {
+			return errors.Wrap(err, "fetching blockhashes")
+		}
+		if len(blockhashes) != len(blocks) {
+			return errors.Errorf("got %d blockhashes for %d blocks", len(blockhashes), len(blocks))
+		}
+
+		// a stored blockhash verifies the header of the block below it, so
+		// stored blocks can be skipped without breaking the chain of headers
+		var missing []*big.Int
+		for i, bh := range blockhashes {
+			if bytes.Equal(bh[:], zeroHash[:]) {
+				missing = append(missing, blocks[i])
+			}
+		}
+
+		if len(missing) > 0 {
+			headers, err := f.blockHeaderProvider.RlpHeadersBatch(ctx, missing)
+			if err != nil {
+				return errors.Wrap(err, "fetching block headers")
+			}
+			lggr.Debugw("Storing block headers", "blockRange", missing)
+			if err = f.batchBHS.StoreVerifyHeader(ctx, missing, headers, b.FromAddress); err != nil {
+				return errors.Wrap(err, "store block headers")
+			}
+			if err = f.waitStored(ctx, missing); err != nil {
+				return err
+			}
+		}
+
+		b.StoredBlocks += int64(len(missing))
+		b.SkippedBlocks += int64(len(blocks) - len(missing))
+		b.NextBlock = low - 1
+		if err = f.orm.UpdateBackfill(ctx, b); err != nil {
+			return err
+		}
+	}
+
+	lggr.Infow("Blockhash store backfill completed", "storedBlocks", b.StoredBlocks, "skippedBlocks", b.SkippedBlocks)
+	return nil
+}
+
+// findAnchor returns the lowest block above b.NextBlock with a stored
+// blockhash. If there is none, the blockhash of a recent block is stored.
+func (f *Backfiller) findAnchor(ctx context.Context, lggr logger.Logger, b *Backfill) (int64, error) {
+	latest, err := f.latestBlock(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "fetching latest block")
+	}
+	if uint64(b.NextBlock) >= latest {
+		return 0, errors.Errorf("block %d is not below the latest block %d", b.NextBlock, latest)
+	}
+
+	for i := uint64(b.NextBlock) + 1; i < latest; i += anchorSearchBatchSize {
+		j := min(i+anchorSearchBatchSize, latest)
+		var blocks []*big.Int
+		for n := i; n < j; n++ {
+			blocks = append(blocks, new(big.Int).SetUint64(n))
+		}
+		blockhashes, err := f.batchBHS.GetBlockhashes(ctx, blocks)
+		if err != nil {
+			return 0, errors.Wrap(err, "fetching blockhashes")
+		}
+		for idx, bh := range blockhashes {
+			if !bytes.Equal(bh[:], zeroHash[:]) {
+				return int64(i) + int64(idx), nil
+			}
+		}
+	}
+
+	anchor := uint64(b.NextBlock) + 1
+	if latest > anchorLookback && latest-anchorLookback > anchor {
+		anchor = latest - anchorLookback
+	}
+	lggr.Infow("No stored blockhash found above the range, storing a recent blockhash", "anchor", anchor, "latestBlock", latest)
+	if err = f.batchBHS.Store(ctx, []*big.Int{new(big.Int).SetUint64(anchor)}, b.FromAddress); err != nil {
+		return 0, errors.Wrap(err, "storing recent blockhash")
+	}
+	if err = f.waitStored(ctx, []*big.Int{new(big.Int).SetUint64(anchor)}); err != nil {
+		return 0, err
+	}
+	return int64(anchor), nil
+}
+
+// waitStored waits until the blockhashes of all the given blocks are stored,
+// failing if the store transaction is not mined in time, or reverted.
+func (f *Backfiller) waitStored(ctx context.Context, blocks []*big.Int) error {
+	ctx, cancel := context.WithTimeout(ctx, f.storeTimeout)
+	defer cancel()
+	ticker := time.NewTicker(f.storePollPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("timed out waiting for the blockhashes of blocks %v to be stored", blocks)
+		case <-ticker.C:
+			blockhashes, err := f.batchBHS.GetBlockhashes(ctx, blocks)
+			if err != nil {
+				f.lggr.Warnw("Failed to check if blockhashes are stored", "blocks", blocks, "err", err)
+				continue
+			}
+			if len(blockhashes) == len(blocks) && !slices.ContainsFunc(blockhashes, func(bh [32]byte) bool {
+				return bytes.Equal(bh[:], zeroHash[:])
+			}) {
+				return nil
+			}
+		}
+	}
+}
+
+// BackfillRequest describes a block range to backfill. Zero values are
+// replaced by defaults.
+type BackfillRequest struct {
+	EVMChainID      *big.Int
+	BatchBHSAddress common.Address
+	FromBlock       int64
+	ToBlock         int64
+	// FromAddress is the sending key used for all the transactions of the
+	// backfill, as headers must be stored in order
+	FromAddress *common.Address
+	BatchSize   uint32
+	GasLimit    uint64
+}
+
+// BackfillRunner runs blockhash store backfills in the background, and
+// resumes the unfinished ones when the node starts.
+type BackfillRunner struct {
+	services.StateMachine
+	lggr         logger.Logger
+	orm          BackfillORM
+	legacyChains legacyevm.LegacyChainContainer
+	ks           keystore.Eth
+
+	mu      sync.Mutex
+	running map[int64]struct{}
+	wg      sync.WaitGroup
+	stopCh  services.StopChan
+}
+
+// NewBackfillRunner creates a new BackfillRunner instance.
+func NewBackfillRunner(lggr logger.Logger, orm BackfillORM, legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth) *BackfillRunner {
+	return &BackfillRunner{
+		lggr:         lggr.Named("BlockhashStoreBackfill"),
+		orm:          orm,
+		legacyChains: legacyChains,
+		ks:           ks,
+		running:      make(map[int64]struct{}),
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start resumes the backfills that were running when the node stopped.
+func (r *BackfillRunner) Start(ctx context.Context) error {
+	return r.StartOnce("BlockhashStoreBackfillRunner", func() error {
+		backfills, err := r.orm.FindBackfillsWithState(ctx, BackfillStateRunning)
+		if err != nil {
+			return err
+		}
+		for _, b := range backfills {
+			r.lggr.Infow("Resuming blockhash store backfill", "backfillID", b.ID, "nextBlock", b.NextBlock)
+			r.launch(b)
+		}
+		return nil
+	})
+}
+
+// Close stops the running backfills, which are resumed on the next start.
+func (r *BackfillRunner) Close() error {
+	return r.StopOnce("BlockhashStoreBackfillRunner", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+// Backfill starts a backfill of the requested range. Requesting a range that
+// was already backfilled returns the existing backfill, resuming it if it
+// stopped on an error, or scanning the range again for missing blockhashes if
+// it completed.
+func (r *BackfillRunner) Backfill(ctx context.Context, req BackfillRequest) (Backfill, error) {
+	if req.FromBlock < 0 || req.FromBlock > req.ToBlock {
+		return Backfill{}, errors.Errorf("invalid block range [%d, %d]", req.FromBlock, req.ToBlock)
+	}
+	if req.EVMChainID == nil {
+		return Backfill{}, errors.New("evmChainID must be set")
+	}
+	if err := validateChainID(req.EVMChainID.Int64()); err != nil {
+		return Backfill{}, err
+	}
+	chain, err := r.legacyChains.Get(req.EVMChainID.String())
+	if err != nil {
+		return Backfill{}, err
+	}
+
+	head, err := chain.Client().HeadByNumber(ctx, nil)
+	if err != nil {
+		return Backfill{}, errors.Wrap(err, "getting chain head")
+	}
+	if req.ToBlock >= head.Number {
+		return Backfill{}, errors.Errorf("toBlock %d must be below the latest block %d", req.ToBlock, head.Number)
+	}
+
+	var fromAddress common.Address
+	if req.FromAddress != nil {
+		if err = r.ks.CheckEnabled(ctx, *req.FromAddress, req.EVMChainID); err != nil {
+			return Backfill{}, err
+		}
+		fromAddress = *req.FromAddress
+	} else if fromAddress, err = r.ks.GetRoundRobinAddress(ctx, req.EVMChainID); err != nil {
+		return Backfill{}, errors.Wrap(err, "getting sending key")
+	}
+
+	b := Backfill{
+		EVMChainID:      *ubig.New(req.EVMChainID),
+		BatchBHSAddress: req.BatchBHSAddress,
+		FromAddress:     fromAddress,
+		FromBlock:       req.FromBlock,
+		ToBlock:         req.ToBlock,
+		BatchSize:       req.BatchSize,
+		GasLimit:        req.GasLimit,
+	}
+	if b.BatchSize == 0 {
+		b.BatchSize = DefaultBackfillBatchSize
+	}
+	if b.GasLimit == 0 {
+		b.GasLimit = chain.Config().EVM().GasEstimator().LimitDefault()
+	}
+	b, err = r.orm.FindOrCreateBackfill(ctx, b)
+	if err != nil {
+		return Backfill{}, err
+	}
+
+	if r.isRunning(b.ID) {
+		return b, nil
+	}
+	if b.State == BackfillStateCompleted {
+		// blocks that are still stored are skipped without a transaction
+		b.NextBlock, b.StoredBlocks, b.SkippedBlocks = b.ToBlock, 0, 0
+	}
+	b.State = BackfillStateRunning
+	b.Error = nil
+	if err = r.orm.UpdateBackfill(ctx, &b); err != nil {
+		return Backfill{}, err
+	}
+	r.launch(b)
+	return b, nil
+}
+
+// Backfills returns all backfills, most recent first.
+func (r *BackfillRunner) Backfills(ctx context.Context) ([]Backfill, error) {
+	return r.orm.FindBackfills(ctx)
+}
+
+func (r *BackfillRunner) isRunning(id int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.running[id]
+	return ok
+}
+
+func (r *BackfillRunner) launch(b Backfill) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.running[b.ID]; ok {
+		return
+	}
+	r.running[b.ID] = struct{}{}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() {
+			r.mu.Lock()
+			delete(r.running, b.ID)
+			r.mu.Unlock()
+		}()
+		ctx, cancel := r.stopCh.NewCtx()
+		defer cancel()
+		r.run(ctx, &b)
+	}()
+}
+
+func (r *BackfillRunner) run(ctx context.Context, b *Backfill) {
+	lggr := r.lggr.With("backfillID", b.ID, "evmChainID", b.EVMChainID.String(), "batchBHSAddress", b.BatchBHSAddress)
+	err := r.backfill(ctx, lggr, b)
+	if ctx.Err() != nil {
+		// the node is stopping, the backfill is resumed on the next start
+		return
+	}
+	if err != nil {
+		lggr.Errorw("Blockhash store backfill failed", "err", err)
+		msg := err.Error()
+		b.State, b.Error = BackfillStateErrored, &msg
+	} else {
+		b.State = BackfillStateCompleted
+	}
+	if err = r.orm.UpdateBackfill(ctx, b); err != nil {
+		lggr.Errorw("Failed to save blockhash store backfill", "err", err)
+	}
+}
+
+func (r *BackfillRunner) backfill(ctx context.Context, lggr logger.Logger, b *Backfill) error {
+	chain, err := r.legacyChains.Get(b.EVMChainID.String())
+	if err != nil {
+		return err
+	}
+	batchBlockhashStore, err := batch_blockhash_store.NewBatchBlockhashStore(b.BatchBHSAddress, chain.Client())
+	if err != nil {
+		return errors.Wrap(err, "building batch bhs")
+	}
+	batchBHS, err := blockhashstore.NewBatchBHS(gasLimit(b.GasLimit), chain.TxManager(), batchBlockhashStore)
+	if err != nil {
+		return errors.Wrap(err, "building batchBHS")
+	}
+
+	backfiller := NewBackfiller(
+		lggr,
+		r.orm,
+		batchBHS,
+		NewGethBlockHeaderProvider(chain.Client()),
+		func(ctx context.Context) (uint64, error) {
+			head, err := chain.Client().HeadByNumber(ctx, nil)
+			if err != nil {
+				return 0, errors.Wrap(err, "getting chain head")
+			}
+			return uint64(head.Number), nil
+		},
+		backfillStorePollPeriod,
+		backfillStoreTimeout,
+	)
+	lggr.Infow("Running blockhash store backfill", "fromBlock", b.FromBlock, "toBlock", b.ToBlock, "nextBlock", b.NextBlock)
+	return backfiller.Run(ctx, b)
+}
+
+// gasLimit is the gas limit of the transactions of a backfill
+type gasLimit uint64
+
+func (g gasLimit) LimitDefault() uint64 { return uint64(g) }
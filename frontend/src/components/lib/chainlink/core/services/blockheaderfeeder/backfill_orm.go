@@ -0,0 +1,117 @@
+package blockheaderfeeder
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// BackfillState is the state of a blockhash store backfill
+type BackfillState string
+
+const (
+	// BackfillStateRunning means the backfill is in progress, or was
+	// interrupted by a node restart and will be resumed
+	BackfillStateRunning BackfillState = "running"
+	// BackfillStateCompleted means every blockhash in the range is stored.
+	// Requesting the same range again scans it for missing blockhashes.
+	BackfillStateCompleted BackfillState = "completed"
+	// BackfillStateErrored means the backfill stopped on an error. Requesting
+	// the same range again resumes it.
+	BackfillStateErrored BackfillState = "errored"
+)
+
+// Backfill stores the blockhashes of a block range in a blockhash store,
+// using the block headers of the following blocks. Blocks are processed from
+// ToBlock down to FromBlock, NextBlock being the highest block that has not
+// been processed yet.
+type Backfill struct {
+	ID              int64
+	EVMChainID      ubig.Big       `db:"evm_chain_id"`
+	BatchBHSAddress common.Address `db:"batch_bhs_address"`
+	FromAddress     common.Address
+	FromBlock       int64
+	ToBlock         int64
+	BatchSize       uint32
+	GasLimit        uint64
+	NextBlock       int64
+	StoredBlocks    int64
+	SkippedBlocks   int64
+	State           BackfillState
+	Error           *string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Done returns true once every block of the range has been processed
+func (b Backfill) Done() bool {
+	return b.NextBlock < b.FromBlock
+}
+
+// BackfillORM persists the progress of blockhash store backfills so that they
+// can be resumed
+type BackfillORM interface {
+	// FindOrCreateBackfill returns the backfill of the same chain, contract and
+	// range as b, creating it if there is none
+	FindOrCreateBackfill(ctx context.Context, b Backfill) (Backfill, error)
+	FindBackfill(ctx context.Context, id int64) (Backfill, error)
+	FindBackfills(ctx context.Context) ([]Backfill, error)
+	FindBackfillsWithState(ctx context.Context, state BackfillState) ([]Backfill, error)
+	// UpdateBackfill saves the progress and state of a backfill
+	UpdateBackfill(ctx context.Context, b *Backfill) error
+}
+
+type backfillORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ BackfillORM = &backfillORM{}
+
+func NewBackfillORM(ds sqlutil.DataSource) BackfillORM {
+	return &backfillORM{ds: ds}
+}
+
+func (o *backfillORM) FindOrCreateBackfill(ctx context.Context, b Backfill) (Backfill, error) {
+	var backfill Backfill
+	err := o.ds.GetContext(ctx, &backfill, `
+INSERT INTO blockhash_store_backfills (evm_chain_id, batch_bhs_address, from_address, from_block, to_block, batch_size, gas_limit, next_block, state, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $5, $8, NOW(), NOW())
+ON CONFLICT (evm_chain_id, batch_bhs_address, from_block, to_block) DO UPDATE SET updated_at = blockhash_store_backfills.updated_at
+RETURNING *`,
+		b.EVMChainID, b.BatchBHSAddress, b.FromAddress, b.FromBlock, b.ToBlock, b.BatchSize, b.GasLimit, BackfillStateRunning)
+	return backfill, errors.Wrap(err, "failed to find or create blockhash store backfill")
+}
+
+func (o *backfillORM) FindBackfill(ctx context.Context, id int64) (Backfill, error) {
+	var backfill Backfill
+	err := o.ds.GetContext(ctx, &backfill, `SELECT * FROM blockhash_store_backfills WHERE id = $1`, id)
+	return backfill, errors.Wrap(err, "failed to find blockhash store backfill")
+}
+
+func (o *backfillORM) FindBackfills(ctx context.Context) ([]Backfill, error) {
+	var backfills []Backfill
+	err := o.ds.SelectContext(ctx, &backfills, `SELECT * FROM blockhash_store_backfills ORDER BY id DESC`)
+	return backfills, errors.Wrap(err, "failed to find blockhash store backfills")
+}
+
+func (o *backfillORM) FindBackfillsWithState(ctx context.Context, state BackfillState) ([]Backfill, error) {
+	var backfills []Backfill
+	err := o.ds.SelectContext(ctx, &backfills, `SELECT * FROM blockhash_store_backfills WHERE state = $1 ORDER BY id ASC`, state)
+	return backfills, errors.Wrap(err, "failed to find blockhash store backfills")
+}
+
+func (o *backfillORM) UpdateBackfill(ctx context.Context, b *Backfill) error {
+	err := o.ds.GetContext(ctx, &b.UpdatedAt, `
+UPDATE blockhash_store_backfills
+SET next_block = $2, stored_blocks = $3, skipped_blocks = $4, state = $5, error = $6, updated_at = NOW()
+WHERE id = $1
+RETURNING updated_at`,
+		b.ID, b.NextBlock, b.StoredBlocks, b.SkippedBlocks, b.State, b.Error)
+	return errors.Wrap(err, "failed to update blockhash store backfill")
+}
@@ -0,0 +1,173 @@
+package blockheaderfeeder
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+)
+
+type backfillBatchBHS struct {
+	blockhashstore.TestBatchBHS
+	storedRecent []uint64
+	batches      [][]uint64
+	// dropped makes StoreVerifyHeader succeed without storing anything, like
+	// a transaction that reverts or is never mined
+	dropped bool
+}
+
+func (b *backfillBatchBHS) GetBlockhashes(_ context.Context, blockNumbers []*big.Int) ([][32]byte, error) {
+	blockhashes := make([][32]byte, len(blockNumbers))
+	for i, n := range blockNumbers {
+		if slices.Contains(b.Stored, n.Uint64()) {
+			blockhashes[i] = common.BigToHash(n)
+		}
+	}
+	return blockhashes, nil
+}
+
+func (b *backfillBatchBHS) StoreVerifyHeader(ctx context.Context, blockNumbers []*big.Int, blockHeaders [][]byte, fromAddress common.Address) error {
+	if b.dropped {
+		return nil
+	}
+	var batch []uint64
+	for _, n := range blockNumbers {
+		// a header can only be verified against the stored blockhash of the next block
+		if !slices.Contains(b.Stored, n.Uint64()+1) {
+			return errors.Errorf("blockhash of block %d is not stored", n.Uint64()+1)
+		}
+		b.Stored = append(b.Stored, n.Uint64())
+		batch = append(batch, n.Uint64())
+	}
+	b.batches = append(b.batches, batch)
+	return nil
+}
+
+func (b *backfillBatchBHS) Store(_ context.Context, blockNumbers []*big.Int, _ common.Address) error {
+	for _, n := range blockNumbers {
+		b.storedRecent = append(b.storedRecent, n.Uint64())
+		b.Stored = append(b.Stored, n.Uint64())
+	}
+	return nil
+}
+
+type fakeBackfillORM struct {
+	updates []Backfill
+}
+
+func (o *fakeBackfillORM) FindOrCreateBackfill(context.Context, Backfill) (Backfill, error) {
+	panic("unimplemented")
+}
+func (o *fakeBackfillORM) FindBackfill(context.Context, int64) (Backfill, error) {
+	panic("unimplemented")
+}
+func (o *fakeBackfillORM) FindBackfills(context.Context) ([]Backfill, error) { panic("unimplemented") }
+func (o *fakeBackfillORM) FindBackfillsWithState(context.Context, BackfillState) ([]Backfill, error) {
+	panic("unimplemented")
+}
+
+func (o *fakeBackfillORM) UpdateBackfill(_ context.Context, b *Backfill) error {
+	o.updates = append(o.updates, *b)
+	return nil
+}
+
+func newTestBackfiller(t *testing.T, batchBHS *backfillBatchBHS, orm *fakeBackfillORM, latest uint64) *Backfiller {
+	return NewBackfiller(
+		logger.TestLogger(t),
+		orm,
+		batchBHS,
+		&blockhashstore.TestBlockHeaderProvider{},
+		func(context.Context) (uint64, error) { return latest, nil },
+		time.Millisecond,
+		time.Second,
+	)
+}
+
+func TestBackfiller_Run(t *testing.T) {
+	t.Run("skips stored blocks", func(t *testing.T) {
+		batchBHS := &backfillBatchBHS{}
+		batchBHS.Stored = []uint64{111, 105}
+		orm := &fakeBackfillORM{}
+		b := &Backfill{FromBlock: 100, ToBlock: 110, NextBlock: 110, BatchSize: 4}
+
+		require.NoError(t, newTestBackfiller(t, batchBHS, orm, 1000).Run(testutils.Context(t), b))
+
+		assert.Equal(t, [][]uint64{{110, 109, 108, 107}, {106, 104, 103}, {102, 101, 100}}, batchBHS.batches)
+		assert.Equal(t, int64(10), b.StoredBlocks)
+		assert.Equal(t, int64(1), b.SkippedBlocks)
+		assert.True(t, b.Done())
+		assert.Empty(t, batchBHS.storedRecent)
+		// progress is saved after every batch
+		require.Len(t, orm.updates, 3)
+		assert.Equal(t, int64(106), orm.updates[0].NextBlock)
+	})
+
+	t.Run("stores the blocks up to the closest stored blockhash", func(t *testing.T) {
+		batchBHS := &backfillBatchBHS{}
+		batchBHS.Stored = []uint64{115}
+		b := &Backfill{FromBlock: 100, ToBlock: 110, NextBlock: 110, BatchSize: 10}
+
+		require.NoError(t, newTestBackfiller(t, batchBHS, &fakeBackfillORM{}, 1000).Run(testutils.Context(t), b))
+
+		assert.Equal(t, [][]uint64{{114, 113, 112, 111, 110, 109, 108, 107, 106, 105}, {104, 103, 102, 101, 100}}, batchBHS.batches)
+		assert.Equal(t, int64(15), b.StoredBlocks)
+	})
+
+	t.Run("stores a recent blockhash when none is stored", func(t *testing.T) {
+		batchBHS := &backfillBatchBHS{}
+		b := &Backfill{FromBlock: 100, ToBlock: 101, NextBlock: 101, BatchSize: 100}
+
+		require.NoError(t, newTestBackfiller(t, batchBHS, &fakeBackfillORM{}, 300).Run(testutils.Context(t), b))
+
+		assert.Equal(t, []uint64{172}, batchBHS.storedRecent)
+		require.Len(t, batchBHS.batches, 1)
+		assert.Len(t, batchBHS.batches[0], 72)
+		assert.Equal(t, uint64(100), batchBHS.batches[0][71])
+	})
+
+	t.Run("resumes from the next block", func(t *testing.T) {
+		batchBHS := &backfillBatchBHS{}
+		batchBHS.Stored = []uint64{106, 107, 108, 109, 110, 111}
+		b := &Backfill{FromBlock: 100, ToBlock: 110, NextBlock: 105, BatchSize: 10, StoredBlocks: 5}
+
+		require.NoError(t, newTestBackfiller(t, batchBHS, &fakeBackfillORM{}, 1000).Run(testutils.Context(t), b))
+
+		assert.Equal(t, [][]uint64{{105, 104, 103, 102, 101, 100}}, batchBHS.batches)
+		assert.Equal(t, int64(11), b.StoredBlocks)
+	})
+
+	t.Run("does not advance past blockhashes that are not stored", func(t *testing.T) {
+		batchBHS := &backfillBatchBHS{dropped: true}
+		batchBHS.Stored = []uint64{111}
+		orm := &fakeBackfillORM{}
+		b := &Backfill{FromBlock: 100, ToBlock: 110, NextBlock: 110, BatchSize: 4}
+
+		err := newTestBackfiller(t, batchBHS, orm, 1000).Run(testutils.Context(t), b)
+		require.ErrorContains(t, err, "timed out waiting for the blockhashes")
+		assert.Empty(t, orm.updates)
+		assert.Equal(t, int64(110), b.NextBlock)
+		assert.Zero(t, b.StoredBlocks)
+
+		// the batch is sent again when the backfill is resumed
+		batchBHS.dropped = false
+		require.NoError(t, newTestBackfiller(t, batchBHS, orm, 1000).Run(testutils.Context(t), b))
+		assert.Equal(t, []uint64{110, 109, 108, 107}, batchBHS.batches[0])
+		assert.Equal(t, int64(11), b.StoredBlocks)
+	})
+
+	t.Run("range above the latest block", func(t *testing.T) {
+		b := &Backfill{FromBlock: 100, ToBlock: 110, NextBlock: 110, BatchSize: 10}
+		err := newTestBackfiller(t, &backfillBatchBHS{}, &fakeBackfillORM{}, 105).Run(testutils.Context(t), b)
+		require.ErrorContains(t, err, "is not below the latest block")
+	})
+}
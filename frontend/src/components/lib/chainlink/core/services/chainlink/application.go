@@ -64,6 +64,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keytopup"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/archive"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
@@ -81,6 +83,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
@@ -107,6 +110,9 @@ type Application interface {
 	GetDB() sqlutil.DataSource
 	GetConfig() GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	// ReloadConfig reloads the config and secrets files, applying the changes
+	// that can take effect without a restart.
+	ReloadConfig(ctx context.Context) (ConfigReload, error)
 	GetKeyStore() keystore.Master
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
@@ -148,6 +154,15 @@ type Application interface {
 	FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.Block, error)
 	// DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 	DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error
+
+	// VRFBacklog returns the pending request inspectors of the running VRF v2 and v2plus jobs
+	VRFBacklog() *vrfcommon.BacklogRegistry
+	// BlockhashStoreBackfills runs backfills of blockhashes for arbitrary block ranges
+	BlockhashStoreBackfills() *blockheaderfeeder.BackfillRunner
+	// KeyTopUps tops up the sending keys of the configured chains from their treasury key
+	KeyTopUps() *keytopup.Manager
+	// ReportArchiveReplays runs replays of archived LLO and Mercury reports
+	ReportArchiveReplays() *archive.ReplayRunner
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -180,7 +195,13 @@ type ChainlinkApplication struct {
 	profiler                 *pyroscope.Profiler
 	loopRegistry             *plugins.LoopRegistry
 	loopRegistrarConfig      plugins.RegistrarConfig
+	vrfBacklog               *vrfcommon.BacklogRegistry
+	bhsBackfills             *blockheaderfeeder.BackfillRunner
+	keyTopUps                *keytopup.Manager
+	reportArchiveReplays     *archive.ReplayRunner
+	telemetryManager         *telemetry.Manager
 
+	reloadMu    sync.Mutex
 	started     bool
 	startStopMu sync.Mutex
 }
@@ -309,12 +330,14 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 	evmFactoryCfg := EVMFactoryConfig{
 		ChainOpts: legacyevm.ChainOpts{
-			ChainConfigs:   cfg.EVMConfigs(),
-			DatabaseConfig: cfg.Database(),
-			ListenerConfig: cfg.Database().Listener(),
-			FeatureConfig:  cfg.Feature(),
-			MailMon:        mailMon,
-			DS:             opts.DS,
+			ChainConfigs:     cfg.EVMConfigs(),
+			DatabaseConfig:   cfg.Database(),
+			ListenerConfig:   cfg.Database().Listener(),
+			FeatureConfig:    cfg.Feature(),
+			MailMon:          mailMon,
+			DS:               opts.DS,
+			SpendBudgets:     GasSpendBudgets(cfg.GasSpend()),
+			OCRKeySelections: OCRKeySelections(cfg.OCRKeySelection()),
 		},
 		EthKeystore:   keyStore.Eth(),
 		CSAKeystore:   csaKeystore,
@@ -490,10 +513,22 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, loopRegistry.Register, loopRegistry.Unregister)
 
+	vrfBacklog := vrfcommon.NewBacklogRegistry()
+
+	bhsBackfills := blockheaderfeeder.NewBackfillRunner(globalLogger, blockheaderfeeder.NewBackfillORM(opts.DS), legacyEVMChains, keyStore.Eth())
+	srvcs = append(srvcs, bhsBackfills)
+
+	keyTopUps := keytopup.NewManager(globalLogger, keytopup.NewORM(opts.DS), cfg.KeyTopUp(), legacyEVMChains, keyStore.Eth())
+	srvcs = append(srvcs, keyTopUps)
+
+	reportArchiveReplays := archive.NewReplayRunner(globalLogger)
+	srvcs = append(srvcs, reportArchiveReplays)
+
 	var (
 		delegates = map[job.Type]job.Delegate{
 			job.DirectRequest: directrequest.NewDelegate(
 				globalLogger,
+				opts.DS,
 				pipelineRunner,
 				pipelineORM,
 				legacyEVMChains,
@@ -513,7 +548,8 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				pipelineORM,
 				legacyEVMChains,
 				globalLogger,
-				mailMon),
+				mailMon,
+				vrfBacklog),
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
 				externalInitiatorManager,
@@ -620,7 +656,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	if cfg.OCR2().Enabled() {
 		globalLogger.Debug("Off-chain reporting v2 enabled")
 
-		ocr2DelegateConfig := ocr2.NewDelegateConfig(cfg.OCR2(), cfg.Mercury(), cfg.Threshold(), cfg.Insecure(), cfg.JobPipeline(), loopRegistrarConfig)
+		ocr2DelegateConfig := ocr2.NewDelegateConfig(cfg.OCR2(), cfg.Mercury(), cfg.Threshold(), cfg.Insecure(), cfg.JobPipeline(), cfg.Tracing(), loopRegistrarConfig)
 
 		delegates[job.OffchainReporting2] = ocr2.NewDelegate(
 			ocr2.DelegateOpts{
@@ -742,6 +778,11 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		profiler:                 profiler,
 		loopRegistry:             loopRegistry,
 		loopRegistrarConfig:      loopRegistrarConfig,
+		vrfBacklog:               vrfBacklog,
+		bhsBackfills:             bhsBackfills,
+		keyTopUps:                keyTopUps,
+		reportArchiveReplays:     reportArchiveReplays,
+		telemetryManager:         telemetryManager,
 
 		ds: opts.DS,
 
@@ -993,6 +1034,44 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+func (app *ChainlinkApplication) ReloadConfig(ctx context.Context) (ConfigReload, error) {
+	cfg, ok := app.Config.(ReloadableConfig)
+	if !ok {
+		return ConfigReload{}, errors.New("config cannot be reloaded")
+	}
+
+	app.reloadMu.Lock()
+	defer app.reloadMu.Unlock()
+
+	reload, err := cfg.Reload()
+	if err != nil {
+		app.logger.Errorw("Failed to reload config", "err", err)
+		return reload, err
+	}
+	for _, path := range reload.Applied {
+		switch path {
+		case "Log.Level":
+			app.logger.SetLogLevel(cfg.Log().Level())
+		case "TelemetryIngress.Endpoints":
+			if err = app.telemetryManager.AddEndpoints(ctx, cfg.TelemetryIngress()); err != nil {
+				app.logger.Errorw("Failed to add telemetry endpoints", "err", err)
+			}
+		}
+	}
+
+	if reload.Changed() {
+		app.logger.Infow("Reloaded config", "applied", reload.Applied, "requiresRestart", reload.RequiresRestart, "notes", reload.Notes)
+	} else {
+		app.logger.Info("Reloaded config, no changes found")
+	}
+	app.AuditLogger.Audit(audit.ConfigReloaded, map[string]interface{}{
+		"applied":         reload.Applied,
+		"requiresRestart": reload.RequiresRestart,
+		"notes":           reload.Notes,
+	})
+	return reload, nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -1336,6 +1415,26 @@ func (app *ChainlinkApplication) ID() uuid.UUID {
 	return app.Config.AppID()
 }
 
+// VRFBacklog returns the pending request inspectors of the running VRF jobs
+func (app *ChainlinkApplication) VRFBacklog() *vrfcommon.BacklogRegistry {
+	return app.vrfBacklog
+}
+
+// BlockhashStoreBackfills returns the runner of blockhash store backfills
+func (app *ChainlinkApplication) BlockhashStoreBackfills() *blockheaderfeeder.BackfillRunner {
+	return app.bhsBackfills
+}
+
+// KeyTopUps returns the manager of sending key top-ups
+func (app *ChainlinkApplication) KeyTopUps() *keytopup.Manager {
+	return app.keyTopUps
+}
+
+// ReportArchiveReplays returns the runner of report archive replays
+func (app *ChainlinkApplication) ReportArchiveReplays() *archive.ReplayRunner {
+	return app.reportArchiveReplays
+}
+
 // FindLCA - finds last common ancestor
 func (app *ChainlinkApplication) FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.Block, error) {
 	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
@@ -1371,3 +1470,32 @@ func (app *ChainlinkApplication) DeleteLogPollerDataAfter(ctx context.Context, c
 
 	return nil
 }
+
+// GasSpendBudgets returns the configured gas spend budgets, for the transaction managers to enforce.
+func GasSpendBudgets(cfg config.GasSpend) []txmgr.SpendBudget {
+	var budgets []txmgr.SpendBudget
+	for _, b := range cfg.Budgets() {
+		budgets = append(budgets, txmgr.SpendBudget{
+			ChainID: b.ChainID(),
+			JobID:   b.JobID(),
+			Key:     b.Key(),
+			Window:  b.Window(),
+			Limit:   b.Limit().ToInt(),
+			Action:  txmgr.SpendBudgetAction(b.Action()),
+		})
+	}
+	return budgets
+}
+
+// OCRKeySelections returns the configured sending key selections, for the OCR transmitters of the jobs to apply.
+func OCRKeySelections(cfg config.OCRKeySelection) []txmgr.KeySelection {
+	var selections []txmgr.KeySelection
+	for _, c := range cfg.Chains() {
+		selections = append(selections, txmgr.KeySelection{
+			ChainID:      c.ChainID(),
+			Strategy:     txmgr.KeySelectionStrategy(c.Strategy()),
+			ExcludeStuck: c.ExcludeStuck(),
+		})
+	}
+	return selections
+}
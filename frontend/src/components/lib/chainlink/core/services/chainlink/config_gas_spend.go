@@ -0,0 +1,64 @@
+package chainlink
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.GasSpend = (*gasSpendConfig)(nil)
+
+type gasSpendConfig struct {
+	c toml.GasSpend
+}
+
+type gasSpendBudgetConfig struct {
+	c toml.GasSpendBudget
+}
+
+func (g *gasSpendConfig) Budgets() []config.GasSpendBudget {
+	var budgets []config.GasSpendBudget
+	for _, b := range g.c.Budgets {
+		budgets = append(budgets, &gasSpendBudgetConfig{
+			c: b,
+		})
+	}
+	return budgets
+}
+
+func (b *gasSpendBudgetConfig) ChainID() *big.Int {
+	return b.c.ChainID.ToInt()
+}
+
+func (b *gasSpendBudgetConfig) JobID() *int32 {
+	return b.c.JobID
+}
+
+func (b *gasSpendBudgetConfig) Key() *common.Address {
+	if b.c.Key == nil {
+		return nil
+	}
+	key := b.c.Key.Address()
+	return &key
+}
+
+func (b *gasSpendBudgetConfig) Window() time.Duration {
+	return b.c.Window.Duration()
+}
+
+func (b *gasSpendBudgetConfig) Limit() *assets.Wei {
+	return b.c.Limit
+}
+
+func (b *gasSpendBudgetConfig) Action() string {
+	if b.c.Action == nil {
+		return toml.GasSpendActionReject
+	}
+	return *b.c.Action
+}
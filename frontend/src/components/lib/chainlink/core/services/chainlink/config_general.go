@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -44,7 +45,15 @@ type generalConfig struct {
 
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
+	logLevelOverrides *logger.LevelOverrides
+
+	reloadMu sync.RWMutex // for the sections applied live by Reload
+
 	passwordMu sync.RWMutex // passwords are set after initialization
+
+	// configFiles and secretsFiles are re-read by Reload
+	configFiles  []string
+	secretsFiles []string
 }
 
 // GeneralConfigOpts holds configuration options for creating a coreconfig.GeneralConfig via New().
@@ -61,9 +70,14 @@ type GeneralConfigOpts struct {
 	OverrideFn func(*Config, *Secrets)
 
 	SkipEnv bool
+
+	configFiles  []string
+	secretsFiles []string
 }
 
 func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) error {
+	o.configFiles, o.secretsFiles = configFiles, secretsFiles
+
 	configs := []string{}
 	for _, fileName := range configFiles {
 		b, err := os.ReadFile(fileName)
@@ -164,10 +178,17 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 		c:             &o.Config,
 		secrets:       &o.Secrets,
 		warning:       warning,
+		configFiles:   o.configFiles,
+		secretsFiles:  o.secretsFiles,
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
 	}
+	cfg.logLevelOverrides = logger.NewLevelOverrides()
+	for name, lvl := range o.Config.Log.Overrides {
+		// invalid names are reported by Validate
+		_, _ = cfg.logLevelOverrides.Set(name, zapcore.Level(lvl), 0)
+	}
 
 	return cfg, nil
 }
@@ -357,6 +378,8 @@ func (g *generalConfig) TronEnabled() bool {
 }
 
 func (g *generalConfig) WebServer() config.WebServer {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
 	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir}
 }
 
@@ -416,6 +439,18 @@ func (g *generalConfig) Workflows() config.Workflows {
 	return &workflowsConfig{c: g.c.Workflows}
 }
 
+func (g *generalConfig) GasSpend() config.GasSpend {
+	return &gasSpendConfig{c: g.c.GasSpend}
+}
+
+func (g *generalConfig) KeyTopUp() config.KeyTopUp {
+	return &keyTopUpConfig{c: g.c.KeyTopUp}
+}
+
+func (g *generalConfig) OCRKeySelection() config.OCRKeySelection {
+	return &ocrKeySelectionConfig{c: g.c.OCRKeySelection}
+}
+
 func (g *generalConfig) Database() coreconfig.Database {
 	return &databaseConfig{c: g.c.Database, s: g.secrets.Secrets.Database, logSQL: g.logSQL}
 }
@@ -437,15 +472,15 @@ func (g *generalConfig) InsecurePPROFHeap() bool {
 }
 
 func (g *generalConfig) JobPipelineReaperInterval() time.Duration {
-	return g.c.JobPipeline.ReaperInterval.Duration()
+	return g.jobPipeline().ReaperInterval.Duration()
 }
 
 func (g *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
-	return uint64(*g.c.JobPipeline.ResultWriteQueueDepth)
+	return uint64(*g.jobPipeline().ResultWriteQueueDepth)
 }
 
 func (g *generalConfig) JobPipeline() coreconfig.JobPipeline {
-	return &jobPipelineConfig{c: g.c.JobPipeline}
+	return &jobPipelineConfig{c: g.jobPipeline}
 }
 
 func (g *generalConfig) Keeper() config.Keeper {
@@ -453,6 +488,8 @@ func (g *generalConfig) Keeper() config.Keeper {
 }
 
 func (g *generalConfig) Log() config.Log {
+	g.logMu.RLock()
+	defer g.logMu.RUnlock()
 	return &logConfig{c: g.c.Log, rootDir: g.RootDir, level: g.logLevel, defaultLevel: g.logLevelDefault}
 }
 
@@ -494,6 +531,8 @@ func (g *generalConfig) RootDir() string {
 }
 
 func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
 	return &telemetryIngressConfig{
 		c: g.c.TelemetryIngress,
 	}
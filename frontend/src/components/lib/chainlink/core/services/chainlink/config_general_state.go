@@ -5,6 +5,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
@@ -32,6 +33,10 @@ func (g *generalConfig) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+func (g *generalConfig) LogLevelOverrides() *logger.LevelOverrides {
+	return g.logLevelOverrides
+}
+
 func (g *generalConfig) logSQL() (sql bool) {
 	g.logMu.RLock()
 	sql = *g.c.Database.LogQueries
@@ -0,0 +1,80 @@
+package chainlink
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+const (
+	defaultKeyTopUpSpendWindow  = 24 * time.Hour
+	defaultKeyTopUpCooldown     = time.Hour
+	defaultKeyTopUpPollInterval = time.Minute
+)
+
+var _ config.KeyTopUp = (*keyTopUpConfig)(nil)
+
+type keyTopUpConfig struct {
+	c toml.KeyTopUp
+}
+
+type keyTopUpChainConfig struct {
+	c toml.KeyTopUpChain
+}
+
+func (k *keyTopUpConfig) Chains() []config.KeyTopUpChain {
+	var chains []config.KeyTopUpChain
+	for _, c := range k.c.Chains {
+		chains = append(chains, &keyTopUpChainConfig{
+			c: c,
+		})
+	}
+	return chains
+}
+
+func (k *keyTopUpChainConfig) ChainID() *big.Int {
+	return k.c.ChainID.ToInt()
+}
+
+func (k *keyTopUpChainConfig) TreasuryKey() common.Address {
+	return k.c.TreasuryKey.Address()
+}
+
+func (k *keyTopUpChainConfig) Threshold() *assets.Wei {
+	return k.c.Threshold
+}
+
+func (k *keyTopUpChainConfig) Target() *assets.Wei {
+	return k.c.Target
+}
+
+func (k *keyTopUpChainConfig) MaxSpend() *assets.Wei {
+	return k.c.MaxSpend
+}
+
+func (k *keyTopUpChainConfig) SpendWindow() time.Duration {
+	if k.c.SpendWindow == nil {
+		return defaultKeyTopUpSpendWindow
+	}
+	return k.c.SpendWindow.Duration()
+}
+
+func (k *keyTopUpChainConfig) Cooldown() time.Duration {
+	if k.c.Cooldown == nil {
+		return defaultKeyTopUpCooldown
+	}
+	return k.c.Cooldown.Duration()
+}
+
+func (k *keyTopUpChainConfig) PollInterval() time.Duration {
+	if k.c.PollInterval == nil {
+		return defaultKeyTopUpPollInterval
+	}
+	return k.c.PollInterval.Duration()
+}
@@ -61,3 +61,11 @@ func (l *logConfig) DefaultLevel() zapcore.Level {
 func (l *logConfig) Level() zapcore.Level {
 	return l.level()
 }
+
+func (l *logConfig) Overrides() map[string]zapcore.Level {
+	overrides := make(map[string]zapcore.Level, len(l.c.Overrides))
+	for name, lvl := range l.c.Overrides {
+		overrides[name] = zapcore.Level(lvl)
+	}
+	return overrides
+}
@@ -0,0 +1,44 @@
+package chainlink
+
+import (
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.OCRKeySelection = (*ocrKeySelectionConfig)(nil)
+
+type ocrKeySelectionConfig struct {
+	c toml.OCRKeySelection
+}
+
+type ocrKeySelectionChainConfig struct {
+	c toml.OCRKeySelectionChain
+}
+
+func (k *ocrKeySelectionConfig) Chains() []config.OCRKeySelectionChain {
+	var chains []config.OCRKeySelectionChain
+	for _, c := range k.c.Chains {
+		chains = append(chains, &ocrKeySelectionChainConfig{
+			c: c,
+		})
+	}
+	return chains
+}
+
+func (k *ocrKeySelectionChainConfig) ChainID() *big.Int {
+	return k.c.ChainID.ToInt()
+}
+
+func (k *ocrKeySelectionChainConfig) Strategy() string {
+	if k.c.Strategy == nil {
+		return string(txmgr.KeySelectionRoundRobin)
+	}
+	return *k.c.Strategy
+}
+
+func (k *ocrKeySelectionChainConfig) ExcludeStuck() bool {
+	return k.c.ExcludeStuck != nil && *k.c.ExcludeStuck
+}
@@ -0,0 +1,310 @@
+package chainlink
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	gotoml "github.com/pelletier/go-toml/v2"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+// ConfigReload reports the changed settings found by a config reload.
+type ConfigReload struct {
+	// Applied are the settings that took effect on the running node.
+	Applied []string
+	// RequiresRestart are the settings that were not applied, and only take
+	// effect once the node restarts.
+	RequiresRestart []string
+	// Notes explain why some of the settings requiring a restart could not
+	// be applied.
+	Notes []string
+}
+
+// Changed returns true if the reload found any changed setting.
+func (r ConfigReload) Changed() bool {
+	return len(r.Applied) > 0 || len(r.RequiresRestart) > 0
+}
+
+// ReloadableConfig is a GeneralConfig that can be reloaded from the files it
+// was loaded from.
+type ReloadableConfig interface {
+	GeneralConfig
+	// Reload re-reads, parses and validates the config and secrets files, and
+	// applies the changed settings that can take effect on a running node.
+	// Nothing is applied if the files are invalid.
+	Reload() (ConfigReload, error)
+}
+
+var _ ReloadableConfig = (*generalConfig)(nil)
+
+// liveConfigSections are the settings applied by a reload. They are read from
+// the config every time they are used, so that the running node picks up
+// their new values, e.g. Database.LogQueries is read by the SQL monitor hook on
+// every query. JobPipeline settings that are only read when the pipeline
+// runner or a job starts, such as ReaperInterval, require a restart.
+// TelemetryIngress.Endpoints is only applied live when endpoints are added,
+// see applyLive. Chain settings, including their RPC nodes, require a restart
+// as the multinode client of a chain is created with a fixed set of nodes
+// when the chain starts, see restartNote.
+var liveConfigSections = []string{
+	"Database.LogQueries",
+	"JobPipeline.ExternalInitiatorsEnabled",
+	"JobPipeline.HTTPRequest.DefaultTimeout",
+	"JobPipeline.HTTPRequest.MaxSize",
+	"JobPipeline.MaxRunDuration",
+	"JobPipeline.ReaperThreshold",
+	"JobPipeline.VerboseLogging",
+	"Log.Level",
+	"Log.Overrides",
+	"TelemetryIngress.Endpoints",
+	"WebServer.RateLimit",
+}
+
+func (g *generalConfig) Reload() (ConfigReload, error) {
+	if len(g.configFiles) == 0 && len(g.secretsFiles) == 0 {
+		return ConfigReload{}, errors.New("config was not loaded from files")
+	}
+
+	var opts GeneralConfigOpts
+	if err := opts.Setup(g.configFiles, g.secretsFiles); err != nil {
+		return ConfigReload{}, err
+	}
+	cfg, err := opts.New()
+	if err != nil {
+		return ConfigReload{}, err
+	}
+	next := cfg.(*generalConfig)
+
+	// Neither the app ID nor the passwords are read from the files
+	next.c.AppID = g.AppID()
+	g.passwordMu.RLock()
+	next.secrets.Password = g.secrets.Password
+	g.passwordMu.RUnlock()
+
+	if err = next.Validate(); err != nil {
+		return ConfigReload{}, fmt.Errorf("invalid config: %w", err)
+	}
+	return g.apply(next)
+}
+
+// apply applies the live settings of next that differ from g, and reports the
+// other changed settings as requiring a restart.
+func (g *generalConfig) apply(next *generalConfig) (reload ConfigReload, err error) {
+	g.logMu.Lock()
+	defer g.logMu.Unlock()
+	g.reloadMu.Lock()
+	defer g.reloadMu.Unlock()
+
+	current, err := g.c.TOMLString()
+	if err != nil {
+		return reload, err
+	}
+	changed, err := changedConfigPaths(current, next.effectiveTOML)
+	if err != nil {
+		return reload, err
+	}
+	for _, path := range changed {
+		if g.applyLive(next, path) {
+			reload.Applied = append(reload.Applied, path)
+		} else {
+			reload.RequiresRestart = append(reload.RequiresRestart, path)
+			if note := restartNote(path); note != "" && !slices.Contains(reload.Notes, note) {
+				reload.Notes = append(reload.Notes, note)
+			}
+		}
+	}
+	reload.RequiresRestart = append(reload.RequiresRestart, changedSecrets(g.secrets, next.secrets)...)
+	return reload, nil
+}
+
+// applyLive sets the setting at path from next, returning false if the
+// setting cannot change on a running node. Callers must hold logMu and
+// reloadMu.
+func (g *generalConfig) applyLive(next *generalConfig, path string) bool {
+	i := slices.IndexFunc(liveConfigSections, func(section string) bool {
+		return path == section || strings.HasPrefix(path, section+".")
+	})
+	if i == -1 {
+		return false
+	}
+	switch liveConfigSections[i] {
+	case "Database.LogQueries":
+		g.c.Database.LogQueries = next.c.Database.LogQueries
+	case "JobPipeline.ExternalInitiatorsEnabled":
+		g.c.JobPipeline.ExternalInitiatorsEnabled = next.c.JobPipeline.ExternalInitiatorsEnabled
+	case "JobPipeline.HTTPRequest.DefaultTimeout":
+		g.c.JobPipeline.HTTPRequest.DefaultTimeout = next.c.JobPipeline.HTTPRequest.DefaultTimeout
+	case "JobPipeline.HTTPRequest.MaxSize":
+		g.c.JobPipeline.HTTPRequest.MaxSize = next.c.JobPipeline.HTTPRequest.MaxSize
+	case "JobPipeline.MaxRunDuration":
+		g.c.JobPipeline.MaxRunDuration = next.c.JobPipeline.MaxRunDuration
+	case "JobPipeline.ReaperThreshold":
+		g.c.JobPipeline.ReaperThreshold = next.c.JobPipeline.ReaperThreshold
+	case "JobPipeline.VerboseLogging":
+		g.c.JobPipeline.VerboseLogging = next.c.JobPipeline.VerboseLogging
+	case "Log.Level":
+		g.c.Log.Level = next.c.Log.Level
+	case "Log.Overrides":
+		for name := range g.c.Log.Overrides {
+			if _, ok := next.c.Log.Overrides[name]; !ok {
+				g.logLevelOverrides.Remove(name)
+			}
+		}
+		for name, lvl := range next.c.Log.Overrides {
+			// names were validated by Reload
+			_, _ = g.logLevelOverrides.Set(name, zapcore.Level(lvl), 0)
+		}
+		g.c.Log.Overrides = next.c.Log.Overrides
+	case "TelemetryIngress.Endpoints":
+		// Monitoring endpoints hold on to the client of their telemetry
+		// endpoint, so existing endpoints cannot be changed or removed.
+		if !containsEndpoints(next.c.TelemetryIngress.Endpoints, g.c.TelemetryIngress.Endpoints) {
+			return false
+		}
+		g.c.TelemetryIngress.Endpoints = next.c.TelemetryIngress.Endpoints
+	case "WebServer.RateLimit":
+		g.c.WebServer.RateLimit = next.c.WebServer.RateLimit
+	default:
+		return false
+	}
+	return true
+}
+
+// chainConfigSections are the top level sections of chain configs, keyed by
+// chain ID in the changed paths.
+var chainConfigSections = []string{"Aptos", "Cosmos", "EVM", "Solana", "Starknet", "Tron"}
+
+// jobPipelineRestartNotes explain the JobPipeline settings that are only read
+// on startup.
+var jobPipelineRestartNotes = map[string]string{
+	"JobPipeline.MaxSuccessfulRuns":     "JobPipeline.MaxSuccessfulRuns: read when the pipeline ORM and the result savers of jobs are created",
+	"JobPipeline.ReaperInterval":        "JobPipeline.ReaperInterval: read when the pipeline runner starts its reaper",
+	"JobPipeline.ResultWriteQueueDepth": "JobPipeline.ResultWriteQueueDepth: read when the result savers of jobs are created",
+}
+
+// restartNote returns a note explaining why the setting at path cannot be
+// applied live, or an empty string if there is nothing to add.
+func restartNote(path string) string {
+	if note, ok := jobPipelineRestartNotes[path]; ok {
+		return note
+	}
+	parts := strings.SplitN(path, ".", 4)
+	if !slices.Contains(chainConfigSections, parts[0]) {
+		return ""
+	}
+	if len(parts) == 2 {
+		return fmt.Sprintf("%s: chains cannot be added or removed on a running node", path)
+	}
+	if len(parts) >= 3 && parts[2] == "Nodes" {
+		return fmt.Sprintf("%s.%s.Nodes: the RPC nodes of a running chain cannot be added, removed or changed, as its multinode client is created with a fixed set of nodes", parts[0], parts[1])
+	}
+	return ""
+}
+
+func (g *generalConfig) jobPipeline() toml.JobPipeline {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
+	return g.c.JobPipeline
+}
+
+func containsEndpoints(all, endpoints []toml.TelemetryIngressEndpoint) bool {
+	for _, e := range endpoints {
+		if !slices.ContainsFunc(all, func(a toml.TelemetryIngressEndpoint) bool {
+			return reflect.DeepEqual(a, e)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// changedConfigPaths returns the sorted dotted paths of the settings that
+// differ between two TOML configs. Chains are keyed by chain ID, e.g.
+// EVM.1.Nodes, and added or removed tables are reported as a whole.
+func changedConfigPaths(before, after string) ([]string, error) {
+	var b, a map[string]any
+	if err := gotoml.Unmarshal([]byte(before), &b); err != nil {
+		return nil, fmt.Errorf("failed to decode current config: %w", err)
+	}
+	if err := gotoml.Unmarshal([]byte(after), &a); err != nil {
+		return nil, fmt.Errorf("failed to decode reloaded config: %w", err)
+	}
+	var changed []string
+	diffConfig("", b, a, &changed)
+	return changed, nil
+}
+
+func diffConfig(path string, before, after any, changed *[]string) {
+	bm, bok := configTable(before)
+	am, aok := configTable(after)
+	if !bok || !aok {
+		if !reflect.DeepEqual(before, after) {
+			*changed = append(*changed, path)
+		}
+		return
+	}
+
+	keys := make([]string, 0, len(bm)+len(am))
+	for k := range bm {
+		keys = append(keys, k)
+	}
+	for k := range am {
+		if _, ok := bm[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+		diffConfig(p, bm[k], am[k], changed)
+	}
+}
+
+// configTable returns v as a table. Arrays of chain tables are returned as a
+// table keyed by chain ID.
+func configTable(v any) (map[string]any, bool) {
+	switch t := v.(type) {
+	case map[string]any:
+		return t, true
+	case []any:
+		if len(t) == 0 {
+			return nil, false
+		}
+		chains := make(map[string]any, len(t))
+		for _, e := range t {
+			m, ok := e.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			id, ok := m["ChainID"]
+			if !ok {
+				return nil, false
+			}
+			chains[fmt.Sprint(id)] = m
+		}
+		return chains, true
+	default:
+		return nil, false
+	}
+}
+
+// changedSecrets returns the secrets sections that differ between a and b.
+// Secrets always require a restart.
+func changedSecrets(a, b *Secrets) (changed []string) {
+	av, bv := reflect.ValueOf(a.Secrets), reflect.ValueOf(b.Secrets)
+	for i := 0; i < av.NumField(); i++ {
+		if !reflect.DeepEqual(av.Field(i).Interface(), bv.Field(i).Interface()) {
+			changed = append(changed, "Secrets."+av.Type().Field(i).Name)
+		}
+	}
+	return
+}
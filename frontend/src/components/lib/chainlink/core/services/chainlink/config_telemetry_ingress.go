@@ -6,6 +6,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.TelemetryIngress = (*telemetryIngressConfig)(nil)
@@ -18,6 +19,14 @@ type telemetryIngressEndpointConfig struct {
 	c toml.TelemetryIngressEndpoint
 }
 
+type telemetryIngressFileSinkConfig struct {
+	c toml.TelemetryIngressFileSink
+}
+
+type telemetryIngressOTLPSinkConfig struct {
+	c toml.TelemetryIngressOTLPSink
+}
+
 func (t *telemetryIngressConfig) Logging() bool {
 	return *t.c.Logging
 }
@@ -56,6 +65,26 @@ func (t *telemetryIngressConfig) Endpoints() []config.TelemetryIngressEndpoint {
 	return endpoints
 }
 
+func (t *telemetryIngressConfig) FileSinks() []config.TelemetryIngressFileSink {
+	var sinks []config.TelemetryIngressFileSink
+	for _, s := range t.c.FileSinks {
+		sinks = append(sinks, &telemetryIngressFileSinkConfig{
+			c: s,
+		})
+	}
+	return sinks
+}
+
+func (t *telemetryIngressConfig) OTLPSinks() []config.TelemetryIngressOTLPSink {
+	var sinks []config.TelemetryIngressOTLPSink
+	for _, s := range t.c.OTLPSinks {
+		sinks = append(sinks, &telemetryIngressOTLPSinkConfig{
+			c: s,
+		})
+	}
+	return sinks
+}
+
 func (t *telemetryIngressEndpointConfig) Network() string {
 	return *t.c.Network
 }
@@ -74,3 +103,52 @@ func (t *telemetryIngressEndpointConfig) URL() *url.URL {
 func (t *telemetryIngressEndpointConfig) ServerPubKey() string {
 	return *t.c.ServerPubKey
 }
+
+func (t *telemetryIngressFileSinkConfig) Path() string {
+	return *t.c.Path
+}
+
+func (t *telemetryIngressFileSinkConfig) Format() string {
+	if t.c.Format == nil {
+		return toml.TelemetrySinkFormatJSONL
+	}
+	return *t.c.Format
+}
+
+func (t *telemetryIngressFileSinkConfig) MaxSize() utils.FileSize {
+	if t.c.MaxSize == nil {
+		return 0
+	}
+	return *t.c.MaxSize
+}
+
+func (t *telemetryIngressFileSinkConfig) MaxBackups() int64 {
+	if t.c.MaxBackups == nil {
+		return 0
+	}
+	return *t.c.MaxBackups
+}
+
+func (t *telemetryIngressFileSinkConfig) Networks() []string {
+	return t.c.Networks
+}
+
+func (t *telemetryIngressFileSinkConfig) TelemetryTypes() []string {
+	return t.c.TelemetryTypes
+}
+
+func (t *telemetryIngressOTLPSinkConfig) Endpoint() string {
+	return *t.c.Endpoint
+}
+
+func (t *telemetryIngressOTLPSinkConfig) InsecureConnection() bool {
+	return t.c.InsecureConnection != nil && *t.c.InsecureConnection
+}
+
+func (t *telemetryIngressOTLPSinkConfig) Networks() []string {
+	return t.c.Networks
+}
+
+func (t *telemetryIngressOTLPSinkConfig) TelemetryTypes() []string {
+	return t.c.TelemetryTypes
+}
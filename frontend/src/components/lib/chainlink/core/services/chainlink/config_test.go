@@ -324,6 +324,20 @@ func TestConfig_Marshal(t *testing.T) {
 			ServerPubKey: ptr("test-pub-key"),
 			URL:          mustURL("prom.test")},
 		},
+		FileSinks: []toml.TelemetryIngressFileSink{{
+			Path:           ptr("telemetry/ocr.pb"),
+			Format:         ptr("protobuf"),
+			MaxSize:        ptr[utils.FileSize](10 * utils.MB),
+			MaxBackups:     ptr[int64](3),
+			Networks:       []string{"EVM"},
+			TelemetryTypes: []string{"ocr", "ocr2-median"},
+		}},
+		OTLPSinks: []toml.TelemetryIngressOTLPSink{{
+			Endpoint:           ptr("localhost:4317"),
+			InsecureConnection: ptr(true),
+			Networks:           []string{"EVM"},
+			TelemetryTypes:     []string{"head-report"},
+		}},
 	}
 
 	full.Log = toml.Log{
@@ -518,6 +532,35 @@ func TestConfig_Marshal(t *testing.T) {
 			PerOwner: ptr(int32(200)),
 		},
 	}
+	full.GasSpend = toml.GasSpend{
+		Budgets: []toml.GasSpendBudget{{
+			ChainID: ubig.NewI(1),
+			JobID:   ptr[int32](42),
+			Key:     ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+			Window:  commoncfg.MustNewDuration(24 * time.Hour),
+			Limit:   assets.GWei(2_000_000_000),
+			Action:  ptr("queue"),
+		}},
+	}
+	full.KeyTopUp = toml.KeyTopUp{
+		Chains: []toml.KeyTopUpChain{{
+			ChainID:      ubig.NewI(1),
+			TreasuryKey:  ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+			Threshold:    assets.GWei(100_000_000),
+			Target:       assets.GWei(500_000_000),
+			MaxSpend:     assets.GWei(5_000_000_000),
+			SpendWindow:  commoncfg.MustNewDuration(24 * time.Hour),
+			Cooldown:     commoncfg.MustNewDuration(time.Hour),
+			PollInterval: commoncfg.MustNewDuration(time.Minute),
+		}},
+	}
+	full.OCRKeySelection = toml.OCRKeySelection{
+		Chains: []toml.OCRKeySelectionChain{{
+			ChainID:      ubig.NewI(1),
+			Strategy:     ptr("least-pending"),
+			ExcludeStuck: ptr(true),
+		}},
+	}
 	full.Keeper = toml.Keeper{
 		DefaultTransactionQueueDepth: ptr[uint32](17),
 		GasPriceBufferPercent:        ptr[uint16](12),
@@ -901,6 +944,20 @@ Network = 'EVM'
 ChainID = '1'
 URL = 'prom.test'
 ServerPubKey = 'test-pub-key'
+
+[[TelemetryIngress.FileSinks]]
+Path = 'telemetry/ocr.pb'
+Format = 'protobuf'
+MaxSize = '10.00mb'
+MaxBackups = 3
+Networks = ['EVM']
+TelemetryTypes = ['ocr', 'ocr2-median']
+
+[[TelemetryIngress.OTLPSinks]]
+Endpoint = 'localhost:4317'
+InsecureConnection = true
+Networks = ['EVM']
+TelemetryTypes = ['head-report']
 `},
 
 		{"Log", Config{Core: toml.Core{Log: full.Log}}, `[Log]
@@ -1063,6 +1120,29 @@ Debug = true
 DSN = 'sentry-dsn'
 Environment = 'dev'
 Release = 'v1.2.3'
+`},
+		{"GasSpend", Config{Core: toml.Core{GasSpend: full.GasSpend}}, `[[GasSpend.Budgets]]
+ChainID = '1'
+JobID = 42
+Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+Window = '24h0m0s'
+Limit = '2 ether'
+Action = 'queue'
+`},
+		{"KeyTopUp", Config{Core: toml.Core{KeyTopUp: full.KeyTopUp}}, `[[KeyTopUp.Chains]]
+ChainID = '1'
+TreasuryKey = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+Threshold = '100 milli'
+Target = '500 milli'
+MaxSpend = '5 ether'
+SpendWindow = '24h0m0s'
+Cooldown = '1h0m0s'
+PollInterval = '1m0s'
+`},
+		{"OCRKeySelection", Config{Core: toml.Core{OCRKeySelection: full.OCRKeySelection}}, `[[OCRKeySelection.Chains]]
+ChainID = '1'
+Strategy = 'least-pending'
+ExcludeStuck = true
 `},
 		{"EVM", Config{EVM: full.EVM}, `[[EVM]]
 ChainID = '1'
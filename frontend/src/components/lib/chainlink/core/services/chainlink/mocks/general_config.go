@@ -6,6 +6,8 @@ import (
 	config "github.com/smartcontractkit/chainlink/v2/core/config"
 	chainlink "github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 
+	logger "github.com/smartcontractkit/chainlink/v2/core/logger"
+
 	mock "github.com/stretchr/testify/mock"
 
 	solanaconfig "github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
@@ -786,6 +788,53 @@ func (_c *GeneralConfig_ImportedP2PKey_Call) RunAndReturn(run func() config.Impo
 	return _c
 }
 
+// GasSpend provides a mock function with no fields
+func (_m *GeneralConfig) GasSpend() config.GasSpend {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GasSpend")
+	}
+
+	var r0 config.GasSpend
+	if rf, ok := ret.Get(0).(func() config.GasSpend); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.GasSpend)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_GasSpend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GasSpend'
+type GeneralConfig_GasSpend_Call struct {
+	*mock.Call
+}
+
+// GasSpend is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) GasSpend() *GeneralConfig_GasSpend_Call {
+	return &GeneralConfig_GasSpend_Call{Call: _e.mock.On("GasSpend")}
+}
+
+func (_c *GeneralConfig_GasSpend_Call) Run(run func()) *GeneralConfig_GasSpend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_GasSpend_Call) Return(_a0 config.GasSpend) *GeneralConfig_GasSpend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_GasSpend_Call) RunAndReturn(run func() config.GasSpend) *GeneralConfig_GasSpend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Insecure provides a mock function with no fields
 func (_m *GeneralConfig) Insecure() config.Insecure {
 	ret := _m.Called()
@@ -1017,6 +1066,100 @@ func (_c *GeneralConfig_Keeper_Call) RunAndReturn(run func() config.Keeper) *Gen
 	return _c
 }
 
+// OCRKeySelection provides a mock function with no fields
+func (_m *GeneralConfig) OCRKeySelection() config.OCRKeySelection {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OCRKeySelection")
+	}
+
+	var r0 config.OCRKeySelection
+	if rf, ok := ret.Get(0).(func() config.OCRKeySelection); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.OCRKeySelection)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_OCRKeySelection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OCRKeySelection'
+type GeneralConfig_OCRKeySelection_Call struct {
+	*mock.Call
+}
+
+// OCRKeySelection is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) OCRKeySelection() *GeneralConfig_OCRKeySelection_Call {
+	return &GeneralConfig_OCRKeySelection_Call{Call: _e.mock.On("OCRKeySelection")}
+}
+
+func (_c *GeneralConfig_OCRKeySelection_Call) Run(run func()) *GeneralConfig_OCRKeySelection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_OCRKeySelection_Call) Return(_a0 config.OCRKeySelection) *GeneralConfig_OCRKeySelection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_OCRKeySelection_Call) RunAndReturn(run func() config.OCRKeySelection) *GeneralConfig_OCRKeySelection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KeyTopUp provides a mock function with no fields
+func (_m *GeneralConfig) KeyTopUp() config.KeyTopUp {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyTopUp")
+	}
+
+	var r0 config.KeyTopUp
+	if rf, ok := ret.Get(0).(func() config.KeyTopUp); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.KeyTopUp)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_KeyTopUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyTopUp'
+type GeneralConfig_KeyTopUp_Call struct {
+	*mock.Call
+}
+
+// KeyTopUp is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) KeyTopUp() *GeneralConfig_KeyTopUp_Call {
+	return &GeneralConfig_KeyTopUp_Call{Call: _e.mock.On("KeyTopUp")}
+}
+
+func (_c *GeneralConfig_KeyTopUp_Call) Run(run func()) *GeneralConfig_KeyTopUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_KeyTopUp_Call) Return(_a0 config.KeyTopUp) *GeneralConfig_KeyTopUp_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_KeyTopUp_Call) RunAndReturn(run func() config.KeyTopUp) *GeneralConfig_KeyTopUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Log provides a mock function with no fields
 func (_m *GeneralConfig) Log() config.Log {
 	ret := _m.Called()
@@ -1098,6 +1241,53 @@ func (_c *GeneralConfig_LogConfiguration_Call) RunAndReturn(run func(config.Logf
 	return _c
 }
 
+// LogLevelOverrides provides a mock function with no fields
+func (_m *GeneralConfig) LogLevelOverrides() *logger.LevelOverrides {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogLevelOverrides")
+	}
+
+	var r0 *logger.LevelOverrides
+	if rf, ok := ret.Get(0).(func() *logger.LevelOverrides); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logger.LevelOverrides)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_LogLevelOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogLevelOverrides'
+type GeneralConfig_LogLevelOverrides_Call struct {
+	*mock.Call
+}
+
+// LogLevelOverrides is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) LogLevelOverrides() *GeneralConfig_LogLevelOverrides_Call {
+	return &GeneralConfig_LogLevelOverrides_Call{Call: _e.mock.On("LogLevelOverrides")}
+}
+
+func (_c *GeneralConfig_LogLevelOverrides_Call) Run(run func()) *GeneralConfig_LogLevelOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_LogLevelOverrides_Call) Return(_a0 *logger.LevelOverrides) *GeneralConfig_LogLevelOverrides_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_LogLevelOverrides_Call) RunAndReturn(run func() *logger.LevelOverrides) *GeneralConfig_LogLevelOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Mercury provides a mock function with no fields
 func (_m *GeneralConfig) Mercury() config.Mercury {
 	ret := _m.Called()
@@ -6,6 +6,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 type GeneralConfig interface {
@@ -18,6 +19,9 @@ type GeneralConfig interface {
 	TronConfigs() RawConfigs
 	// ConfigTOML returns both the user provided and effective configuration as TOML.
 	ConfigTOML() (user, effective string)
+	// LogLevelOverrides returns the log level overrides of named loggers,
+	// initialized from Log.Overrides and changeable at runtime.
+	LogLevelOverrides() *logger.LevelOverrides
 	ImportedSecretConfig
 }
 
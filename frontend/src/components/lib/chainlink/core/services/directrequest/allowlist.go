@@ -0,0 +1,203 @@
+package directrequest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// allowlistResyncPeriod is how often the allowlist is reloaded regardless of
+// logs, so that a failed refresh does not leave it stale.
+const allowlistResyncPeriod = 10 * time.Minute
+
+// requesterAllowlistABI is the interface of the allowlist contracts of
+// Direct Request jobs, which the Functions TermsOfServiceAllowList implements.
+const requesterAllowlistABI = `[
+	{"type":"function","name":"getAllAllowedSenders","inputs":[],"outputs":[{"name":"","type":"address[]"}],"stateMutability":"view"},
+	{"type":"event","name":"AddedAccess","inputs":[{"name":"user","type":"address","indexed":false}],"anonymous":false},
+	{"type":"event","name":"BlockedAccess","inputs":[{"name":"user","type":"address","indexed":false}],"anonymous":false},
+	{"type":"event","name":"UnblockedAccess","inputs":[{"name":"user","type":"address","indexed":false}],"anonymous":false}
+]`
+
+var requesterAllowlistContractABI = evmtypes.MustGetABI(requesterAllowlistABI)
+
+// allowlistEvents are the events granting or revoking access to requesters
+var allowlistEvents = []string{"AddedAccess", "BlockedAccess", "UnblockedAccess"}
+
+// allowlistContract is an allowlist contract of requesters
+type allowlistContract interface {
+	Address() common.Address
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+	GetAllAllowedSenders(opts *bind.CallOpts) ([]common.Address, error)
+}
+
+// requesterAccessChanged is a log of an allowlist contract granting or
+// revoking access to a requester
+type requesterAccessChanged struct {
+	User common.Address
+	Raw  types.Log
+}
+
+func (l *requesterAccessChanged) Topic() common.Hash {
+	return l.Raw.Topics[0]
+}
+
+type requesterAllowlistContract struct {
+	address  common.Address
+	contract *bind.BoundContract
+}
+
+var _ allowlistContract = &requesterAllowlistContract{}
+
+func newRequesterAllowlistContract(address common.Address, backend bind.ContractBackend) *requesterAllowlistContract {
+	return &requesterAllowlistContract{
+		address:  address,
+		contract: bind.NewBoundContract(address, requesterAllowlistContractABI, backend, backend, backend),
+	}
+}
+
+func (c *requesterAllowlistContract) Address() common.Address {
+	return c.address
+}
+
+func (c *requesterAllowlistContract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.New("log has no topics")
+	}
+	event, err := requesterAllowlistContractABI.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "unknown allowlist event")
+	}
+	parsed := &requesterAccessChanged{Raw: log}
+	if err = c.contract.UnpackLog(parsed, event.Name, log); err != nil {
+		return nil, errors.Wrapf(err, "failed to unpack %s log", event.Name)
+	}
+	return parsed, nil
+}
+
+func (c *requesterAllowlistContract) GetAllAllowedSenders(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	if err := c.contract.Call(opts, &out, "getAllAllowedSenders"); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address), nil
+}
+
+var _ log.Listener = &requesterAllowlist{}
+
+// requesterAllowlist keeps the allowed senders of an on-chain allowlist
+// contract in memory. It is loaded when the job starts and reloaded whenever
+// the contract grants or revokes access.
+type requesterAllowlist struct {
+	logger                   logger.Logger
+	logBroadcaster           log.Broadcaster
+	contract                 allowlistContract
+	minIncomingConfirmations uint32
+	jobID                    int32
+	chRefresh                chan struct{}
+
+	mu      sync.RWMutex
+	allowed map[common.Address]struct{}
+}
+
+func newRequesterAllowlist(lggr logger.Logger, logBroadcaster log.Broadcaster, contract allowlistContract, minIncomingConfirmations uint32, jobID int32) *requesterAllowlist {
+	return &requesterAllowlist{
+		logger:                   lggr.Named("RequesterAllowlist").With("allowlist", contract.Address().String()),
+		logBroadcaster:           logBroadcaster,
+		contract:                 contract,
+		minIncomingConfirmations: minIncomingConfirmations,
+		jobID:                    jobID,
+		chRefresh:                make(chan struct{}, 1),
+		allowed:                  make(map[common.Address]struct{}),
+	}
+}
+
+// run subscribes to the access logs of the contract and refreshes the
+// allowlist until chStop is closed. The allowlist is expected to have been
+// loaded once already.
+func (a *requesterAllowlist) run(chStop services.StopChan) {
+	topics := make(map[common.Hash][][]log.Topic, len(allowlistEvents))
+	for _, name := range allowlistEvents {
+		topics[requesterAllowlistContractABI.Events[name].ID] = nil
+	}
+	unsubscribeLogs := a.logBroadcaster.Register(a, log.ListenerOpts{
+		Contract:                 a.contract.Address(),
+		ParseLog:                 a.contract.ParseLog,
+		LogsWithTopics:           topics,
+		MinIncomingConfirmations: a.minIncomingConfirmations,
+	})
+	defer unsubscribeLogs()
+
+	ctx, cancel := chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(allowlistResyncPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-chStop:
+			return
+		case <-a.chRefresh:
+		case <-ticker.C:
+		}
+		if err := a.refresh(ctx); err != nil && ctx.Err() == nil {
+			a.logger.Errorw("Failed to refresh requester allowlist", "err", err)
+		}
+	}
+}
+
+// refresh replaces the allowed requesters with the allowed senders of the
+// contract
+func (a *requesterAllowlist) refresh(ctx context.Context) error {
+	senders, err := a.contract.GetAllAllowedSenders(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return errors.Wrap(err, "error calling GetAllAllowedSenders")
+	}
+	allowed := make(map[common.Address]struct{}, len(senders))
+	for _, sender := range senders {
+		allowed[sender] = struct{}{}
+	}
+
+	a.mu.Lock()
+	a.allowed = allowed
+	a.mu.Unlock()
+
+	a.logger.Debugw("Refreshed requester allowlist", "allowedRequesters", len(allowed))
+	return nil
+}
+
+func (a *requesterAllowlist) allow(requester common.Address) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	_, ok := a.allowed[requester]
+	return ok
+}
+
+// HandleLog complies with log.Listener
+func (a *requesterAllowlist) HandleLog(ctx context.Context, lb log.Broadcast) {
+	select {
+	case a.chRefresh <- struct{}{}:
+	default:
+	}
+	if err := a.logBroadcaster.MarkConsumed(ctx, nil, lb); err != nil {
+		a.logger.Errorw("Unable to mark log consumed", "err", err, "log", lb.String())
+	}
+}
+
+// JobID complies with log.Listener
+func (a *requesterAllowlist) JobID() int32 {
+	return a.jobID
+}
@@ -0,0 +1,96 @@
+package directrequest
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+type fakeAllowlistContract struct {
+	senders []common.Address
+	err     error
+}
+
+func (c *fakeAllowlistContract) Address() common.Address { return common.Address{} }
+
+func (c *fakeAllowlistContract) ParseLog(types.Log) (generated.AbigenLog, error) {
+	return nil, errors.New("unimplemented")
+}
+
+func (c *fakeAllowlistContract) GetAllAllowedSenders(*bind.CallOpts) ([]common.Address, error) {
+	return c.senders, c.err
+}
+
+func TestRequesterAllowlist_Refresh(t *testing.T) {
+	t.Parallel()
+
+	a, b := testutils.NewAddress(), testutils.NewAddress()
+	contract := &fakeAllowlistContract{senders: []common.Address{a}}
+	allowlist := newRequesterAllowlist(logger.TestLogger(t), nil, contract, 1, 1)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, allowlist.refresh(ctx))
+	assert.True(t, allowlist.allow(a))
+	assert.False(t, allowlist.allow(b))
+
+	contract.senders = []common.Address{b}
+	require.NoError(t, allowlist.refresh(ctx))
+	assert.False(t, allowlist.allow(a), "access was revoked")
+	assert.True(t, allowlist.allow(b))
+
+	contract.err = errors.New("rpc down")
+	require.Error(t, allowlist.refresh(ctx))
+	assert.True(t, allowlist.allow(b), "keeps the last loaded allowlist")
+}
+
+func TestListener_AllowRequester(t *testing.T) {
+	t.Parallel()
+
+	static, onchain, other := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+
+	l := &listener{}
+	assert.True(t, l.allowRequester(other), "no restriction")
+
+	l.requesters = models.AddressCollection{static}
+	assert.True(t, l.allowRequester(static))
+	assert.False(t, l.allowRequester(other))
+
+	l.allowlist = newRequesterAllowlist(logger.TestLogger(t), nil, &fakeAllowlistContract{senders: []common.Address{onchain}}, 1, 1)
+	require.NoError(t, l.allowlist.refresh(testutils.Context(t)))
+	assert.True(t, l.allowRequester(static), "static requesters stay allowed")
+	assert.True(t, l.allowRequester(onchain))
+	assert.False(t, l.allowRequester(other))
+
+	l.requesters = nil
+	assert.False(t, l.allowRequester(other), "the allowlist alone restricts requesters")
+}
+
+func TestRequesterAllowlistContract_ParseLog(t *testing.T) {
+	t.Parallel()
+
+	contract := newRequesterAllowlistContract(testutils.NewAddress(), nil)
+	user := testutils.NewAddress()
+	for _, name := range allowlistEvents {
+		event := requesterAllowlistContractABI.Events[name]
+		data, err := event.Inputs.Pack(user)
+		require.NoError(t, err)
+
+		parsed, err := contract.ParseLog(types.Log{Topics: []common.Hash{event.ID}, Data: data})
+		require.NoError(t, err)
+		assert.Equal(t, event.ID, parsed.Topic())
+		assert.Equal(t, user, parsed.(*requesterAccessChanged).User)
+	}
+
+	_, err := contract.ParseLog(types.Log{Topics: []common.Hash{{1}}})
+	require.Error(t, err)
+}
@@ -3,9 +3,11 @@ package directrequest
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -28,6 +30,7 @@ import (
 type (
 	Delegate struct {
 		logger         logger.Logger
+		ds             sqlutil.DataSource
 		pipelineRunner pipeline.Runner
 		pipelineORM    pipeline.ORM
 		chHeads        chan *evmtypes.Head
@@ -45,6 +48,7 @@ var _ job.Delegate = (*Delegate)(nil)
 
 func NewDelegate(
 	logger logger.Logger,
+	ds sqlutil.DataSource,
 	pipelineRunner pipeline.Runner,
 	pipelineORM pipeline.ORM,
 	legacyChains legacyevm.LegacyChainContainer,
@@ -52,6 +56,7 @@ func NewDelegate(
 ) *Delegate {
 	return &Delegate{
 		logger:         logger.Named("DirectRequest"),
+		ds:             ds,
 		pipelineRunner: pipelineRunner,
 		pipelineORM:    pipelineORM,
 		chHeads:        make(chan *evmtypes.Head, 1),
@@ -93,6 +98,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 			"externalJobID", jb.ExternalJobID,
 		)
 
+	drORM := NewORM(d.ds)
 	logListener := &listener{
 		logger:                   svcLogger.Named("Listener"),
 		config:                   chain.Config().EVM(),
@@ -107,8 +113,36 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		minIncomingConfirmations: concreteSpec.MinIncomingConfirmations.Uint32,
 		requesters:               concreteSpec.Requesters,
 		minContractPayment:       concreteSpec.MinContractPayment,
+		orm:                      drORM,
+		rateLimiter:              newRequesterRateLimiter(drORM, jb.ID, concreteSpec.RequesterRateLimit, concreteSpec.RequesterRateLimitPeriod.Duration()),
 		chStop:                   make(chan struct{}),
 	}
+	if concreteSpec.RequesterAllowlistAddress != nil {
+		allowlist := newRequesterAllowlistContract(concreteSpec.RequesterAllowlistAddress.Address(), chain.Client())
+		logListener.allowlist = newRequesterAllowlist(svcLogger, chain.LogBroadcaster(), allowlist, concreteSpec.MinIncomingConfirmations.Uint32, jb.ID)
+	}
+	if concreteSpec.RequesterDailySpendCap != nil {
+		logListener.dailySpendCap = concreteSpec.RequesterDailySpendCap.ToInt()
+		gasEstimator := chain.Config().EVM().GasEstimator()
+		gasLimit := gasEstimator.LimitDefault()
+		if drLimit := gasEstimator.LimitJobType().DR(); drLimit != nil {
+			gasLimit = uint64(*drLimit)
+		}
+		if jb.GasLimit.Valid {
+			gasLimit = uint64(jb.GasLimit.Uint32)
+		}
+		client := chain.Client()
+		// The callback cost is estimated when the request is accepted, as
+		// the gas limit of the job at the current gas price, and replaced by
+		// the actual cost once the transaction is confirmed.
+		logListener.callbackCost = func(ctx context.Context) (*big.Int, error) {
+			gasPrice, err := client.SuggestGasPrice(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return gasPrice.Mul(gasPrice, new(big.Int).SetUint64(gasLimit)), nil
+		}
+	}
 	var services []job.ServiceCtx
 	services = append(services, logListener)
 
@@ -137,6 +171,11 @@ type listener struct {
 	minIncomingConfirmations uint32
 	requesters               models.AddressCollection
 	minContractPayment       *assets.Link
+	orm                      ORM
+	allowlist                *requesterAllowlist
+	rateLimiter              *requesterRateLimiter
+	dailySpendCap            *big.Int
+	callbackCost             func(ctx context.Context) (*big.Int, error)
 	chStop                   services.StopChan
 }
 
@@ -147,7 +186,7 @@ func (l *listener) HealthReport() map[string]error {
 func (l *listener) Name() string { return l.logger.Name() }
 
 // Start complies with job.Service
-func (l *listener) Start(context.Context) error {
+func (l *listener) Start(ctx context.Context) error {
 	return l.StartOnce("DirectRequestListener", func() error {
 		unsubscribeLogs := l.logBroadcaster.Register(l, log.ListenerOpts{
 			Contract: l.oracle.Address(),
@@ -168,6 +207,28 @@ func (l *listener) Start(context.Context) error {
 			l.shutdownWaitGroup.Done()
 		}()
 
+		if l.dailySpendCap != nil {
+			l.shutdownWaitGroup.Add(1)
+			go func() {
+				defer l.shutdownWaitGroup.Done()
+				l.reconcileSpend()
+			}()
+		}
+
+		if l.allowlist != nil {
+			// Requests are checked against the static requesters until the
+			// allowlist loads, so a failure here does not prevent the job
+			// from starting.
+			if err := l.allowlist.refresh(ctx); err != nil {
+				l.logger.Errorw("Failed to load requester allowlist", "err", err)
+			}
+			l.shutdownWaitGroup.Add(1)
+			go func() {
+				defer l.shutdownWaitGroup.Done()
+				l.allowlist.run(l.chStop)
+			}()
+		}
+
 		l.mailMon.Monitor(l.mbOracleRequests, "DirectRequest", "Requests", strconv.Itoa(int(l.job.PipelineSpec.JobID)))
 		l.mailMon.Monitor(l.mbOracleCancelRequests, "DirectRequest", "Cancel", strconv.Itoa(int(l.job.PipelineSpec.JobID)))
 
@@ -318,7 +379,7 @@ func (l *listener) handleOracleRequest(ctx context.Context, request *operator.Op
 			"requester", request.Requester,
 			"allowedRequesters", l.requesters.ToStrings(),
 		)
-		l.markLogConsumed(ctx, nil, lb)
+		l.rejectRequest(ctx, request, lb, RejectionReasonRequesterNotAllowed, "")
 		return
 	}
 
@@ -335,11 +396,33 @@ func (l *listener) handleOracleRequest(ctx context.Context, request *operator.Op
 				"minContractPayment", minContractPayment.String(),
 				"requestPayment", requestPayment.String(),
 			)
-			l.markLogConsumed(ctx, nil, lb)
+			l.rejectRequest(ctx, request, lb, RejectionReasonInsufficientPayment, fmt.Sprintf("minimum contract payment is %s", minContractPayment.String()))
 			return
 		}
 	}
 
+	// Limits fail closed: a request whose limits cannot be checked is
+	// rejected, and the rejection records why.
+	if ok, err := l.rateLimiter.take(ctx, request.RequestId, request.Requester); err != nil {
+		l.logger.Errorw("Rejected run as the requester rate limit could not be checked", "requester", request.Requester, "err", err)
+		l.rejectRequest(ctx, request, lb, RejectionReasonLimitCheckFailed, fmt.Sprintf("checking the rate limit: %v", err))
+		return
+	} else if !ok {
+		l.logger.Warnw("Rejected run for exceeding the requester rate limit", "requester", request.Requester)
+		l.rejectRequest(ctx, request, lb, RejectionReasonRateLimited, fmt.Sprintf("more than %d requests in %s", l.rateLimiter.max, l.rateLimiter.period))
+		return
+	}
+
+	if ok, detail, err := l.reserveSpend(ctx, request); err != nil {
+		l.logger.Errorw("Rejected run as the requester daily spend cap could not be checked", "requester", request.Requester, "err", err)
+		l.rejectRequest(ctx, request, lb, RejectionReasonLimitCheckFailed, fmt.Sprintf("checking the daily spend cap: %v", err))
+		return
+	} else if !ok {
+		l.logger.Warnw("Rejected run for exceeding the requester daily spend cap", "requester", request.Requester, "detail", detail)
+		l.rejectRequest(ctx, request, lb, RejectionReasonSpendCapExceeded, detail)
+		return
+	}
+
 	meta := make(map[string]interface{})
 	meta["oracleRequest"] = oracleRequestToMap(request)
 
@@ -388,7 +471,7 @@ func (l *listener) handleOracleRequest(ctx context.Context, request *operator.Op
 }
 
 func (l *listener) allowRequester(requester common.Address) bool {
-	if len(l.requesters) == 0 {
+	if len(l.requesters) == 0 && l.allowlist == nil {
 		return true
 	}
 	for _, addr := range l.requesters {
@@ -396,7 +479,73 @@ func (l *listener) allowRequester(requester common.Address) bool {
 			return true
 		}
 	}
-	return false
+	return l.allowlist != nil && l.allowlist.allow(requester)
+}
+
+// reserveSpend adds the estimated cost of the callback to the daily spend of
+// the requester. The estimate is replaced by the actual cost of the callback
+// by reconcileSpend.
+func (l *listener) reserveSpend(ctx context.Context, request *operator.OperatorOracleRequest) (bool, string, error) {
+	if l.dailySpendCap == nil {
+		return true, "", nil
+	}
+	cost, err := l.callbackCost(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "estimating the callback cost")
+	}
+	ok, err := l.orm.ReserveSpend(ctx, l.job.ID, request.RequestId, request.Requester, time.Now(), cost, l.dailySpendCap)
+	if err != nil {
+		return false, "", err
+	}
+	if !ok {
+		return false, fmt.Sprintf("estimated callback cost of %s wei would exceed the daily spend cap of %s wei", cost, l.dailySpendCap), nil
+	}
+	return true, "", nil
+}
+
+// reconcileSpend periodically replaces the estimated cost of the accepted
+// requests with the cost of their confirmed callbacks, and releases the
+// estimates of requests that never produced a callback, until the listener
+// is closed.
+func (l *listener) reconcileSpend() {
+	ctx, cancel := l.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(spendReconcilePeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.chStop:
+			return
+		case <-ticker.C:
+		}
+		n, err := l.orm.ReconcileSpend(ctx, l.job.ID, time.Now().Add(-spendReservationExpiry))
+		if err != nil && ctx.Err() == nil {
+			l.logger.Errorw("Failed to reconcile the spend of requesters", "err", err)
+		} else if n > 0 {
+			l.logger.Debugw("Reconciled the spend of requesters", "requests", n)
+		}
+	}
+}
+
+// rejectRequest records why a request was not run and consumes its log
+func (l *listener) rejectRequest(ctx context.Context, request *operator.OperatorOracleRequest, lb log.Broadcast, reason RejectionReason, detail string) {
+	rejection := &Rejection{
+		JobID:       l.job.ID,
+		RequestID:   request.RequestId,
+		Requester:   request.Requester,
+		Reason:      reason,
+		Detail:      detail,
+		BlockNumber: int64(request.Raw.BlockNumber),
+		TxHash:      request.Raw.TxHash,
+	}
+	if request.Payment != nil {
+		rejection.Payment = (*assets.Link)(request.Payment)
+	}
+	if err := l.orm.CreateRejection(ctx, rejection); err != nil {
+		l.logger.Errorw("Failed to record rejected request", "requestId", formatRequestId(request.RequestId), "reason", reason, "err", err)
+	}
+	l.markLogConsumed(ctx, nil, lb)
 }
 
 // Cancels runs that haven't been started yet, with the given request ID
@@ -35,6 +35,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	pipeline_mocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func TestDelegate_ServicesForSpec(t *testing.T) {
@@ -58,7 +59,7 @@ func TestDelegate_ServicesForSpec(t *testing.T) {
 	})
 
 	lggr := logger.TestLogger(t)
-	delegate := directrequest.NewDelegate(lggr, runner, nil, legacyChains, mailMon)
+	delegate := directrequest.NewDelegate(lggr, nil, runner, nil, legacyChains, mailMon)
 
 	t.Run("Spec without DirectRequestSpec", func(t *testing.T) {
 		spec := job.Job{}
@@ -79,6 +80,7 @@ type DirectRequestUniverse struct {
 	runner         *pipeline_mocks.Runner
 	service        job.ServiceCtx
 	jobORM         job.ORM
+	drORM          directrequest.ORM
 	listener       log.Listener
 	logBroadcaster *log_mocks.Broadcaster
 	cleanup        func()
@@ -109,7 +111,7 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg chainlink.GeneralConfi
 	orm := pipeline.NewORM(db, lggr, cfg.JobPipeline().MaxSuccessfulRuns())
 	btORM := bridges.NewORM(db)
 	jobORM := job.NewORM(db, orm, btORM, keyStore, lggr)
-	delegate := directrequest.NewDelegate(lggr, runner, orm, legacyChains, mailMon)
+	delegate := directrequest.NewDelegate(lggr, db, runner, orm, legacyChains, mailMon)
 
 	jb := cltest.MakeDirectRequestJobSpec(t)
 	jb.ExternalJobID = uuid.New()
@@ -128,6 +130,7 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg chainlink.GeneralConfi
 		runner:         runner,
 		service:        service,
 		jobORM:         jobORM,
+		drORM:          directrequest.NewORM(db),
 		listener:       nil,
 		logBroadcaster: broadcaster,
 		cleanup:        func() { jobORM.Close() },
@@ -469,6 +472,12 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 
 		markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
 
+		rejections, err := uni.drORM.FindRejections(ctx, uni.spec.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, rejections, 1)
+		assert.Equal(t, directrequest.RejectionReasonInsufficientPayment, rejections[0].Reason)
+		assert.Equal(t, "99", rejections[0].Payment.ToInt().String())
+
 		uni.service.Close()
 	})
 
@@ -572,8 +581,141 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 
 		markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
 
+		rejections, err := uni.drORM.FindRejections(ctx, uni.spec.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, rejections, 1)
+		assert.Equal(t, directrequest.RejectionReasonRequesterNotAllowed, rejections[0].Reason)
+		assert.Equal(t, requester, rejections[0].Requester)
+
+		uni.service.Close()
+	})
+
+	t.Run("requester exceeds the requester rate limit", func(t *testing.T) {
+		requester := testutils.NewAddress()
+		uni := NewDirectRequestUniverseWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].MinIncomingConfirmations = ptr[uint32](1)
+		}), func(jb *job.Job) {
+			jb.DirectRequestSpec.RequesterRateLimit = 1
+			jb.DirectRequestSpec.RequesterRateLimitPeriod = models.Interval(time.Hour)
+		})
+		defer uni.Cleanup()
+
+		uni.logBroadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+		newLog := func(requestID common.Hash) *log_mocks.Broadcast {
+			lb := log_mocks.NewBroadcast(t)
+			lb.On("ReceiptsRoot").Return(common.Hash{}).Maybe()
+			lb.On("TransactionsRoot").Return(common.Hash{}).Maybe()
+			lb.On("StateRoot").Return(common.Hash{}).Maybe()
+			lb.On("EVMChainID").Return(*big.NewInt(0)).Maybe()
+			lb.On("RawLog").Return(types.Log{
+				Topics: []common.Hash{
+					{},
+					uni.spec.ExternalIDEncodeStringToTopic(),
+				},
+			})
+			lb.On("DecodedLog").Return(&operator.OperatorOracleRequest{
+				CancelExpiration: big.NewInt(0),
+				Payment:          big.NewInt(100),
+				Requester:        requester,
+				RequestId:        requestID,
+			})
+			lb.On("String").Return("").Maybe()
+			return lb
+		}
+		markConsumedLogAwaiter := cltest.NewAwaiter()
+		uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			markConsumedLogAwaiter.ItHappened()
+		}).Return(nil)
+		uni.runner.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(false, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, uni.service.Start(ctx))
+
+		uni.listener.HandleLog(ctx, newLog(common.HexToHash("0x1")))
+		uni.listener.HandleLog(ctx, newLog(common.HexToHash("0x2")))
+
+		markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
+
+		rejections, err := uni.drORM.FindRejections(ctx, uni.spec.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, rejections, 1)
+		assert.Equal(t, directrequest.RejectionReasonRateLimited, rejections[0].Reason)
+		assert.Equal(t, common.HexToHash("0x2"), rejections[0].RequestID)
+
 		uni.service.Close()
 	})
 }
 
+func TestORM_ReserveSpend(t *testing.T) {
+	testutils.SkipShortDB(t)
+	t.Parallel()
+
+	uni := NewDirectRequestUniverse(t)
+	defer uni.Cleanup()
+
+	ctx := testutils.Context(t)
+	requester := testutils.NewAddress()
+	day := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	spendCap := big.NewInt(100)
+	reserve := func(requestID int64, requester common.Address, day time.Time, amount int64) bool {
+		ok, err := uni.drORM.ReserveSpend(ctx, uni.spec.ID, common.BigToHash(big.NewInt(requestID)), requester, day, big.NewInt(amount), spendCap)
+		require.NoError(t, err)
+		return ok
+	}
+
+	assert.True(t, reserve(1, requester, day, 60))
+	assert.False(t, reserve(2, requester, day, 50), "exceeds the cap")
+	assert.True(t, reserve(1, requester, day, 60), "a request is only reserved once")
+	assert.True(t, reserve(3, requester, day, 40), "reaches the cap")
+	assert.True(t, reserve(4, testutils.NewAddress(), day, 100), "caps are per requester")
+	assert.True(t, reserve(5, requester, day.AddDate(0, 0, 1), 100), "caps are per day")
+	assert.False(t, reserve(6, requester, day, 101))
+
+	n, err := uni.drORM.ReconcileSpend(ctx, uni.spec.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, n, "no callback transaction is final yet")
+
+	n, err = uni.drORM.ReconcileSpend(ctx, uni.spec.ID, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 4, n, "reservations without a callback transaction expire")
+	assert.True(t, reserve(7, requester, day, 100), "expired reservations are released")
+}
+
+func TestORM_TakeRequest(t *testing.T) {
+	testutils.SkipShortDB(t)
+	t.Parallel()
+
+	uni := NewDirectRequestUniverse(t)
+	defer uni.Cleanup()
+
+	ctx := testutils.Context(t)
+	now := time.Now()
+	a, b := testutils.NewAddress(), testutils.NewAddress()
+	take := func(requester common.Address) bool {
+		ok, err := uni.drORM.TakeRequest(ctx, uni.spec.ID, testutils.Random32Byte(), requester, now, 2, time.Minute)
+		require.NoError(t, err)
+		return ok
+	}
+
+	assert.True(t, take(a))
+	assert.True(t, take(a))
+	assert.False(t, take(a))
+	assert.True(t, take(b), "limits are per requester")
+
+	now = now.Add(30 * time.Second)
+	assert.False(t, take(a))
+
+	now = now.Add(31 * time.Second)
+	assert.True(t, take(a))
+	requestID := testutils.Random32Byte()
+	ok, err := uni.drORM.TakeRequest(ctx, uni.spec.ID, requestID, a, now, 2, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, take(a))
+
+	ok, err = uni.drORM.TakeRequest(ctx, uni.spec.ID, requestID, a, now, 2, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok, "a request delivered again is not counted twice")
+}
+
 func ptr[T any](t T) *T { return &t }
@@ -0,0 +1,56 @@
+package directrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// spendReconcilePeriod is how often the estimated spend of the accepted
+	// requests is replaced by the cost of their confirmed callbacks
+	spendReconcilePeriod = time.Minute
+	// spendReservationExpiry is how long the estimated spend of an accepted
+	// request is held without a callback transaction. It is well above the
+	// default maximum run duration, so only requests whose runs failed
+	// before their callback are released.
+	spendReservationExpiry = time.Hour
+)
+
+// requesterRateLimiter limits the number of requests accepted from each
+// requester within a rolling period. The accepted requests are stored by
+// request ID, so the limit holds across job and node restarts and a request
+// delivered again is only counted once.
+type requesterRateLimiter struct {
+	orm    ORM
+	jobID  int32
+	max    uint32
+	period time.Duration
+	now    func() time.Time
+}
+
+// newRequesterRateLimiter returns a limiter accepting max requests per
+// requester per period. A zero max or period disables the limit.
+func newRequesterRateLimiter(orm ORM, jobID int32, max uint32, period time.Duration) *requesterRateLimiter {
+	return &requesterRateLimiter{
+		orm:    orm,
+		jobID:  jobID,
+		max:    max,
+		period: period,
+		now:    time.Now,
+	}
+}
+
+func (l *requesterRateLimiter) enabled() bool {
+	return l != nil && l.max > 0 && l.period > 0
+}
+
+// take records the request requestID of requester, returning false without
+// recording it if the requester has reached the limit.
+func (l *requesterRateLimiter) take(ctx context.Context, requestID common.Hash, requester common.Address) (bool, error) {
+	if !l.enabled() {
+		return true, nil
+	}
+	return l.orm.TakeRequest(ctx, l.jobID, requestID, requester, l.now(), l.max, l.period)
+}
@@ -0,0 +1,29 @@
+package directrequest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestRequesterRateLimiter_Disabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	var l *requesterRateLimiter
+	ok, err := l.take(ctx, testutils.Random32Byte(), testutils.NewAddress())
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// the ORM is not used when the limit is disabled
+	l = newRequesterRateLimiter(nil, 1, 0, 0)
+	requester := testutils.NewAddress()
+	for range 10 {
+		ok, err = l.take(ctx, testutils.Random32Byte(), requester)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+}
@@ -0,0 +1,289 @@
+package directrequest
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// RejectionReason explains why an oracle request was not run
+type RejectionReason string
+
+const (
+	// RejectionReasonRequesterNotAllowed means the requester is neither in the
+	// requesters of the job nor in its on-chain allowlist
+	RejectionReasonRequesterNotAllowed RejectionReason = "requester_not_allowed"
+	// RejectionReasonInsufficientPayment means the payment is below the
+	// minimum contract payment
+	RejectionReasonInsufficientPayment RejectionReason = "insufficient_payment"
+	// RejectionReasonRateLimited means the requester exceeded the requester
+	// rate limit of the job
+	RejectionReasonRateLimited RejectionReason = "rate_limited"
+	// RejectionReasonSpendCapExceeded means the estimated cost of the callback
+	// would exceed the daily spend cap of the requester
+	RejectionReasonSpendCapExceeded RejectionReason = "spend_cap_exceeded"
+	// RejectionReasonLimitCheckFailed means the rate limit or the spend cap of
+	// the requester could not be checked, and the request was rejected rather
+	// than run without its limits
+	RejectionReasonLimitCheckFailed RejectionReason = "limit_check_failed"
+)
+
+// Rejection records an oracle request that was not run
+type Rejection struct {
+	ID          int64
+	JobID       int32
+	RequestID   common.Hash
+	Requester   common.Address
+	Payment     *assets.Link
+	Reason      RejectionReason
+	Detail      string
+	BlockNumber int64
+	TxHash      common.Hash
+	CreatedAt   time.Time
+}
+
+// ORM records rejected requests, and the requests and daily spend of
+// requesters
+type ORM interface {
+	CreateRejection(ctx context.Context, r *Rejection) error
+	// FindRejections returns the most recent rejections of a job first
+	FindRejections(ctx context.Context, jobID int32, limit int) ([]Rejection, error)
+	// TakeRequest records the request requestID of requester at now, unless
+	// requester already made limit requests in the period before now. It
+	// returns false if the request was not recorded. Taking a request that is
+	// already recorded returns true without counting it again.
+	TakeRequest(ctx context.Context, jobID int32, requestID common.Hash, requester common.Address, now time.Time, limit uint32, period time.Duration) (bool, error)
+	// ReserveSpend adds the estimated cost of the request to the spend of
+	// requester on day, unless the total would exceed spendCap. It returns
+	// false if the amount was not added. Reserving the same request again
+	// does nothing.
+	ReserveSpend(ctx context.Context, jobID int32, requestID common.Hash, requester common.Address, day time.Time, amount, spendCap *big.Int) (bool, error)
+	// ReconcileSpend replaces the reserved cost of the requests of a job with
+	// the cost of their transactions once these are confirmed, or with zero if
+	// they failed. Reservations made before expireBefore that have no
+	// transaction, e.g. as their run failed, are released. It returns the
+	// number of reconciled and released requests.
+	ReconcileSpend(ctx context.Context, jobID int32, expireBefore time.Time) (int, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = &orm{}
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) CreateRejection(ctx context.Context, r *Rejection) error {
+	stmt := `INSERT INTO direct_request_rejections (job_id, request_id, requester, payment, reason, detail, block_number, tx_hash, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING id, created_at`
+	err := o.ds.QueryRowxContext(ctx, stmt, r.JobID, r.RequestID, r.Requester, r.Payment, r.Reason, r.Detail, r.BlockNumber, r.TxHash).
+		Scan(&r.ID, &r.CreatedAt)
+	return errors.Wrap(err, "CreateRejection failed")
+}
+
+func (o *orm) FindRejections(ctx context.Context, jobID int32, limit int) (rejections []Rejection, err error) {
+	err = o.ds.SelectContext(ctx, &rejections, `SELECT * FROM direct_request_rejections WHERE job_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2`, jobID, limit)
+	return rejections, errors.Wrap(err, "FindRejections failed")
+}
+
+func (o *orm) TakeRequest(ctx context.Context, jobID int32, requestID common.Hash, requester common.Address, now time.Time, limit uint32, period time.Duration) (taken bool, err error) {
+	cutoff := now.Add(-period)
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		// a log delivered again, e.g. after a restart, is not counted twice
+		err := tx.GetContext(ctx, &taken, `SELECT EXISTS (SELECT 1 FROM direct_request_requester_requests WHERE job_id = $1 AND request_id = $2)`, jobID, requestID)
+		if err != nil || taken {
+			return err
+		}
+		// the requests that left the period are pruned, they are not counted
+		// either way
+		stmt := `WITH pruned AS (
+				DELETE FROM direct_request_requester_requests WHERE job_id = $1 AND requester = $3 AND created_at <= $5
+			)
+			INSERT INTO direct_request_requester_requests (job_id, request_id, requester, created_at)
+			SELECT $1, $2, $3, $4
+			WHERE (SELECT count(*) FROM direct_request_requester_requests WHERE job_id = $1 AND requester = $3 AND created_at > $5) < $6
+			ON CONFLICT (job_id, request_id) DO NOTHING`
+		res, err := tx.ExecContext(ctx, stmt, jobID, requestID, requester, now, cutoff, limit)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		taken = n == 1
+		return err
+	})
+	return taken, errors.Wrap(err, "TakeRequest failed")
+}
+
+// errSpendCapExceeded rolls back the reservation of a request exceeding the
+// spend cap
+var errSpendCapExceeded = errors.New("spend cap exceeded")
+
+func (o *orm) ReserveSpend(ctx context.Context, jobID int32, requestID common.Hash, requester common.Address, day time.Time, amount, spendCap *big.Int) (bool, error) {
+	if amount.Cmp(spendCap) > 0 {
+		return false, nil
+	}
+	dayStr := day.UTC().Format(time.DateOnly)
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		res, err := tx.ExecContext(ctx, `INSERT INTO direct_request_spend_reservations (job_id, request_id, requester, day, amount, created_at)
+			VALUES ($1, $2, $3, $4, $5, NOW())
+			ON CONFLICT (job_id, request_id) DO NOTHING`, jobID, requestID, requester, dayStr, ubig.New(amount))
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			// already reserved when the log was first delivered
+			return err
+		}
+		var spent ubig.Big
+		err = tx.GetContext(ctx, &spent, `INSERT INTO direct_request_requester_spend AS s (job_id, requester, day, spent) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (job_id, requester, day) DO UPDATE SET spent = s.spent + EXCLUDED.spent
+			WHERE s.spent + EXCLUDED.spent <= $5
+			RETURNING spent`, jobID, requester, dayStr, ubig.New(amount), ubig.New(spendCap))
+		if errors.Is(err, sql.ErrNoRows) {
+			return errSpendCapExceeded
+		}
+		return err
+	})
+	if errors.Is(err, errSpendCapExceeded) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "ReserveSpend failed")
+	}
+	return true, nil
+}
+
+// settledReservation is a spend reservation whose transaction is final. The
+// receipt fields are null if the transaction failed without being mined.
+type settledReservation struct {
+	RequestID         common.Hash
+	Requester         common.Address
+	Day               time.Time
+	Amount            ubig.Big
+	GasUsed           *string
+	EffectiveGasPrice *string
+	GasPrice          *ubig.Big
+	GasFeeCap         *ubig.Big
+}
+
+// cost returns what the transaction of the reservation paid for gas
+func (r settledReservation) cost() (*big.Int, error) {
+	if r.GasUsed == nil {
+		return new(big.Int), nil
+	}
+	gasUsed, err := hexutil.DecodeBig(*r.GasUsed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid gas used %q", *r.GasUsed)
+	}
+	var price *big.Int
+	switch {
+	case r.EffectiveGasPrice != nil:
+		if price, err = hexutil.DecodeBig(*r.EffectiveGasPrice); err != nil {
+			return nil, errors.Wrapf(err, "invalid effective gas price %q", *r.EffectiveGasPrice)
+		}
+	case r.GasPrice != nil:
+		// receipts of chains predating EIP-1559 have no effective gas price,
+		// the attempt paid what it offered
+		price = r.GasPrice.ToInt()
+	case r.GasFeeCap != nil:
+		price = r.GasFeeCap.ToInt()
+	default:
+		return new(big.Int), nil
+	}
+	return gasUsed.Mul(gasUsed, price), nil
+}
+
+func (o *orm) ReconcileSpend(ctx context.Context, jobID int32, expireBefore time.Time) (int, error) {
+	released, err := o.releaseExpiredReservations(ctx, jobID, expireBefore)
+	if err != nil {
+		return 0, err
+	}
+	reconciled, err := o.reconcileSettledReservations(ctx, jobID)
+	return released + reconciled, err
+}
+
+// releaseExpiredReservations releases the reservations made before
+// expireBefore for which no transaction was created. The requests of these
+// either failed before their callback, or their jobs do not set the
+// requestID of the transaction meta, and would otherwise hold their
+// reservation for the rest of the day.
+func (o *orm) releaseExpiredReservations(ctx context.Context, jobID int32, expireBefore time.Time) (released int, err error) {
+	err = o.ds.GetContext(ctx, &released, `WITH expired AS (
+			DELETE FROM direct_request_spend_reservations r
+			WHERE r.job_id = $1 AND r.created_at < $2 AND NOT EXISTS (
+				SELECT 1 FROM evm.txes t WHERE t.meta->>'JobID' = r.job_id::text AND t.meta->>'RequestID' = '0x' || encode(r.request_id, 'hex')
+			)
+			RETURNING r.requester, r.day, r.amount
+		), released AS (
+			UPDATE direct_request_requester_spend s SET spent = GREATEST(s.spent - e.amount, 0)
+			FROM (SELECT requester, day, sum(amount) AS amount FROM expired GROUP BY requester, day) e
+			WHERE s.job_id = $1 AND s.requester = e.requester AND s.day = e.day
+		)
+		SELECT count(*) FROM expired`, jobID, expireBefore)
+	return released, errors.Wrap(err, "ReconcileSpend failed to release expired reservations")
+}
+
+func (o *orm) reconcileSettledReservations(ctx context.Context, jobID int32) (int, error) {
+	// transactions are matched to requests by the jobID and requestID of
+	// their meta, which the ethtx task of a Direct Request job sets. The
+	// latest receipt is used if a reorg left several.
+	var settled []settledReservation
+	err := o.ds.SelectContext(ctx, &settled, `SELECT DISTINCT ON (r.request_id) r.request_id, r.requester, r.day, r.amount,
+			rc.receipt->>'gasUsed' AS gas_used, rc.receipt->>'effectiveGasPrice' AS effective_gas_price, a.gas_price, a.gas_fee_cap
+		FROM direct_request_spend_reservations r
+		JOIN evm.txes t ON t.meta->>'JobID' = r.job_id::text AND t.meta->>'RequestID' = '0x' || encode(r.request_id, 'hex')
+		LEFT JOIN evm.tx_attempts a ON a.eth_tx_id = t.id
+		LEFT JOIN evm.receipts rc ON rc.tx_hash = a.hash
+		WHERE r.job_id = $1 AND (t.state = 'fatal_error' OR (t.state = 'confirmed' AND rc.receipt IS NOT NULL))
+		ORDER BY r.request_id, rc.block_number DESC NULLS LAST`, jobID)
+	if err != nil {
+		return 0, errors.Wrap(err, "ReconcileSpend failed to find settled requests")
+	}
+	if len(settled) == 0 {
+		return 0, nil
+	}
+
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		for _, r := range settled {
+			cost, err := r.cost()
+			if err != nil {
+				return errors.Wrapf(err, "request %s", r.RequestID)
+			}
+			res, err := tx.ExecContext(ctx, `DELETE FROM direct_request_spend_reservations WHERE job_id = $1 AND request_id = $2`, jobID, r.RequestID)
+			if err != nil {
+				return err
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				// reconciled concurrently
+				continue
+			}
+			if _, err = tx.ExecContext(ctx, `UPDATE direct_request_requester_spend SET spent = GREATEST(spent - $4 + $5, 0)
+				WHERE job_id = $1 AND requester = $2 AND day = $3`, jobID, r.Requester, r.Day.Format(time.DateOnly), &r.Amount, ubig.New(cost)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "ReconcileSpend failed")
+	}
+	return len(settled), nil
+}
@@ -0,0 +1,36 @@
+package directrequest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+func TestSettledReservation_Cost(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+
+	cost, err := settledReservation{}.cost()
+	require.NoError(t, err)
+	assert.Zero(t, cost.Sign(), "failed transactions cost nothing")
+
+	cost, err = settledReservation{GasUsed: str("0x5208"), EffectiveGasPrice: str("0x3b9aca00"), GasPrice: ubig.NewI(5)}.cost()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(21_000_000_000_000), cost, "the effective gas price is preferred")
+
+	cost, err = settledReservation{GasUsed: str("0x5208"), GasPrice: ubig.NewI(5)}.cost()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(105_000), cost)
+
+	cost, err = settledReservation{GasUsed: str("0x5208"), GasFeeCap: ubig.NewI(2)}.cost()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42_000), cost)
+
+	_, err = settledReservation{GasUsed: str("21000")}.cost()
+	require.Error(t, err)
+}
@@ -1,23 +1,35 @@
 package directrequest
 
 import (
+	"regexp"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	evmassets "github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
+// txMetaRequestIDRegexp matches a txMeta setting the requestID of the
+// transaction, e.g. txMeta="{\\"requestID\\": $(decode_log.requestId)}"
+var txMetaRequestIDRegexp = regexp.MustCompile(`(?i)"requestID"\s*:`)
+
 type DirectRequestToml struct {
-	ContractAddress          types.EIP55Address       `toml:"contractAddress"`
-	Requesters               models.AddressCollection `toml:"requesters"`
-	MinContractPayment       *assets.Link             `toml:"minContractPaymentLinkJuels"`
-	EVMChainID               *big.Big                 `toml:"evmChainID"`
-	MinIncomingConfirmations null.Uint32              `toml:"minIncomingConfirmations"`
+	ContractAddress           types.EIP55Address       `toml:"contractAddress"`
+	Requesters                models.AddressCollection `toml:"requesters"`
+	MinContractPayment        *assets.Link             `toml:"minContractPaymentLinkJuels"`
+	EVMChainID                *big.Big                 `toml:"evmChainID"`
+	MinIncomingConfirmations  null.Uint32              `toml:"minIncomingConfirmations"`
+	RequesterAllowlistAddress *types.EIP55Address      `toml:"requesterAllowlistAddress"`
+	RequesterRateLimit        uint32                   `toml:"requesterRateLimit"`
+	RequesterRateLimitPeriod  models.Interval          `toml:"requesterRateLimitPeriod"`
+	RequesterDailySpendCap    *evmassets.Wei           `toml:"requesterDailySpendCap"`
 }
 
 func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
@@ -36,15 +48,56 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 		return jb, err
 	}
 	jb.DirectRequestSpec = &job.DirectRequestSpec{
-		ContractAddress:          spec.ContractAddress,
-		Requesters:               spec.Requesters,
-		MinContractPayment:       spec.MinContractPayment,
-		EVMChainID:               spec.EVMChainID,
-		MinIncomingConfirmations: spec.MinIncomingConfirmations,
+		ContractAddress:           spec.ContractAddress,
+		Requesters:                spec.Requesters,
+		MinContractPayment:        spec.MinContractPayment,
+		EVMChainID:                spec.EVMChainID,
+		MinIncomingConfirmations:  spec.MinIncomingConfirmations,
+		RequesterAllowlistAddress: spec.RequesterAllowlistAddress,
+		RequesterRateLimit:        spec.RequesterRateLimit,
+		RequesterRateLimitPeriod:  spec.RequesterRateLimitPeriod,
+		RequesterDailySpendCap:    spec.RequesterDailySpendCap,
 	}
 
 	if jb.Type != job.DirectRequest {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	if (spec.RequesterRateLimit == 0) != (spec.RequesterRateLimitPeriod.Duration() == 0) {
+		return jb, errors.New("requesterRateLimit and requesterRateLimitPeriod must be set together")
+	}
+	if spec.RequesterRateLimitPeriod.Duration() < 0 {
+		return jb, errors.New("requesterRateLimitPeriod must be positive")
+	}
+	if spec.RequesterDailySpendCap != nil && spec.RequesterDailySpendCap.ToInt().Sign() <= 0 {
+		return jb, errors.New("requesterDailySpendCap must be positive")
+	}
+	if spec.RequesterDailySpendCap != nil {
+		if err := validateCallbackTxMeta(jb.Pipeline); err != nil {
+			return jb, err
+		}
+	}
 	return jb, nil
 }
+
+// validateCallbackTxMeta checks that the callbacks of a job with a daily
+// spend cap can be matched to their requests. The spend of a request is
+// reconciled with the transaction whose meta has its requestID; without it
+// the estimate is only released once the reservation expires, and the actual
+// cost of the callback is never counted.
+func validateCallbackTxMeta(p pipeline.Pipeline) error {
+	var found bool
+	for _, task := range p.Tasks {
+		ethTx, ok := task.(*pipeline.ETHTxTask)
+		if !ok {
+			continue
+		}
+		found = true
+		if !txMetaRequestIDRegexp.MatchString(ethTx.TxMeta) {
+			return errors.Errorf("requesterDailySpendCap requires the txMeta of ethtx task %q to set requestID to the request ID of the log", ethTx.DotID())
+		}
+	}
+	if !found {
+		return errors.New("requesterDailySpendCap requires an ethtx task to send the callback")
+	}
+	return nil
+}
@@ -72,3 +72,97 @@ func TestValidatedDirectRequestSpec_MinIncomingConfirmations(t *testing.T) {
 		assert.Equal(t, uint32(100), s.DirectRequestSpec.MinIncomingConfirmations.Uint32)
 	})
 }
+
+func TestValidatedDirectRequestSpec_RequesterLimits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("limits specified", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                      = "directrequest"
+		schemaVersion             = 1
+		name                      = "example eth request event spec"
+		requesterAllowlistAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+		requesterRateLimit        = 10
+		requesterRateLimitPeriod  = "1h"
+		requesterDailySpendCap    = "1 ether"
+		observationSource         = """
+			decode_log [type=ethabidecodelog abi="OracleRequest(bytes32 indexed specId, address requester, bytes32 requestId, uint256 payment, address callbackAddr, bytes4 callbackFunctionId, uint256 cancelExpiration, uint256 dataVersion, bytes data)" data="$(jobRun.logData)" topics="$(jobRun.logTopics)"]
+			submit_tx  [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x" txMeta="{\\"requestID\\": $(decode_log.requestId)}"]
+			decode_log -> submit_tx
+		"""
+		`
+
+		s, err := ValidatedDirectRequestSpec(toml)
+		require.NoError(t, err)
+
+		require.NotNil(t, s.DirectRequestSpec.RequesterAllowlistAddress)
+		assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", s.DirectRequestSpec.RequesterAllowlistAddress.Hex())
+		assert.Equal(t, uint32(10), s.DirectRequestSpec.RequesterRateLimit)
+		assert.Equal(t, time.Hour, s.DirectRequestSpec.RequesterRateLimitPeriod.Duration())
+		assert.Equal(t, "1000000000000000000", s.DirectRequestSpec.RequesterDailySpendCap.ToInt().String())
+	})
+
+	t.Run("rate limit without period", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                = "directrequest"
+		schemaVersion       = 1
+		name                = "example eth request event spec"
+		requesterRateLimit  = 10
+		`
+
+		_, err := ValidatedDirectRequestSpec(toml)
+		require.ErrorContains(t, err, "must be set together")
+	})
+
+	t.Run("zero spend cap", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                   = "directrequest"
+		schemaVersion          = 1
+		name                   = "example eth request event spec"
+		requesterDailySpendCap = "0"
+		`
+
+		_, err := ValidatedDirectRequestSpec(toml)
+		require.ErrorContains(t, err, "requesterDailySpendCap must be positive")
+	})
+
+	t.Run("spend cap without the requestID of the callback", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                   = "directrequest"
+		schemaVersion          = 1
+		name                   = "example eth request event spec"
+		requesterDailySpendCap = "1 ether"
+		observationSource      = """
+			submit_tx [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x"]
+		"""
+		`
+
+		_, err := ValidatedDirectRequestSpec(toml)
+		require.ErrorContains(t, err, `txMeta of ethtx task "submit_tx" to set requestID`)
+	})
+
+	t.Run("spend cap without a callback", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                   = "directrequest"
+		schemaVersion          = 1
+		name                   = "example eth request event spec"
+		requesterDailySpendCap = "1 ether"
+		observationSource      = """
+			ds [type=http method=GET url="example.com"]
+		"""
+		`
+
+		_, err := ValidatedDirectRequestSpec(toml)
+		require.ErrorContains(t, err, "requires an ethtx task")
+	})
+}
@@ -0,0 +1,281 @@
+package feeds
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// ApprovalPolicyAction is what happens to a job proposal spec matched by an
+// approval policy
+type ApprovalPolicyAction string
+
+const (
+	// ApprovalPolicyActionApprove approves the spec as soon as it is proposed
+	ApprovalPolicyActionApprove ApprovalPolicyAction = "approve"
+	// ApprovalPolicyActionManual leaves the spec pending for an operator,
+	// regardless of the policies that follow
+	ApprovalPolicyActionManual ApprovalPolicyAction = "manual"
+)
+
+// ProposalKind distinguishes the first spec of a job proposal from the
+// updates of an already approved job
+type ProposalKind string
+
+const (
+	ProposalKindNew    ProposalKind = "new"
+	ProposalKindUpdate ProposalKind = "update"
+)
+
+// operatorTaskTypes are the task types that send transactions or call
+// contracts. Specs with any of them always need an operator to approve them,
+// whatever the approval policies.
+var operatorTaskTypes = []string{string(pipeline.TaskTypeETHTx), string(pipeline.TaskTypeETHCall)}
+
+// onchainTasksPolicy is the built-in policy matching the specs with
+// operatorTaskTypes, ahead of the policies of the feeds manager
+var onchainTasksPolicy = ApprovalPolicy{
+	Name:      "onchain-tasks",
+	Action:    ApprovalPolicyActionManual,
+	TaskTypes: operatorTaskTypes,
+}
+
+// ApprovalPolicy auto-approves, or explicitly requires an operator to
+// approve, the job proposal specs it matches. A spec matches a policy if it
+// satisfies all of its conditions. Empty conditions match any spec.
+type ApprovalPolicy struct {
+	Name   string               `json:"name"`
+	Action ApprovalPolicyAction `json:"action"`
+	// ProposalKind restricts the policy to new jobs or to updates of an
+	// approved job.
+	ProposalKind ProposalKind `json:"proposalKind,omitempty"`
+	// JobTypes matches specs of any of these job types.
+	JobTypes []string `json:"jobTypes,omitempty"`
+	// Bridges matches specs whose pipeline uses at least one bridge, and only
+	// these bridges.
+	Bridges []string `json:"bridges,omitempty"`
+	// TaskTypes matches specs whose pipeline has a task of any of these
+	// types, e.g. "ethtx".
+	TaskTypes []string `json:"taskTypes,omitempty"`
+	// ChangedFields matches updates that only change these fields of the
+	// approved spec. Fields of nested tables are dotted, and a table name
+	// allows all of its fields to change. An auto-approved update replaces
+	// the job of the approved spec it updates, but an update that would
+	// replace any other running job is left pending for an operator.
+	ChangedFields []string `json:"changedFields,omitempty"`
+}
+
+// ApprovalPolicies are evaluated in order, the first matching policy deciding
+// whether a spec is auto-approved. Specs that match no policy are left
+// pending, as are specs with ethtx or ethcall tasks, regardless of the
+// policies.
+type ApprovalPolicies []ApprovalPolicy
+
+func (ps ApprovalPolicies) Value() (driver.Value, error) {
+	if ps == nil {
+		ps = ApprovalPolicies{}
+	}
+	return json.Marshal(ps)
+}
+
+func (ps *ApprovalPolicies) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, ps)
+	case string:
+		return json.Unmarshal([]byte(v), ps)
+	default:
+		return errors.Errorf("unable to convert %v of %T to ApprovalPolicies", value, value)
+	}
+}
+
+// Validate checks that the policies are well-formed
+func (ps ApprovalPolicies) Validate() error {
+	names := make(map[string]struct{}, len(ps))
+	for i, p := range ps {
+		if p.Name == "" {
+			return errors.Errorf("policy %d: name is required", i)
+		}
+		if _, ok := names[p.Name]; ok {
+			return errors.Errorf("policy %s: duplicate name", p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		switch p.Action {
+		case ApprovalPolicyActionApprove, ApprovalPolicyActionManual:
+		default:
+			return errors.Errorf("policy %s: invalid action %q, must be %q or %q", p.Name, p.Action, ApprovalPolicyActionApprove, ApprovalPolicyActionManual)
+		}
+		switch p.ProposalKind {
+		case "", ProposalKindNew, ProposalKindUpdate:
+		default:
+			return errors.Errorf("policy %s: invalid proposal kind %q, must be %q or %q", p.Name, p.ProposalKind, ProposalKindNew, ProposalKindUpdate)
+		}
+		if len(p.ChangedFields) > 0 && p.ProposalKind == ProposalKindNew {
+			return errors.Errorf("policy %s: changed fields only apply to updates", p.Name)
+		}
+		if p.Action == ApprovalPolicyActionApprove {
+			for _, tt := range p.TaskTypes {
+				if slices.Contains(operatorTaskTypes, tt) {
+					return errors.Errorf("policy %s: specs with %s tasks always need an operator", p.Name, tt)
+				}
+			}
+		}
+		for _, jt := range p.JobTypes {
+			if job.Type(jt).SchemaVersion() == 0 {
+				return errors.Errorf("policy %s: unknown job type %q", p.Name, jt)
+			}
+		}
+	}
+	return nil
+}
+
+// match returns the first policy matching the proposed spec, if any
+func (ps ApprovalPolicies) match(p proposedSpec) *ApprovalPolicy {
+	if onchainTasksPolicy.matches(p) {
+		policy := onchainTasksPolicy
+		return &policy
+	}
+	for i := range ps {
+		if ps[i].matches(p) {
+			return &ps[i]
+		}
+	}
+	return nil
+}
+
+func (p ApprovalPolicy) matches(spec proposedSpec) bool {
+	switch p.ProposalKind {
+	case ProposalKindNew:
+		if spec.isUpdate {
+			return false
+		}
+	case ProposalKindUpdate:
+		if !spec.isUpdate {
+			return false
+		}
+	}
+	if len(p.JobTypes) > 0 && !slices.Contains(p.JobTypes, string(spec.jobType)) {
+		return false
+	}
+	if len(p.Bridges) > 0 {
+		// a spec without bridges must not pass a policy allowing some
+		if len(spec.bridges) == 0 {
+			return false
+		}
+		for _, bridge := range spec.bridges {
+			if !slices.Contains(p.Bridges, bridge) {
+				return false
+			}
+		}
+	}
+	if len(p.TaskTypes) > 0 && !slices.ContainsFunc(spec.taskTypes, func(tt string) bool {
+		return slices.Contains(p.TaskTypes, tt)
+	}) {
+		return false
+	}
+	if len(p.ChangedFields) > 0 {
+		if !spec.isUpdate {
+			return false
+		}
+		for _, field := range spec.changedFields {
+			if !fieldAllowed(p.ChangedFields, field) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func fieldAllowed(allowed []string, field string) bool {
+	for _, a := range allowed {
+		if field == a || strings.HasPrefix(field, a+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// proposedSpec is what approval policies know about a proposed spec
+type proposedSpec struct {
+	jobType       job.Type
+	isUpdate      bool
+	bridges       []string
+	taskTypes     []string
+	changedFields []string
+}
+
+// newProposedSpec describes the proposed spec of job j. approvedDefinition is
+// the definition of the approved spec of the same job proposal, if any.
+func newProposedSpec(j *job.Job, definition string, approvedDefinition *string) (proposedSpec, error) {
+	spec := proposedSpec{jobType: j.Type}
+	for _, task := range j.Pipeline.Tasks {
+		spec.taskTypes = append(spec.taskTypes, string(task.Type()))
+		if bridge, ok := task.(*pipeline.BridgeTask); ok {
+			spec.bridges = append(spec.bridges, bridge.Name)
+		}
+	}
+	if approvedDefinition != nil {
+		spec.isUpdate = true
+		changed, err := changedFields(*approvedDefinition, definition)
+		if err != nil {
+			return spec, err
+		}
+		spec.changedFields = changed
+	}
+	return spec, nil
+}
+
+// changedFields returns the sorted dotted paths of the fields that differ
+// between two TOML job specs
+func changedFields(before, after string) ([]string, error) {
+	beforeTree, err := toml.Load(before)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse approved spec")
+	}
+	afterTree, err := toml.Load(after)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposed spec")
+	}
+	beforeFields, afterFields := map[string]interface{}{}, map[string]interface{}{}
+	flattenFields("", beforeTree.ToMap(), beforeFields)
+	flattenFields("", afterTree.ToMap(), afterFields)
+
+	var changed []string
+	for field, v := range afterFields {
+		if bv, ok := beforeFields[field]; !ok || !reflect.DeepEqual(bv, v) {
+			changed = append(changed, field)
+		}
+	}
+	for field := range beforeFields {
+		if _, ok := afterFields[field]; !ok {
+			changed = append(changed, field)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
+
+func flattenFields(prefix string, m map[string]interface{}, out map[string]interface{}) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = fmt.Sprintf("%s.%s", prefix, k)
+		}
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenFields(key, nested, out)
+			continue
+		}
+		out[key] = v
+	}
+}
@@ -0,0 +1,222 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func Test_ApprovalPolicies_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		policies ApprovalPolicies
+		wantErr  string
+	}{
+		{
+			name: "valid",
+			policies: ApprovalPolicies{
+				{Name: "fm", Action: ApprovalPolicyActionApprove, ProposalKind: ProposalKindNew, JobTypes: []string{"fluxmonitor"}},
+				{Name: "ethtx", Action: ApprovalPolicyActionManual, TaskTypes: []string{"ethtx"}},
+				{Name: "updates", Action: ApprovalPolicyActionApprove, ProposalKind: ProposalKindUpdate, ChangedFields: []string{"name", "observationSource"}},
+			},
+		},
+		{
+			name:     "missing name",
+			policies: ApprovalPolicies{{Action: ApprovalPolicyActionApprove}},
+			wantErr:  "policy 0: name is required",
+		},
+		{
+			name: "duplicate name",
+			policies: ApprovalPolicies{
+				{Name: "a", Action: ApprovalPolicyActionApprove},
+				{Name: "a", Action: ApprovalPolicyActionManual},
+			},
+			wantErr: "policy a: duplicate name",
+		},
+		{
+			name:     "invalid action",
+			policies: ApprovalPolicies{{Name: "a", Action: "reject"}},
+			wantErr:  `policy a: invalid action "reject", must be "approve" or "manual"`,
+		},
+		{
+			name:     "invalid proposal kind",
+			policies: ApprovalPolicies{{Name: "a", Action: ApprovalPolicyActionApprove, ProposalKind: "any"}},
+			wantErr:  `policy a: invalid proposal kind "any", must be "new" or "update"`,
+		},
+		{
+			name:     "changed fields of new jobs",
+			policies: ApprovalPolicies{{Name: "a", Action: ApprovalPolicyActionApprove, ProposalKind: ProposalKindNew, ChangedFields: []string{"name"}}},
+			wantErr:  "policy a: changed fields only apply to updates",
+		},
+		{
+			name:     "approving ethtx specs",
+			policies: ApprovalPolicies{{Name: "a", Action: ApprovalPolicyActionApprove, TaskTypes: []string{"bridge", "ethtx"}}},
+			wantErr:  "policy a: specs with ethtx tasks always need an operator",
+		},
+		{
+			name:     "unknown job type",
+			policies: ApprovalPolicies{{Name: "a", Action: ApprovalPolicyActionApprove, JobTypes: []string{"foo"}}},
+			wantErr:  `policy a: unknown job type "foo"`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.policies.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func Test_ApprovalPolicies_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	want := ApprovalPolicies{
+		{Name: "a", Action: ApprovalPolicyActionApprove, JobTypes: []string{"fluxmonitor"}, Bridges: []string{"coingecko"}},
+	}
+	v, err := want.Value()
+	require.NoError(t, err)
+
+	var got ApprovalPolicies
+	require.NoError(t, got.Scan(v))
+	assert.Equal(t, want, got)
+
+	v, err = ApprovalPolicies(nil).Value()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("[]"), v)
+}
+
+func Test_ApprovalPolicies_match(t *testing.T) {
+	t.Parallel()
+
+	policies := ApprovalPolicies{
+		{Name: "manual-http", Action: ApprovalPolicyActionManual, TaskTypes: []string{"http"}},
+		{Name: "fm-new", Action: ApprovalPolicyActionApprove, ProposalKind: ProposalKindNew, JobTypes: []string{"fluxmonitor"}, Bridges: []string{"coingecko", "coinmarketcap"}},
+		{Name: "fm-update", Action: ApprovalPolicyActionApprove, ProposalKind: ProposalKindUpdate, JobTypes: []string{"fluxmonitor"}, ChangedFields: []string{"observationSource", "drumbeatSchedule"}},
+	}
+
+	tests := []struct {
+		name string
+		spec proposedSpec
+		want string
+	}{
+		{
+			name: "new job with allowed bridges",
+			spec: proposedSpec{jobType: job.FluxMonitor, bridges: []string{"coingecko"}, taskTypes: []string{"bridge", "jsonparse"}},
+			want: "fm-new",
+		},
+		{
+			name: "new job with another bridge",
+			spec: proposedSpec{jobType: job.FluxMonitor, bridges: []string{"coingecko", "other"}},
+		},
+		{
+			name: "new job without bridges",
+			spec: proposedSpec{jobType: job.FluxMonitor, taskTypes: []string{"jsonparse"}},
+		},
+		{
+			name: "first matching policy wins",
+			spec: proposedSpec{jobType: job.FluxMonitor, bridges: []string{"coingecko"}, taskTypes: []string{"bridge", "http"}},
+			want: "manual-http",
+		},
+		{
+			name: "ethtx specs always need an operator",
+			spec: proposedSpec{jobType: job.FluxMonitor, bridges: []string{"coingecko"}, taskTypes: []string{"bridge", "ethtx"}},
+			want: onchainTasksPolicy.Name,
+		},
+		{
+			name: "ethcall specs always need an operator",
+			spec: proposedSpec{jobType: job.FluxMonitor, bridges: []string{"coingecko"}, taskTypes: []string{"bridge", "ethcall"}},
+			want: onchainTasksPolicy.Name,
+		},
+		{
+			name: "other job type",
+			spec: proposedSpec{jobType: job.OffchainReporting},
+		},
+		{
+			name: "update of allowed fields",
+			spec: proposedSpec{jobType: job.FluxMonitor, isUpdate: true, changedFields: []string{"drumbeatSchedule", "observationSource"}},
+			want: "fm-update",
+		},
+		{
+			name: "update of nested allowed fields",
+			spec: proposedSpec{jobType: job.FluxMonitor, isUpdate: true, changedFields: []string{"drumbeatSchedule.foo"}},
+			want: "fm-update",
+		},
+		{
+			name: "update of another field",
+			spec: proposedSpec{jobType: job.FluxMonitor, isUpdate: true, changedFields: []string{"contractAddress", "observationSource"}},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := policies.match(tc.spec)
+			if tc.want == "" {
+				assert.Nil(t, p)
+			} else {
+				require.NotNil(t, p)
+				assert.Equal(t, tc.want, p.Name)
+			}
+		})
+	}
+
+	t.Run("built-in policy goes first", func(t *testing.T) {
+		t.Parallel()
+
+		p := ApprovalPolicies{{Name: "all", Action: ApprovalPolicyActionApprove}}.match(proposedSpec{jobType: job.DirectRequest, taskTypes: []string{"ethtx"}})
+		require.NotNil(t, p)
+		assert.Equal(t, onchainTasksPolicy.Name, p.Name)
+		assert.Equal(t, ApprovalPolicyActionManual, p.Action)
+	})
+}
+
+func Test_changedFields(t *testing.T) {
+	t.Parallel()
+
+	before := `
+type = "fluxmonitor"
+name = "example"
+threshold = 0.5
+observationSource = "ds1 [type=bridge name=coingecko]"
+
+[drumbeat]
+enabled = true
+schedule = "CRON_TZ=UTC */5 * * * *"
+`
+	after := `
+type = "fluxmonitor"
+name = "example"
+threshold = 1.0
+observationSource = "ds1 [type=bridge name=coingecko]"
+idleTimerPeriod = "1m"
+
+[drumbeat]
+enabled = true
+schedule = "CRON_TZ=UTC */10 * * * *"
+`
+	changed, err := changedFields(before, after)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"drumbeat.schedule", "idleTimerPeriod", "threshold"}, changed)
+
+	changed, err = changedFields(before, before)
+	require.NoError(t, err)
+	assert.Empty(t, changed)
+
+	_, err = changedFields(before, "not toml = ")
+	require.ErrorContains(t, err, "failed to parse proposed spec")
+}
@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	null "gopkg.in/guregu/null.v4"
+
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	uuid "github.com/google/uuid"
@@ -1974,6 +1976,103 @@ func (_c *ORM_UpdateManager_Call) RunAndReturn(run func(context.Context, feeds.F
 	return _c
 }
 
+// UpdateManagerApprovalPolicies provides a mock function with given fields: ctx, id, policies
+func (_m *ORM) UpdateManagerApprovalPolicies(ctx context.Context, id int64, policies feeds.ApprovalPolicies) error {
+	ret := _m.Called(ctx, id, policies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManagerApprovalPolicies")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, feeds.ApprovalPolicies) error); ok {
+		r0 = rf(ctx, id, policies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpdateManagerApprovalPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateManagerApprovalPolicies'
+type ORM_UpdateManagerApprovalPolicies_Call struct {
+	*mock.Call
+}
+
+// UpdateManagerApprovalPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - policies feeds.ApprovalPolicies
+func (_e *ORM_Expecter) UpdateManagerApprovalPolicies(ctx interface{}, id interface{}, policies interface{}) *ORM_UpdateManagerApprovalPolicies_Call {
+	return &ORM_UpdateManagerApprovalPolicies_Call{Call: _e.mock.On("UpdateManagerApprovalPolicies", ctx, id, policies)}
+}
+
+func (_c *ORM_UpdateManagerApprovalPolicies_Call) Run(run func(ctx context.Context, id int64, policies feeds.ApprovalPolicies)) *ORM_UpdateManagerApprovalPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(feeds.ApprovalPolicies))
+	})
+	return _c
+}
+
+func (_c *ORM_UpdateManagerApprovalPolicies_Call) Return(_a0 error) *ORM_UpdateManagerApprovalPolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpdateManagerApprovalPolicies_Call) RunAndReturn(run func(context.Context, int64, feeds.ApprovalPolicies) error) *ORM_UpdateManagerApprovalPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSpecApprovalDecision provides a mock function with given fields: ctx, id, decision, policy
+func (_m *ORM) UpdateSpecApprovalDecision(ctx context.Context, id int64, decision feeds.ApprovalDecision, policy null.String) error {
+	ret := _m.Called(ctx, id, decision, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSpecApprovalDecision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, feeds.ApprovalDecision, null.String) error); ok {
+		r0 = rf(ctx, id, decision, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpdateSpecApprovalDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSpecApprovalDecision'
+type ORM_UpdateSpecApprovalDecision_Call struct {
+	*mock.Call
+}
+
+// UpdateSpecApprovalDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - decision feeds.ApprovalDecision
+//   - policy null.String
+func (_e *ORM_Expecter) UpdateSpecApprovalDecision(ctx interface{}, id interface{}, decision interface{}, policy interface{}) *ORM_UpdateSpecApprovalDecision_Call {
+	return &ORM_UpdateSpecApprovalDecision_Call{Call: _e.mock.On("UpdateSpecApprovalDecision", ctx, id, decision, policy)}
+}
+
+func (_c *ORM_UpdateSpecApprovalDecision_Call) Run(run func(ctx context.Context, id int64, decision feeds.ApprovalDecision, policy null.String)) *ORM_UpdateSpecApprovalDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(feeds.ApprovalDecision), args[3].(null.String))
+	})
+	return _c
+}
+
+func (_c *ORM_UpdateSpecApprovalDecision_Call) Return(_a0 error) *ORM_UpdateSpecApprovalDecision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpdateSpecApprovalDecision_Call) RunAndReturn(run func(context.Context, int64, feeds.ApprovalDecision, null.String) error) *ORM_UpdateSpecApprovalDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSpecDefinition provides a mock function with given fields: ctx, id, spec
 func (_m *ORM) UpdateSpecDefinition(ctx context.Context, id int64, spec string) error {
 	ret := _m.Called(ctx, id, spec)
@@ -1544,6 +1544,54 @@ func (_c *Service_UpdateManager_Call) RunAndReturn(run func(context.Context, fee
 	return _c
 }
 
+// UpdateManagerApprovalPolicies provides a mock function with given fields: ctx, id, policies
+func (_m *Service) UpdateManagerApprovalPolicies(ctx context.Context, id int64, policies feeds.ApprovalPolicies) error {
+	ret := _m.Called(ctx, id, policies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManagerApprovalPolicies")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, feeds.ApprovalPolicies) error); ok {
+		r0 = rf(ctx, id, policies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Service_UpdateManagerApprovalPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateManagerApprovalPolicies'
+type Service_UpdateManagerApprovalPolicies_Call struct {
+	*mock.Call
+}
+
+// UpdateManagerApprovalPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - policies feeds.ApprovalPolicies
+func (_e *Service_Expecter) UpdateManagerApprovalPolicies(ctx interface{}, id interface{}, policies interface{}) *Service_UpdateManagerApprovalPolicies_Call {
+	return &Service_UpdateManagerApprovalPolicies_Call{Call: _e.mock.On("UpdateManagerApprovalPolicies", ctx, id, policies)}
+}
+
+func (_c *Service_UpdateManagerApprovalPolicies_Call) Run(run func(ctx context.Context, id int64, policies feeds.ApprovalPolicies)) *Service_UpdateManagerApprovalPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(feeds.ApprovalPolicies))
+	})
+	return _c
+}
+
+func (_c *Service_UpdateManagerApprovalPolicies_Call) Return(_a0 error) *Service_UpdateManagerApprovalPolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Service_UpdateManagerApprovalPolicies_Call) RunAndReturn(run func(context.Context, int64, feeds.ApprovalPolicies) error) *Service_UpdateManagerApprovalPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSpecDefinition provides a mock function with given fields: ctx, id, spec
 func (_m *Service) UpdateSpecDefinition(ctx context.Context, id int64, spec string) error {
 	ret := _m.Called(ctx, id, spec)
@@ -120,6 +120,7 @@ type FeedsManager struct {
 	URI                string
 	PublicKey          crypto.PublicKey
 	IsConnectionActive bool
+	ApprovalPolicies   ApprovalPolicies
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 	DisabledAt         *time.Time
@@ -258,16 +259,31 @@ const (
 
 // JobProposalSpec defines a versioned proposed spec for a JobProposal.
 type JobProposalSpec struct {
-	ID              int64
-	Definition      string
-	Status          SpecStatus
-	Version         int32
-	JobProposalID   int64
-	StatusUpdatedAt time.Time
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	ID            int64
+	Definition    string
+	Status        SpecStatus
+	Version       int32
+	JobProposalID int64
+	// ApprovalDecision records whether the spec was auto-approved by an
+	// approval policy of the feeds manager, and ApprovalPolicy the name of
+	// the policy that decided it, if any.
+	ApprovalDecision null.String
+	ApprovalPolicy   null.String
+	StatusUpdatedAt  time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
+// ApprovalDecision is the outcome of evaluating the approval policies of a
+// feeds manager for a proposed spec
+type ApprovalDecision string
+
+const (
+	ApprovalDecisionAutoApproved       ApprovalDecision = "auto_approved"
+	ApprovalDecisionManual             ApprovalDecision = "manual"
+	ApprovalDecisionAutoApprovalFailed ApprovalDecision = "auto_approval_failed"
+)
+
 // CanEditDefinition checks if the spec definition can be edited.
 func (s *JobProposalSpec) CanEditDefinition() bool {
 	return s.Status == SpecStatusPending ||
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
@@ -23,6 +24,7 @@ type ORM interface {
 	ListManagers(ctx context.Context) (mgrs []FeedsManager, err error)
 	ListManagersByIDs(ctx context.Context, ids []int64) ([]FeedsManager, error)
 	UpdateManager(ctx context.Context, mgr FeedsManager) error
+	UpdateManagerApprovalPolicies(ctx context.Context, id int64, policies ApprovalPolicies) error
 	EnableManager(ctx context.Context, id int64) (*FeedsManager, error)
 	DisableManager(ctx context.Context, id int64) (*FeedsManager, error)
 
@@ -54,6 +56,7 @@ type ORM interface {
 	RejectSpec(ctx context.Context, id int64) error
 	RevokeSpec(ctx context.Context, id int64) error
 	UpdateSpecDefinition(ctx context.Context, id int64, spec string) error
+	UpdateSpecApprovalDecision(ctx context.Context, id int64, decision ApprovalDecision, policy null.String) error
 
 	IsJobManaged(ctx context.Context, jobID int64) (bool, error)
 
@@ -270,7 +273,7 @@ RETURNING id;
 // GetManager gets a feeds manager by id.
 func (o *orm) GetManager(ctx context.Context, id int64) (mgr *FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at, disabled_at
+SELECT id, name, uri, public_key, approval_policies, created_at, updated_at, disabled_at
 FROM feeds_managers
 WHERE id = $1
 `
@@ -283,7 +286,7 @@ WHERE id = $1
 // ListManager lists all feeds managers.
 func (o *orm) ListManagers(ctx context.Context) (mgrs []FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at, disabled_at
+SELECT id, name, uri, public_key, approval_policies, created_at, updated_at, disabled_at
 FROM feeds_managers
 ORDER BY created_at;
 `
@@ -295,7 +298,7 @@ ORDER BY created_at;
 // ListManagersByIDs gets feeds managers by ids.
 func (o *orm) ListManagersByIDs(ctx context.Context, ids []int64) (managers []FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at, disabled_at
+SELECT id, name, uri, public_key, approval_policies, created_at, updated_at, disabled_at
 FROM feeds_managers
 WHERE id = ANY($1)
 ORDER BY created_at, id;`
@@ -328,6 +331,28 @@ WHERE id = $4;
 	return nil
 }
 
+// UpdateManagerApprovalPolicies replaces the approval policies of a manager.
+func (o *orm) UpdateManagerApprovalPolicies(ctx context.Context, id int64, policies ApprovalPolicies) error {
+	stmt := `
+UPDATE feeds_managers
+SET approval_policies = $1, updated_at = NOW()
+WHERE id = $2;
+`
+
+	res, err := o.ds.ExecContext(ctx, stmt, policies, id)
+	if err != nil {
+		return errors.Wrap(err, "UpdateManagerApprovalPolicies failed to update feeds_managers")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "UpdateManagerApprovalPolicies failed to get RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) EnableManager(ctx context.Context, id int64) (*FeedsManager, error) {
 	stmt := `
 		UPDATE feeds_managers
@@ -614,7 +639,7 @@ SELECT exists (
 func (o *orm) DeleteProposal(ctx context.Context, id int64) error {
 	// Get the latest spec for the proposal.
 	stmt := `
-	SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+	SELECT id, definition, version, status, job_proposal_id, approval_decision, approval_policy, status_updated_at, created_at, updated_at
 FROM job_proposal_specs
 WHERE (job_proposal_id, version) IN
 (
@@ -661,7 +686,7 @@ WHERE id = $2;
 // GetSpec fetches the job proposal spec by id
 func (o *orm) GetSpec(ctx context.Context, id int64) (*JobProposalSpec, error) {
 	stmt := `
-SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+SELECT id, definition, version, status, job_proposal_id, approval_decision, approval_policy, status_updated_at, created_at, updated_at
 FROM job_proposal_specs
 WHERE id = $1;
 `
@@ -674,7 +699,7 @@ WHERE id = $1;
 // GetApprovedSpec gets the approved spec for a job proposal
 func (o *orm) GetApprovedSpec(ctx context.Context, jpID int64) (*JobProposalSpec, error) {
 	stmt := `
-SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+SELECT id, definition, version, status, job_proposal_id, approval_decision, approval_policy, status_updated_at, created_at, updated_at
 FROM job_proposal_specs
 WHERE status = $1
 AND job_proposal_id = $2
@@ -689,7 +714,7 @@ AND job_proposal_id = $2
 // GetLatestSpec gets the latest spec for a job proposal.
 func (o *orm) GetLatestSpec(ctx context.Context, jpID int64) (*JobProposalSpec, error) {
 	stmt := `
-	SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+	SELECT id, definition, version, status, job_proposal_id, approval_decision, approval_policy, status_updated_at, created_at, updated_at
 FROM job_proposal_specs
 WHERE (job_proposal_id, version) IN
 (
@@ -710,7 +735,7 @@ AND job_proposal_id = $1
 // ids.
 func (o *orm) ListSpecsByJobProposalIDs(ctx context.Context, ids []int64) ([]JobProposalSpec, error) {
 	stmt := `
-SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+SELECT id, definition, version, status, job_proposal_id, approval_decision, approval_policy, status_updated_at, created_at, updated_at
 FROM job_proposal_specs
 WHERE job_proposal_id = ANY($1)
 `
@@ -851,6 +876,34 @@ WHERE id = $2;
 	return nil
 }
 
+// UpdateSpecApprovalDecision records the outcome of evaluating the approval
+// policies for a job proposal spec.
+func (o *orm) UpdateSpecApprovalDecision(ctx context.Context, id int64, decision ApprovalDecision, policy null.String) error {
+	stmt := `
+UPDATE job_proposal_specs
+SET approval_decision = $1,
+	approval_policy = $2,
+	updated_at = NOW()
+WHERE id = $3;
+`
+
+	res, err := o.ds.ExecContext(ctx, stmt, decision, policy, id)
+	if err != nil {
+		return errors.Wrap(err, "UpdateSpecApprovalDecision failed to update job_proposal_specs")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "UpdateSpecApprovalDecision failed to get RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 // IsJobManaged determines if a job is managed by the feeds manager.
 func (o *orm) IsJobManaged(ctx context.Context, jobID int64) (exists bool, err error) {
 	stmt := `
@@ -253,6 +253,31 @@ func Test_ORM_EnableAndDisableManager(t *testing.T) {
 	require.Nil(t, mgr.DisabledAt)
 }
 
+func Test_ORM_UpdateManagerApprovalPolicies(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	var (
+		orm  = setupORM(t)
+		fmID = createFeedsManager(t, orm)
+	)
+
+	mgr, err := orm.GetManager(ctx, fmID)
+	require.NoError(t, err)
+	assert.Empty(t, mgr.ApprovalPolicies)
+
+	policies := feeds.ApprovalPolicies{
+		{Name: "fm", Action: feeds.ApprovalPolicyActionApprove, JobTypes: []string{"fluxmonitor"}},
+	}
+	require.NoError(t, orm.UpdateManagerApprovalPolicies(ctx, fmID, policies))
+
+	mgr, err = orm.GetManager(ctx, fmID)
+	require.NoError(t, err)
+	assert.Equal(t, policies, mgr.ApprovalPolicies)
+
+	require.Error(t, orm.UpdateManagerApprovalPolicies(ctx, fmID+1, policies))
+}
+
 // Chain Config
 
 func Test_ORM_CreateChainConfig(t *testing.T) {
@@ -1418,6 +1443,30 @@ func Test_ORM_GetSpec(t *testing.T) {
 	assert.Equal(t, jpID, actual.JobProposalID)
 }
 
+func Test_ORM_UpdateSpecApprovalDecision(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	var (
+		orm    = setupORM(t)
+		fmID   = createFeedsManager(t, orm)
+		jpID   = createJobProposal(t, orm, feeds.JobProposalStatusPending, fmID)
+		specID = createJobSpec(t, orm, jpID)
+	)
+
+	actual, err := orm.GetSpec(ctx, specID)
+	require.NoError(t, err)
+	assert.False(t, actual.ApprovalDecision.Valid)
+
+	err = orm.UpdateSpecApprovalDecision(ctx, specID, feeds.ApprovalDecisionAutoApproved, null.StringFrom("fm"))
+	require.NoError(t, err)
+
+	actual, err = orm.GetSpec(ctx, specID)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom(string(feeds.ApprovalDecisionAutoApproved)), actual.ApprovalDecision)
+	assert.Equal(t, null.StringFrom("fm"), actual.ApprovalPolicy)
+}
+
 func Test_ORM_GetApprovedSpec(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -97,6 +97,7 @@ type Service interface {
 	ListManagersByIDs(ctx context.Context, ids []int64) ([]FeedsManager, error)
 	RegisterManager(ctx context.Context, params RegisterManagerParams) (int64, error)
 	UpdateManager(ctx context.Context, mgr FeedsManager) error
+	UpdateManagerApprovalPolicies(ctx context.Context, id int64, policies ApprovalPolicies) error
 	EnableManager(ctx context.Context, id int64) (*FeedsManager, error)
 	DisableManager(ctx context.Context, id int64) (*FeedsManager, error)
 
@@ -369,6 +370,15 @@ func (s *service) UpdateManager(ctx context.Context, mgr FeedsManager) error {
 	return nil
 }
 
+// UpdateManagerApprovalPolicies validates and replaces the approval policies
+// of a feeds manager.
+func (s *service) UpdateManagerApprovalPolicies(ctx context.Context, id int64, policies ApprovalPolicies) error {
+	if err := policies.Validate(); err != nil {
+		return errors.Wrap(err, "invalid approval policies")
+	}
+	return errors.Wrap(s.orm.UpdateManagerApprovalPolicies(ctx, id, policies), "could not update manager approval policies")
+}
+
 func (s *service) EnableManager(ctx context.Context, id int64) (*FeedsManager, error) {
 	mgr, err := s.orm.EnableManager(ctx, id)
 	if err != nil || mgr == nil {
@@ -760,6 +770,8 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	} else {
 		// Track the given job proposal request
 		promJobProposalRequest.Inc()
+
+		s.autoApproveSpec(ctx, logger, args.FeedsManagerID, specID)
 	}
 
 	if err = s.observeJobProposalCounts(ctx); err != nil {
@@ -849,6 +861,13 @@ func (s *service) IsJobManaged(ctx context.Context, jobID int64) (bool, error) {
 // ApproveSpec approves a spec for a job proposal and creates a job with the
 // spec.
 func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
+	return s.approveSpec(ctx, id, force, false)
+}
+
+// approveSpec approves a spec like ApproveSpec. Without force, an existing job
+// is only replaced if replaceProposalJob is set and the job was created from
+// the previously approved spec of the same job proposal.
+func (s *service) approveSpec(ctx context.Context, id int64, force bool, replaceProposalJob bool) error {
 	spec, err := s.orm.GetSpec(ctx, id)
 	if err != nil {
 		return errors.Wrap(err, "orm: job proposal spec")
@@ -975,7 +994,16 @@ func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
 		if existingJobID != 0 {
 			// Do not proceed to remove the running job unless the force flag is true
 			if !force {
-				return ErrJobAlreadyExists
+				if !replaceProposalJob {
+					return ErrJobAlreadyExists
+				}
+				isProposalJob, perr := isJobOfApprovedSpec(ctx, tx, proposal, existingJobID)
+				if perr != nil {
+					return perr
+				}
+				if !isProposalJob {
+					return ErrJobAlreadyExists
+				}
 			}
 
 			// Check if the job is managed by FMS
@@ -1044,6 +1072,25 @@ func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
 	return nil
 }
 
+// isJobOfApprovedSpec returns whether the job was created from the approved
+// spec of the job proposal.
+func isJobOfApprovedSpec(ctx context.Context, tx datasources, proposal *JobProposal, jobID int32) (bool, error) {
+	if !proposal.ExternalJobID.Valid {
+		return false, nil
+	}
+	if _, err := tx.orm.GetApprovedSpec(ctx, proposal.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "GetApprovedSpec failed")
+	}
+	j, err := tx.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return false, errors.Wrap(err, "FindJob failed")
+	}
+	return j.ExternalJobID == proposal.ExternalJobID.UUID, nil
+}
+
 type datasources struct {
 	ds     sqlutil.DataSource
 	orm    ORM
@@ -1562,6 +1609,84 @@ func extractName(defn string) null.String {
 	return spec.Name
 }
 
+// autoApproveSpec approves a newly proposed spec if the first approval policy
+// of the feeds manager matching it allows it, and records the decision on the
+// spec. Specs are left pending for an operator on any error.
+func (s *service) autoApproveSpec(ctx context.Context, logger logger.Logger, mgrID int64, specID int64) {
+	mgr, err := s.orm.GetManager(ctx, mgrID)
+	if err != nil {
+		logger.Errorw("Failed to get feeds manager approval policies", "err", err)
+		return
+	}
+	if len(mgr.ApprovalPolicies) == 0 {
+		return
+	}
+
+	decision := ApprovalDecisionManual
+	var policyName null.String
+	policy, err := s.isAutoApprovable(ctx, mgr.ApprovalPolicies, specID)
+	if err != nil {
+		logger.Warnw("Spec cannot be auto-approved", "job_proposal_spec_id", specID, "err", err)
+	}
+	if policy != nil {
+		policyName = null.StringFrom(policy.Name)
+		if policy.Action == ApprovalPolicyActionApprove {
+			decision = ApprovalDecisionAutoApproved
+			// Auto-approval only replaces the job of the previously approved
+			// spec of the proposal, so that replacing any other running job is
+			// always reviewed by an operator.
+			if err = s.approveSpec(ctx, specID, false, true); errors.Is(err, ErrJobAlreadyExists) {
+				logger.Infow("Spec would replace a running job, leaving it for an operator", "job_proposal_spec_id", specID, "policy", policy.Name)
+				decision = ApprovalDecisionManual
+			} else if err != nil {
+				logger.Errorw("Failed to auto-approve spec", "job_proposal_spec_id", specID, "policy", policy.Name, "err", err)
+				decision = ApprovalDecisionAutoApprovalFailed
+			} else {
+				logger.Infow("Auto-approved spec", "job_proposal_spec_id", specID, "policy", policy.Name)
+			}
+		}
+	}
+
+	if err = s.orm.UpdateSpecApprovalDecision(ctx, specID, decision, policyName); err != nil {
+		logger.Errorw("Failed to record approval decision", "job_proposal_spec_id", specID, "err", err)
+	}
+}
+
+// isAutoApprovable returns the first of the policies matching a spec that
+// can be approved, or nil if none matches.
+func (s *service) isAutoApprovable(ctx context.Context, policies ApprovalPolicies, specID int64) (*ApprovalPolicy, error) {
+	spec, err := s.orm.GetSpec(ctx, specID)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: job proposal spec")
+	}
+	proposal, err := s.orm.GetJobProposal(ctx, spec.JobProposalID)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: job proposal")
+	}
+	if err = s.isApprovable(ctx, proposal.Status, proposal.ID, spec.Status, spec.ID); err != nil {
+		return nil, err
+	}
+
+	j, err := s.generateJob(ctx, spec.Definition)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate job from spec")
+	}
+
+	var approvedDefinition *string
+	approved, err := s.orm.GetApprovedSpec(ctx, proposal.ID)
+	if err == nil {
+		approvedDefinition = &approved.Definition
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "GetApprovedSpec failed")
+	}
+
+	proposed, err := newProposedSpec(j, spec.Definition, approvedDefinition)
+	if err != nil {
+		return nil, err
+	}
+	return policies.match(proposed), nil
+}
+
 // isApprovable returns nil if a spec can be approved based on the current
 // proposal and spec status, and if it can't be approved, the reason as an
 // error.
@@ -1736,6 +1861,9 @@ func (ns NullService) UpdateManager(ctx context.Context, mgr FeedsManager) error
 	return ErrFeedsManagerDisabled
 }
 
+func (ns NullService) UpdateManagerApprovalPolicies(ctx context.Context, id int64, policies ApprovalPolicies) error {
+	return ErrFeedsManagerDisabled
+}
 func (ns NullService) EnableManager(ctx context.Context, id int64) (*FeedsManager, error) {
 	return nil, ErrFeedsManagerDisabled
 }
@@ -8,6 +8,7 @@ import (
 	"maps"
 	"math/big"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -1148,6 +1149,40 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpFluxMonitor).Return(idFluxMonitor, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specFluxMonitor).Return(int64(100), nil)
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				svc.orm.On("GetManager", mock.Anything, argsFluxMonitor.FeedsManagerID).Return(&feeds.FeedsManager{ID: argsFluxMonitor.FeedsManagerID}, nil)
+				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
+				transactCall.Run(func(args mock.Arguments) {
+					fn := args[1].(func(orm feeds.ORM) error)
+					transactCall.ReturnArguments = mock.Arguments{fn(svc.orm)}
+				})
+			},
+			args:   argsFluxMonitor,
+			wantID: idFluxMonitor,
+		},
+		{
+			name: "Create success (Flux Monitor) with approval policies",
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, jpFluxMonitor.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
+				svc.orm.On("UpsertJobProposal", mock.Anything, &jpFluxMonitor).Return(idFluxMonitor, nil)
+				svc.orm.On("CreateSpec", mock.Anything, specFluxMonitor).Return(int64(100), nil)
+				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				svc.orm.On("GetManager", mock.Anything, argsFluxMonitor.FeedsManagerID).Return(&feeds.FeedsManager{
+					ID: argsFluxMonitor.FeedsManagerID,
+					ApprovalPolicies: feeds.ApprovalPolicies{
+						{Name: "fm", Action: feeds.ApprovalPolicyActionApprove, JobTypes: []string{"fluxmonitor"}},
+					},
+				}, nil)
+				svc.orm.On("GetSpec", mock.Anything, int64(100)).Return(&feeds.JobProposalSpec{
+					ID:            100,
+					JobProposalID: idFluxMonitor,
+					Status:        feeds.SpecStatusPending,
+				}, nil)
+				svc.orm.On("GetJobProposal", mock.Anything, idFluxMonitor).Return(&feeds.JobProposal{
+					ID:     idFluxMonitor,
+					Status: feeds.JobProposalStatusDeleted,
+				}, nil)
+				// A spec that cannot be approved is left for the operator
+				svc.orm.On("UpdateSpecApprovalDecision", mock.Anything, int64(100), feeds.ApprovalDecisionManual, null.String{}).Return(nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
 					fn := args[1].(func(orm feeds.ORM) error)
@@ -1164,6 +1199,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpOCR1).Return(idOCR1, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specOCR1).Return(int64(100), nil)
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				svc.orm.On("GetManager", mock.Anything, argsOCR1.FeedsManagerID).Return(&feeds.FeedsManager{ID: argsOCR1.FeedsManagerID}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
 					fn := args[1].(func(orm feeds.ORM) error)
@@ -1180,6 +1216,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpOCR2).Return(idOCR2, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specOCR2).Return(int64(100), nil)
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				svc.orm.On("GetManager", mock.Anything, argsOCR2.FeedsManagerID).Return(&feeds.FeedsManager{ID: argsOCR2.FeedsManagerID}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
 					fn := args[1].(func(orm feeds.ORM) error)
@@ -1196,6 +1233,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpBootstrap).Return(idBootstrap, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specBootstrap).Return(int64(102), nil)
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				svc.orm.On("GetManager", mock.Anything, argsBootstrap.FeedsManagerID).Return(&feeds.FeedsManager{ID: argsBootstrap.FeedsManagerID}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
 					fn := args[1].(func(orm feeds.ORM) error)
@@ -1219,6 +1257,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("UpsertJobProposal", mock.Anything, &jpFluxMonitor).Return(idFluxMonitor, nil)
 				svc.orm.On("CreateSpec", mock.Anything, specFluxMonitor).Return(int64(100), nil)
 				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				svc.orm.On("GetManager", mock.Anything, argsFluxMonitor.FeedsManagerID).Return(&feeds.FeedsManager{ID: argsFluxMonitor.FeedsManagerID}, nil)
 				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
 				transactCall.Run(func(args mock.Arguments) {
 					fn := args[1].(func(orm feeds.ORM) error)
@@ -1228,6 +1267,74 @@ func Test_Service_ProposeJob(t *testing.T) {
 			args:   argsFluxMonitor,
 			wantID: idFluxMonitor,
 		},
+		{
+			name: "Update of a running job auto-approved",
+			before: func(svc *TestService) {
+				externalJobID := uuid.NullUUID{UUID: nameAndExternalJobID, Valid: true}
+				runningJob := job.Job{ID: 5, ExternalJobID: nameAndExternalJobID}
+				approvedSpec := &feeds.JobProposalSpec{
+					ID:            99,
+					Definition:    strings.Replace(spec, "threshold = 0.5", "threshold = 0.4", 1),
+					Status:        feeds.SpecStatusApproved,
+					Version:       0,
+					JobProposalID: idFluxMonitor,
+				}
+				svc.orm.
+					On("GetJobProposalByRemoteUUID", mock.Anything, jpFluxMonitor.RemoteUUID).
+					Return(&feeds.JobProposal{
+						ID:             idFluxMonitor,
+						FeedsManagerID: jpFluxMonitor.FeedsManagerID,
+						RemoteUUID:     jpFluxMonitor.RemoteUUID,
+						ExternalJobID:  externalJobID,
+						Status:         feeds.JobProposalStatusApproved,
+					}, nil)
+				svc.orm.On("ExistsSpecByJobProposalIDAndVersion", mock.Anything, idFluxMonitor, argsFluxMonitor.Version).Return(false, nil)
+				svc.orm.On("UpsertJobProposal", mock.Anything, &jpFluxMonitor).Return(idFluxMonitor, nil)
+				svc.orm.On("CreateSpec", mock.Anything, specFluxMonitor).Return(int64(100), nil)
+				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				svc.orm.On("GetManager", mock.Anything, argsFluxMonitor.FeedsManagerID).Return(&feeds.FeedsManager{
+					ID: argsFluxMonitor.FeedsManagerID,
+					ApprovalPolicies: feeds.ApprovalPolicies{
+						{Name: "fm-threshold", Action: feeds.ApprovalPolicyActionApprove, ProposalKind: feeds.ProposalKindUpdate, ChangedFields: []string{"threshold"}},
+					},
+				}, nil)
+				pendingSpec := specFluxMonitor
+				pendingSpec.ID = 100
+				svc.orm.On("GetSpec", mock.Anything, int64(100)).Return(&pendingSpec, nil)
+				svc.orm.On("GetJobProposal", mock.Anything, idFluxMonitor).Return(&feeds.JobProposal{
+					ID:             idFluxMonitor,
+					FeedsManagerID: jpFluxMonitor.FeedsManagerID,
+					RemoteUUID:     jpFluxMonitor.RemoteUUID,
+					ExternalJobID:  externalJobID,
+					Status:         feeds.JobProposalStatusApproved,
+				}, nil)
+				svc.orm.On("GetApprovedSpec", mock.Anything, idFluxMonitor).Return(approvedSpec, nil)
+				transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
+				transactCall.Run(func(args mock.Arguments) {
+					fn := args[1].(func(orm feeds.ORM) error)
+					transactCall.ReturnArguments = mock.Arguments{fn(svc.orm)}
+				})
+
+				// The update replaces the job created from the approved spec
+				svc.connMgr.On("GetClient", argsFluxMonitor.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.jobORM.On("AssertBridgesExist", mock.Anything, mock.IsType(pipeline.Pipeline{})).Return(nil)
+				svc.orm.On("WithDataSource", mock.Anything).Return(feeds.ORM(svc.orm))
+				svc.jobORM.On("WithDataSource", mock.Anything).Return(job.ORM(svc.jobORM))
+				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, nameAndExternalJobID).Return(runningJob, nil)
+				svc.jobORM.On("FindJob", mock.Anything, runningJob.ID).Return(runningJob, nil)
+				svc.orm.On("CancelSpec", mock.Anything, approvedSpec.ID).Return(nil)
+				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, runningJob.ID).Return(nil)
+				svc.spawner.On("CreateJob", mock.Anything, mock.Anything, mock.IsType(&job.Job{})).Return(nil)
+				svc.orm.On("ApproveSpec", mock.Anything, int64(100), nameAndExternalJobID).Return(nil)
+				svc.fmsClient.On("ApprovedJob", mock.Anything, &proto.ApprovedJobRequest{
+					Uuid:    jpFluxMonitor.RemoteUUID.String(),
+					Version: int64(argsFluxMonitor.Version),
+				}).Return(&proto.ApprovedJobResponse{}, nil)
+				svc.orm.On("UpdateSpecApprovalDecision", mock.Anything, int64(100), feeds.ApprovalDecisionAutoApproved, null.StringFrom("fm-threshold")).Return(nil)
+			},
+			args:   argsFluxMonitor,
+			wantID: idFluxMonitor,
+		},
 		{
 			name:    "contains invalid job spec",
 			args:    &feeds.ProposeJobArgs{},
@@ -1,6 +1,9 @@
 package fluxmonitorv2
 
 import (
+	"math"
+	"sync"
+
 	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -10,6 +13,25 @@ import (
 type DeviationThresholds struct {
 	Rel float64 // Relative change required, i.e. |new-old|/|old| >= Rel
 	Abs float64 // Absolute change required, i.e. |new-old| >= Abs
+
+	// RelUp and RelDown override Rel for increases and decreases of the
+	// answer respectively, if non-zero
+	RelUp   float64
+	RelDown float64
+	// AnyThreshold triggers a submission when either of the relative and
+	// absolute thresholds is met, rather than both
+	AnyThreshold bool
+	// VolatilityWindow is the number of recently submitted answers used to
+	// compute the volatility of the feed. If non-zero, the relative threshold is raised
+	// to VolatilityMultiplier times the standard deviation of the relative
+	// changes between them, in percent.
+	VolatilityWindow     int
+	VolatilityMultiplier float64
+}
+
+func (t DeviationThresholds) isZero() bool {
+	return t.Rel == 0 && t.Abs == 0 && t.RelUp == 0 && t.RelDown == 0 &&
+		(t.VolatilityWindow == 0 || t.VolatilityMultiplier == 0)
 }
 
 // DeviationChecker checks the deviation of the next answer against the current
@@ -17,16 +39,32 @@ type DeviationThresholds struct {
 type DeviationChecker struct {
 	Thresholds DeviationThresholds
 	lggr       logger.Logger
+
+	mu sync.Mutex
+	// recently submitted answers, oldest first, if VolatilityWindow is set
+	window []decimal.Decimal
 }
 
 // NewDeviationChecker constructs a new deviation checker with thresholds.
 func NewDeviationChecker(rel, abs float64, lggr logger.Logger) *DeviationChecker {
+	return NewDeviationCheckerWithThresholds(DeviationThresholds{
+		Rel: rel,
+		Abs: abs,
+	}, lggr)
+}
+
+// NewDeviationCheckerWithThresholds constructs a new deviation checker with
+// composite thresholds.
+func NewDeviationCheckerWithThresholds(thresholds DeviationThresholds, lggr logger.Logger) *DeviationChecker {
 	return &DeviationChecker{
-		Thresholds: DeviationThresholds{
-			Rel: rel,
-			Abs: abs,
-		},
-		lggr: logger.Sugared(lggr).Named("DeviationChecker").With("threshold", rel, "absoluteThreshold", abs),
+		Thresholds: thresholds,
+		lggr: logger.Sugared(lggr).Named("DeviationChecker").With(
+			"threshold", thresholds.Rel,
+			"absoluteThreshold", thresholds.Abs,
+			"thresholdUp", thresholds.RelUp,
+			"thresholdDown", thresholds.RelDown,
+			"anyThreshold", thresholds.AnyThreshold,
+		),
 	}
 }
 
@@ -43,16 +81,30 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 		"nextAnswer", nextAnswer,
 	}
 
-	if c.Thresholds.Rel == 0 && c.Thresholds.Abs == 0 {
+	if c.Thresholds.isZero() {
 		c.lggr.Debugw(
 			"Deviation thresholds both zero; short-circuiting deviation checker to "+
 				"true, regardless of feed values", loggerFields...)
 		return true
 	}
+
+	rel := c.relativeThreshold(curAnswer, nextAnswer)
+	loggerFields = append(loggerFields, "relativeThreshold", rel)
+
 	diff := curAnswer.Sub(nextAnswer).Abs()
 	loggerFields = append(loggerFields, "absoluteDeviation", diff)
 
-	if !diff.GreaterThan(decimal.NewFromFloat(c.Thresholds.Abs)) {
+	absMet := diff.GreaterThan(decimal.NewFromFloat(c.Thresholds.Abs))
+	if c.Thresholds.AnyThreshold {
+		if c.Thresholds.Abs > 0 && absMet {
+			c.lggr.Infow("Threshold met: absolute deviation", loggerFields...)
+			return true
+		}
+		if rel == 0 {
+			c.lggr.Debugw("Absolute deviation threshold not met", loggerFields...)
+			return false
+		}
+	} else if !absMet {
 		c.lggr.Debugw("Absolute deviation threshold not met", loggerFields...)
 		return false
 	}
@@ -71,10 +123,81 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 
 	loggerFields = append(loggerFields, "percentage", percentage)
 
-	if percentage.LessThan(decimal.NewFromFloat(c.Thresholds.Rel)) {
+	if percentage.LessThan(decimal.NewFromFloat(rel)) {
 		c.lggr.Debugw("Relative deviation threshold not met", loggerFields...)
 		return false
 	}
-	c.lggr.Infow("Relative and absolute deviation thresholds both met", loggerFields...)
+	if c.Thresholds.AnyThreshold {
+		c.lggr.Infow("Threshold met: relative deviation", loggerFields...)
+	} else {
+		c.lggr.Infow("Relative and absolute deviation thresholds both met", loggerFields...)
+	}
 	return true
 }
+
+// relativeThreshold returns the relative threshold for a change from
+// curAnswer to nextAnswer, taking direction and volatility into account
+func (c *DeviationChecker) relativeThreshold(curAnswer, nextAnswer decimal.Decimal) float64 {
+	rel := c.Thresholds.Rel
+	switch {
+	case nextAnswer.GreaterThan(curAnswer) && c.Thresholds.RelUp > 0:
+		rel = c.Thresholds.RelUp
+	case nextAnswer.LessThan(curAnswer) && c.Thresholds.RelDown > 0:
+		rel = c.Thresholds.RelDown
+	}
+	if c.Thresholds.VolatilityMultiplier > 0 {
+		rel = math.Max(rel, c.Thresholds.VolatilityMultiplier*c.volatility())
+	}
+	return rel
+}
+
+// volatilityEnabled returns whether the relative threshold is scaled with
+// the volatility of the feed
+func (c *DeviationChecker) volatilityEnabled() bool {
+	return c != nil && c.Thresholds.VolatilityWindow > 0 && c.Thresholds.VolatilityMultiplier > 0
+}
+
+// Observe adds a submitted answer to the volatility window. Answers that were
+// only checked but not submitted must not be observed, as polling more often
+// would otherwise make the feed look less volatile.
+func (c *DeviationChecker) Observe(answer decimal.Decimal) {
+	if !c.volatilityEnabled() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.window = append(c.window, answer)
+	if len(c.window) > c.Thresholds.VolatilityWindow {
+		c.window = c.window[len(c.window)-c.Thresholds.VolatilityWindow:]
+	}
+}
+
+// volatility returns the standard deviation of the relative changes between
+// the answers in the window, in percent
+func (c *DeviationChecker) volatility() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var changes []float64
+	for i := 1; i < len(c.window); i++ {
+		prev := c.window[i-1]
+		if prev.IsZero() {
+			continue
+		}
+		change, _ := c.window[i].Sub(prev).Div(prev.Abs()).Mul(decimal.NewFromInt(100)).Float64()
+		changes = append(changes, change)
+	}
+	if len(changes) < 2 {
+		return 0
+	}
+	var mean float64
+	for _, ch := range changes {
+		mean += ch
+	}
+	mean /= float64(len(changes))
+	var variance float64
+	for _, ch := range changes {
+		variance += (ch - mean) * (ch - mean)
+	}
+	variance /= float64(len(changes))
+	return math.Sqrt(variance)
+}
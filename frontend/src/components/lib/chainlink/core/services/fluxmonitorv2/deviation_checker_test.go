@@ -81,3 +81,89 @@ func TestDeviationChecker_OutsideDeviation(t *testing.T) {
 		t.Run(tc.name+" max absolute threshold", func(t *testing.T) { c(test3) })
 	}
 }
+
+func TestDeviationChecker_CompositeThresholds(t *testing.T) {
+	t.Parallel()
+
+	i := decimal.NewFromInt
+	lggr := logger.TestLogger(t)
+
+	t.Run("asymmetric thresholds", func(t *testing.T) {
+		checker := fluxmonitorv2.NewDeviationCheckerWithThresholds(fluxmonitorv2.DeviationThresholds{
+			Rel:     5,
+			RelUp:   1,
+			RelDown: 3,
+		}, lggr)
+		assert.True(t, checker.OutsideDeviation(i(100), i(101)))
+		assert.False(t, checker.OutsideDeviation(i(100), i(98)))
+		assert.True(t, checker.OutsideDeviation(i(100), i(97)))
+	})
+
+	t.Run("asymmetric threshold falls back to relative threshold", func(t *testing.T) {
+		checker := fluxmonitorv2.NewDeviationCheckerWithThresholds(fluxmonitorv2.DeviationThresholds{
+			Rel:   5,
+			RelUp: 1,
+		}, lggr)
+		assert.False(t, checker.OutsideDeviation(i(100), i(96)))
+		assert.True(t, checker.OutsideDeviation(i(100), i(95)))
+	})
+
+	t.Run("any threshold", func(t *testing.T) {
+		checker := fluxmonitorv2.NewDeviationCheckerWithThresholds(fluxmonitorv2.DeviationThresholds{
+			Rel:          10,
+			Abs:          5,
+			AnyThreshold: true,
+		}, lggr)
+		// absolute threshold met, relative not
+		assert.True(t, checker.OutsideDeviation(i(1000), i(1006)))
+		// relative threshold met, absolute not
+		assert.True(t, checker.OutsideDeviation(i(10), i(12)))
+		// neither met
+		assert.False(t, checker.OutsideDeviation(i(1000), i(1004)))
+
+		// all threshold requires both
+		checker = fluxmonitorv2.NewDeviationChecker(10, 5, lggr)
+		assert.False(t, checker.OutsideDeviation(i(1000), i(1006)))
+		assert.False(t, checker.OutsideDeviation(i(10), i(12)))
+		assert.True(t, checker.OutsideDeviation(i(10), i(16)))
+	})
+
+	t.Run("any threshold with a single threshold", func(t *testing.T) {
+		checker := fluxmonitorv2.NewDeviationCheckerWithThresholds(fluxmonitorv2.DeviationThresholds{
+			Rel:          1,
+			AnyThreshold: true,
+		}, lggr)
+		assert.False(t, checker.OutsideDeviation(i(1000), i(1005)))
+		assert.True(t, checker.OutsideDeviation(i(1000), i(1010)))
+	})
+
+	t.Run("volatility scaled threshold", func(t *testing.T) {
+		checker := fluxmonitorv2.NewDeviationCheckerWithThresholds(fluxmonitorv2.DeviationThresholds{
+			Rel:                  1,
+			VolatilityWindow:     5,
+			VolatilityMultiplier: 2,
+		}, lggr)
+		// without history, the configured threshold applies
+		assert.True(t, checker.OutsideDeviation(i(100), i(102)))
+
+		// alternating +10% / -10% moves make the feed volatile, raising
+		// the threshold to 2*stddev, i.e. about 20%
+		for _, a := range []int64{100, 110, 99, 109, 98} {
+			checker.Observe(i(a))
+		}
+		assert.False(t, checker.OutsideDeviation(i(100), i(110)))
+		assert.True(t, checker.OutsideDeviation(i(100), i(130)))
+	})
+
+	t.Run("volatility is only computed from observed answers", func(t *testing.T) {
+		checker := fluxmonitorv2.NewDeviationCheckerWithThresholds(fluxmonitorv2.DeviationThresholds{
+			Rel:                  1,
+			VolatilityWindow:     5,
+			VolatilityMultiplier: 2,
+		}, lggr)
+		for _, a := range []int64{110, 99, 109, 98} {
+			checker.OutsideDeviation(i(100), i(a))
+		}
+		assert.True(t, checker.OutsideDeviation(i(100), i(110)))
+	})
+}
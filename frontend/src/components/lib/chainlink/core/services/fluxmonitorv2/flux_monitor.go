@@ -76,6 +76,7 @@ type FluxMonitor struct {
 	contractSubmitter ContractSubmitter
 	deviationChecker  *DeviationChecker
 	submissionChecker *SubmissionChecker
+	submissionBudget  *SubmissionBudget
 	flags             Flags
 	fluxAggregator    flux_aggregator_wrapper.FluxAggregatorInterface
 	logBroadcaster    log.Broadcaster
@@ -101,6 +102,7 @@ func NewFluxMonitor(
 	contractSubmitter ContractSubmitter,
 	deviationChecker *DeviationChecker,
 	submissionChecker *SubmissionChecker,
+	submissionBudget *SubmissionBudget,
 	flags Flags,
 	fluxAggregator flux_aggregator_wrapper.FluxAggregatorInterface,
 	logBroadcaster log.Broadcaster,
@@ -122,6 +124,7 @@ func NewFluxMonitor(
 		contractSubmitter: contractSubmitter,
 		deviationChecker:  deviationChecker,
 		submissionChecker: submissionChecker,
+		submissionBudget:  submissionBudget,
 		flags:             flags,
 		logBroadcaster:    logBroadcaster,
 		fluxAggregator:    fluxAggregator,
@@ -256,12 +259,17 @@ func NewFromJobSpec(
 		paymentChecker,
 		fmSpec.ContractAddress.Address(),
 		contractSubmitter,
-		NewDeviationChecker(
-			float64(fmSpec.Threshold),
-			float64(fmSpec.AbsoluteThreshold),
-			fmLogger,
-		),
+		NewDeviationCheckerWithThresholds(DeviationThresholds{
+			Rel:                  float64(fmSpec.Threshold),
+			Abs:                  float64(fmSpec.AbsoluteThreshold),
+			RelUp:                float64(fmSpec.ThresholdUp),
+			RelDown:              float64(fmSpec.ThresholdDown),
+			AnyThreshold:         fmSpec.DeviationMode == job.FluxMonitorDeviationModeAny,
+			VolatilityWindow:     int(fmSpec.VolatilityWindow),
+			VolatilityMultiplier: float64(fmSpec.VolatilityMultiplier),
+		}, fmLogger),
 		NewSubmissionChecker(min, max),
+		NewSubmissionBudget(int(fmSpec.SubmissionBudget), fmSpec.SubmissionBudgetPeriod),
 		flags,
 		fluxAggregator,
 		logBroadcaster,
@@ -278,11 +286,28 @@ const (
 
 // Start implements the job.Service interface. It begins the CSP consumer in a
 // single goroutine to poll the price adapters and listen to NewRound events.
-func (fm *FluxMonitor) start(context.Context) error {
+func (fm *FluxMonitor) start(ctx context.Context) error {
+	fm.seedVolatilityWindow(ctx)
 	fm.eng.Go(fm.consume)
 	return nil
 }
 
+// seedVolatilityWindow loads the answers submitted before a restart into the
+// deviation checker, so that volatility scaled thresholds apply immediately
+func (fm *FluxMonitor) seedVolatilityWindow(ctx context.Context) {
+	if !fm.deviationChecker.volatilityEnabled() {
+		return
+	}
+	answers, err := fm.orm.RecentSubmittedAnswers(ctx, fm.contractAddress, fm.deviationChecker.Thresholds.VolatilityWindow)
+	if err != nil {
+		fm.logger.Warnw("unable to load recent submissions, volatility window starts empty", "err", err)
+		return
+	}
+	for _, answer := range answers {
+		fm.deviationChecker.Observe(answer)
+	}
+}
+
 func (fm *FluxMonitor) IsHibernating() bool {
 	if !fm.flags.ContractExists() {
 		return false
@@ -768,6 +793,7 @@ func (fm *FluxMonitor) respondToNewRoundLog(ctx context.Context, log flux_aggreg
 		newRoundLogger.Errorf("unable to create job run: %v", err)
 		return
 	}
+	fm.deviationChecker.Observe(answer)
 }
 
 func (fm *FluxMonitor) Transact(ctx context.Context, fn func(sqlutil.DataSource) error) error {
@@ -969,6 +995,17 @@ func (fm *FluxMonitor) pollIfEligible(ctx context.Context, pollReq PollRequestTy
 		return
 	}
 
+	// heartbeats are neither limited by nor counted against the budget, so
+	// that feeds are kept alive while it is exhausted
+	heartbeat := pollReq == PollRequestTypeIdle || pollReq == PollRequestTypeDrumbeat
+	if !heartbeat && !fm.submissionBudget.Allow() {
+		l.Warnw("submission budget exhausted, not submitting",
+			"submissionBudget", fm.submissionBudget.max,
+			"submissionBudgetPeriod", fm.submissionBudget.period,
+		)
+		return
+	}
+
 	if roundState.RoundId > 1 {
 		l.Infow("deviation > threshold, submitting")
 	} else {
@@ -998,6 +1035,10 @@ func (fm *FluxMonitor) pollIfEligible(ctx context.Context, pollReq PollRequestTy
 		l.Errorw("can't create job run", "err", err)
 		return
 	}
+	if !heartbeat {
+		fm.submissionBudget.Record()
+	}
+	fm.deviationChecker.Observe(answer)
 
 	promfm.SetDecimal(promfm.ReportedValue.WithLabelValues(jobID), answer)
 	promfm.SetUint32(promfm.ReportedRound.WithLabelValues(jobID), roundState.RoundId)
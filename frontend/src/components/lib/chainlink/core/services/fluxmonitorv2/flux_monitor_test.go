@@ -200,6 +200,7 @@ func setup(t *testing.T, ds sqlutil.DataSource, optionFns ...func(*setupOptions)
 		tm.contractSubmitter,
 		fluxmonitorv2.NewDeviationChecker(threshold, absoluteThreshold, lggr),
 		fluxmonitorv2.NewSubmissionChecker(big.NewInt(0), big.NewInt(100000000000)),
+		nil,
 		options.flags,
 		tm.fluxAggregator,
 		tm.logBroadcaster,
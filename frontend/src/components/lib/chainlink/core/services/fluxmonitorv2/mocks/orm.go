@@ -9,6 +9,8 @@ import (
 
 	fluxmonitorv2 "github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 
+	decimal "github.com/shopspring/decimal"
+
 	mock "github.com/stretchr/testify/mock"
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -298,6 +300,66 @@ func (_c *ORM_MostRecentFluxMonitorRoundID_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// RecentSubmittedAnswers provides a mock function with given fields: ctx, aggregator, limit
+func (_m *ORM) RecentSubmittedAnswers(ctx context.Context, aggregator common.Address, limit int) ([]decimal.Decimal, error) {
+	ret := _m.Called(ctx, aggregator, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecentSubmittedAnswers")
+	}
+
+	var r0 []decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, int) ([]decimal.Decimal, error)); ok {
+		return rf(ctx, aggregator, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, int) []decimal.Decimal); ok {
+		r0 = rf(ctx, aggregator, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]decimal.Decimal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, int) error); ok {
+		r1 = rf(ctx, aggregator, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_RecentSubmittedAnswers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecentSubmittedAnswers'
+type ORM_RecentSubmittedAnswers_Call struct {
+	*mock.Call
+}
+
+// RecentSubmittedAnswers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - aggregator common.Address
+//   - limit int
+func (_e *ORM_Expecter) RecentSubmittedAnswers(ctx interface{}, aggregator interface{}, limit interface{}) *ORM_RecentSubmittedAnswers_Call {
+	return &ORM_RecentSubmittedAnswers_Call{Call: _e.mock.On("RecentSubmittedAnswers", ctx, aggregator, limit)}
+}
+
+func (_c *ORM_RecentSubmittedAnswers_Call) Run(run func(ctx context.Context, aggregator common.Address, limit int)) *ORM_RecentSubmittedAnswers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_RecentSubmittedAnswers_Call) Return(_a0 []decimal.Decimal, _a1 error) *ORM_RecentSubmittedAnswers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_RecentSubmittedAnswers_Call) RunAndReturn(run func(context.Context, common.Address, int) ([]decimal.Decimal, error)) *ORM_RecentSubmittedAnswers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateFluxMonitorRoundStats provides a mock function with given fields: ctx, aggregator, roundID, runID, newRoundLogsAddition
 func (_m *ORM) UpdateFluxMonitorRoundStats(ctx context.Context, aggregator common.Address, roundID uint32, runID int64, newRoundLogsAddition uint) error {
 	ret := _m.Called(ctx, aggregator, roundID, runID, newRoundLogsAddition)
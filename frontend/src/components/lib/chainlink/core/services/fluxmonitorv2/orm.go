@@ -6,11 +6,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type transmitter interface {
@@ -25,6 +28,7 @@ type ORM interface {
 	UpdateFluxMonitorRoundStats(ctx context.Context, aggregator common.Address, roundID uint32, runID int64, newRoundLogsAddition uint) error
 	CreateEthTransaction(ctx context.Context, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, idempotencyKey *string) error
 	CountFluxMonitorRoundStats(ctx context.Context) (count int, err error)
+	RecentSubmittedAnswers(ctx context.Context, aggregator common.Address, limit int) ([]decimal.Decimal, error)
 
 	WithDataSource(sqlutil.DataSource) ORM
 }
@@ -109,6 +113,37 @@ func (o *orm) CountFluxMonitorRoundStats(ctx context.Context) (count int, err er
 	return count, errors.Wrap(err, "CountFluxMonitorRoundStats failed")
 }
 
+// RecentSubmittedAnswers returns the answers of up to limit of the most
+// recent rounds this node submitted to, oldest first
+func (o *orm) RecentSubmittedAnswers(ctx context.Context, aggregator common.Address, limit int) ([]decimal.Decimal, error) {
+	var outputs []jsonserializable.JSONSerializable
+	err := o.ds.SelectContext(ctx, &outputs, `
+        SELECT pipeline_runs.outputs FROM flux_monitor_round_stats_v2
+        JOIN pipeline_runs ON pipeline_runs.id = flux_monitor_round_stats_v2.pipeline_run_id
+        WHERE flux_monitor_round_stats_v2.aggregator = $1
+          AND flux_monitor_round_stats_v2.num_submissions > 0
+        ORDER BY flux_monitor_round_stats_v2.round_id DESC
+        LIMIT $2
+    `, aggregator, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "RecentSubmittedAnswers failed")
+	}
+
+	answers := make([]decimal.Decimal, 0, len(outputs))
+	for i := len(outputs) - 1; i >= 0; i-- {
+		vals, ok := outputs[i].Val.([]interface{})
+		if !outputs[i].Valid || !ok || len(vals) != 1 {
+			continue
+		}
+		answer, err := utils.ToDecimal(vals[0])
+		if err != nil {
+			continue
+		}
+		answers = append(answers, answer)
+	}
+	return answers, nil
+}
+
 // CreateEthTransaction creates an ethereum transaction for the Txm to pick up
 func (o *orm) CreateEthTransaction(
 	ctx context.Context,
@@ -146,6 +146,52 @@ func TestORM_UpdateFluxMonitorRoundStats(t *testing.T) {
 	}
 }
 
+func TestORM_RecentSubmittedAnswers(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+
+	cfg := configtest.NewGeneralConfig(t, nil)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db)
+	lggr := logger.TestLogger(t)
+
+	pipelineORM := pipeline.NewORM(db, lggr, cfg.JobPipeline().MaxSuccessfulRuns())
+	bridgeORM := bridges.NewORM(db)
+	jobORM := job.NewORM(db, pipelineORM, bridgeORM, keyStore, lggr)
+	orm := newORM(t, db, nil)
+
+	address := testutils.NewAddress()
+	jb := makeJob(t)
+	require.NoError(t, jobORM.CreateJob(ctx, jb))
+
+	for roundID, answer := range []string{"100", "110", "120"} {
+		f := time.Now()
+		run := &pipeline.Run{
+			State:          pipeline.RunStatusCompleted,
+			PipelineSpecID: jb.PipelineSpec.ID,
+			PruningKey:     jb.ID,
+			PipelineSpec:   *jb.PipelineSpec,
+			CreatedAt:      f,
+			FinishedAt:     null.TimeFrom(f),
+			AllErrors:      pipeline.RunErrors{null.String{}},
+			FatalErrors:    pipeline.RunErrors{null.String{}},
+			Outputs:        jsonserializable.JSONSerializable{Val: []interface{}{answer}, Valid: true},
+		}
+		require.NoError(t, pipelineORM.InsertFinishedRun(ctx, run, true))
+		require.NoError(t, orm.UpdateFluxMonitorRoundStats(ctx, address, uint32(roundID+1), run.ID, 0))
+	}
+	// rounds without a submission are skipped
+	_, err := orm.FindOrCreateFluxMonitorRoundStats(ctx, address, 4, 1)
+	require.NoError(t, err)
+
+	answers, err := orm.RecentSubmittedAnswers(ctx, address, 2)
+	require.NoError(t, err)
+	require.Len(t, answers, 2)
+	require.Equal(t, "110", answers[0].String())
+	require.Equal(t, "120", answers[1].String())
+}
+
 func makeJob(t *testing.T) *job.Job {
 	t.Helper()
 
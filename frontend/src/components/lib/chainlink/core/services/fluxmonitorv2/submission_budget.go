@@ -0,0 +1,63 @@
+package fluxmonitorv2
+
+import (
+	"sync"
+	"time"
+)
+
+// SubmissionBudget limits the number of rounds a node starts through polling
+// within a rolling period. Responses to rounds started by other nodes and
+// heartbeats are not limited.
+type SubmissionBudget struct {
+	max    int
+	period time.Duration
+	now    func() time.Time
+
+	mu          sync.Mutex
+	submissions []time.Time
+}
+
+// NewSubmissionBudget constructs a new submission budget allowing max
+// submissions per period. A zero max or period disables the budget.
+func NewSubmissionBudget(max int, period time.Duration) *SubmissionBudget {
+	return &SubmissionBudget{
+		max:    max,
+		period: period,
+		now:    time.Now,
+	}
+}
+
+func (b *SubmissionBudget) enabled() bool {
+	return b != nil && b.max > 0 && b.period > 0
+}
+
+// Allow returns whether another submission is within the budget
+func (b *SubmissionBudget) Allow() bool {
+	if !b.enabled() {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pruneLocked()
+	return len(b.submissions) < b.max
+}
+
+// Record records a submission against the budget
+func (b *SubmissionBudget) Record() {
+	if !b.enabled() {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pruneLocked()
+	b.submissions = append(b.submissions, b.now())
+}
+
+func (b *SubmissionBudget) pruneLocked() {
+	cutoff := b.now().Add(-b.period)
+	i := 0
+	for i < len(b.submissions) && !b.submissions[i].After(cutoff) {
+		i++
+	}
+	b.submissions = b.submissions[i:]
+}
@@ -0,0 +1,40 @@
+package fluxmonitorv2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmissionBudget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("limits submissions within the period", func(t *testing.T) {
+		now := time.Now()
+		b := NewSubmissionBudget(2, time.Hour)
+		b.now = func() time.Time { return now }
+
+		assert.True(t, b.Allow())
+		b.Record()
+		now = now.Add(10 * time.Minute)
+		assert.True(t, b.Allow())
+		b.Record()
+		assert.False(t, b.Allow())
+
+		// the first submission leaves the period
+		now = now.Add(50 * time.Minute)
+		assert.True(t, b.Allow())
+		b.Record()
+		assert.False(t, b.Allow())
+	})
+
+	t.Run("disabled budgets allow all submissions", func(t *testing.T) {
+		for _, b := range []*SubmissionBudget{nil, NewSubmissionBudget(0, time.Hour), NewSubmissionBudget(1, 0)} {
+			for range 3 {
+				assert.True(t, b.Allow())
+				b.Record()
+			}
+		}
+	})
+}
@@ -71,6 +71,10 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 		}
 	}
 
+	if err = validateDeviationRules(jb.FluxMonitorSpec); err != nil {
+		return jb, err
+	}
+
 	if !validatePollTimer(jb.FluxMonitorSpec.PollTimerDisabled, minTimeout, jb.FluxMonitorSpec.PollTimerPeriod) {
 		return jb, errors.Errorf("PollTimerPeriod (%v) must be equal or greater than the smallest value of MaxTaskDuration param, JobPipeline.HTTPRequest.DefaultTimeout config var, or MinTimeout of all tasks (%v)", jb.FluxMonitorSpec.PollTimerPeriod, minTimeout)
 	}
@@ -78,6 +82,29 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 	return jb, nil
 }
 
+// validateDeviationRules validates the composite deviation rules and the
+// submission budget
+func validateDeviationRules(spec *job.FluxMonitorSpec) error {
+	switch spec.DeviationMode {
+	case "", job.FluxMonitorDeviationModeAll, job.FluxMonitorDeviationModeAny:
+	default:
+		return errors.Errorf("deviationMode must be %q or %q, got %q", job.FluxMonitorDeviationModeAll, job.FluxMonitorDeviationModeAny, spec.DeviationMode)
+	}
+	if spec.ThresholdUp < 0 || spec.ThresholdDown < 0 {
+		return errors.New("thresholdUp and thresholdDown must not be negative")
+	}
+	if spec.VolatilityMultiplier < 0 {
+		return errors.New("volatilityMultiplier must not be negative")
+	}
+	if spec.VolatilityMultiplier > 0 && spec.VolatilityWindow < 3 {
+		return errors.New("volatilityWindow must be at least 3 when volatilityMultiplier is set")
+	}
+	if spec.SubmissionBudget > 0 && spec.SubmissionBudgetPeriod <= 0 {
+		return errors.New("submissionBudgetPeriod must be set when submissionBudget is set")
+	}
+	return nil
+}
+
 // validatePollTime validates the period is greater than the min timeout for an
 // enabled poll timer.
 func validatePollTimer(disabled bool, minTimeout time.Duration, period time.Duration) bool {
@@ -210,6 +210,92 @@ externalJobID = "cfa3fa6b-2850-446b-b973-8f4c3b29d519"
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "composite deviation rules and submission budget",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = 0.01
+thresholdUp = 1.5
+thresholdDown = 0.25
+deviationMode = "any"
+volatilityWindow = 20
+volatilityMultiplier = 2.0
+submissionBudget = 6
+submissionBudgetPeriod = "1h"
+
+idleTimerPeriod = "1m"
+pollTimerPeriod = "1m"
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.NoError(t, err)
+				spec := j.FluxMonitorSpec
+				assert.Equal(t, tomlutils.Float32(1.5), spec.ThresholdUp)
+				assert.Equal(t, tomlutils.Float32(0.25), spec.ThresholdDown)
+				assert.Equal(t, job.FluxMonitorDeviationModeAny, spec.DeviationMode)
+				assert.Equal(t, uint32(20), spec.VolatilityWindow)
+				assert.Equal(t, tomlutils.Float32(2), spec.VolatilityMultiplier)
+				assert.Equal(t, uint32(6), spec.SubmissionBudget)
+				assert.Equal(t, time.Hour, spec.SubmissionBudgetPeriod)
+			},
+		},
+		{
+			name: "invalid deviation mode",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = 0.01
+deviationMode = "some"
+
+idleTimerPeriod = "1m"
+pollTimerPeriod = "1m"
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "deviationMode must be")
+			},
+		},
+		{
+			name: "submission budget without period",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+absoluteThreshold = 0.01
+submissionBudget = 6
+
+idleTimerPeriod = "1m"
+pollTimerPeriod = "1m"
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "submissionBudgetPeriod must be set")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -128,7 +128,7 @@ func (h *functionsConnectorHandler) HandleGatewayMessage(ctx context.Context, ga
 		h.lggr.Errorw("allowlist prevented the request from this address", "id", gatewayId, "address", fromAddr)
 		return
 	}
-	if !h.rateLimiter.Allow(body.Sender) {
+	if !h.rateLimiter.Allow(ctx, body.Sender) {
 		h.lggr.Errorw("request rate-limited", "id", gatewayId, "address", fromAddr)
 		return
 	}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/webapicap"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
@@ -38,6 +39,7 @@ type handler struct {
 	lggr            logger.Logger
 	httpClient      network.HTTPClient
 	nodeRateLimiter *common.RateLimiter
+	sharedState     *common.SharedState
 	wg              sync.WaitGroup
 	metrics         *metrics
 }
@@ -45,6 +47,8 @@ type handler struct {
 type HandlerConfig struct {
 	NodeRateLimiter         common.RateLimiterConfig `json:"nodeRateLimiter"`
 	MaxAllowedMessageAgeSec uint                     `json:"maxAllowedMessageAgeSec"`
+	// Not specifying SharedState config keeps rate limits in memory
+	SharedState *common.SharedStateConfig `json:"sharedState"`
 }
 
 type savedCallback struct {
@@ -54,13 +58,17 @@ type savedCallback struct {
 
 var _ handlers.Handler = (*handler)(nil)
 
-func NewHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, httpClient network.HTTPClient, lggr logger.Logger) (*handler, error) {
+func NewHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, httpClient network.HTTPClient, ds sqlutil.DataSource, lggr logger.Logger) (*handler, error) {
 	var cfg HandlerConfig
 	err := json.Unmarshal(handlerConfig, &cfg)
 	if err != nil {
 		return nil, err
 	}
-	nodeRateLimiter, err := common.NewRateLimiter(cfg.NodeRateLimiter)
+	sharedState, err := common.NewSharedState(cfg.SharedState, ds, "web-api-capabilities:"+donConfig.DonId)
+	if err != nil {
+		return nil, err
+	}
+	nodeRateLimiter, err := sharedState.NewRateLimiter(cfg.NodeRateLimiter, "node")
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +85,7 @@ func NewHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don
 		lggr:            lggr.Named("WebAPIHandler." + donConfig.DonId),
 		httpClient:      httpClient,
 		nodeRateLimiter: nodeRateLimiter,
+		sharedState:     sharedState,
 		wg:              sync.WaitGroup{},
 		savedCallbacks:  make(map[string]*savedCallback),
 		metrics:         metrics,
@@ -130,7 +139,7 @@ func (h *handler) handleWebAPITriggerMessage(ctx context.Context, msg *api.Messa
 
 func (h *handler) handleWebAPIOutgoingMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
 	h.lggr.Debugw("handling webAPI outgoing message", "messageId", msg.Body.MessageId, "nodeAddr", nodeAddr)
-	if !h.nodeRateLimiter.Allow(nodeAddr) {
+	if !h.nodeRateLimiter.Allow(ctx, nodeAddr) {
 		return fmt.Errorf("rate limit exceeded for node %s", nodeAddr)
 	}
 	var payload Request
@@ -216,13 +225,13 @@ func (h *handler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeA
 	return err
 }
 
-func (h *handler) Start(context.Context) error {
-	return nil
+func (h *handler) Start(ctx context.Context) error {
+	return h.sharedState.Start(ctx)
 }
 
 func (h *handler) Close() error {
 	h.wg.Wait()
-	return nil
+	return h.sharedState.Close()
 }
 
 func (h *handler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
@@ -67,7 +67,7 @@ func setupHandler(t *testing.T) (*handler, *mocks.HTTPClient, *handlermocks.DON,
 			Address: n.Address,
 		})
 	}
-	handler, err := NewHandler(json.RawMessage(cfgBytes), donConfig, don, httpClient, lggr)
+	handler, err := NewHandler(json.RawMessage(cfgBytes), donConfig, don, httpClient, nil, lggr)
 	require.NoError(t, err)
 	return handler, httpClient, don, nodes
 }
@@ -1,18 +1,23 @@
 package common
 
 import (
+	"context"
 	"errors"
-	"sync"
-
-	"golang.org/x/time/rate"
+	"math/rand"
+	"strconv"
 )
 
-// Wrapper around Go's rate.Limiter that supports both global and a per-sender rate limiting.
+const globalRateLimiterKey = "global"
+
+// RateLimiter supports both global and a per-sender rate limiting.
+// Token buckets are kept in a RateLimiterStore, which may be shared by several gateway replicas.
+// Like RequestCache, it fails closed: messages are rejected while the store is failing.
 type RateLimiter struct {
-	global    *rate.Limiter
-	perSender map[string]*rate.Limiter
-	config    RateLimiterConfig
-	mu        sync.Mutex
+	store  RateLimiterStore
+	prefix string
+	config RateLimiterConfig
+	// globalShards is the number of buckets the global limit is split across, see allowGlobal.
+	globalShards int
 }
 
 type RateLimiterConfig struct {
@@ -23,47 +28,68 @@ type RateLimiterConfig struct {
 }
 
 func NewRateLimiter(config RateLimiterConfig) (*RateLimiter, error) {
+	return NewRateLimiterWithStore(config, NewInMemoryRateLimiterStore(), "")
+}
+
+// NewRateLimiterWithStore returns a RateLimiter whose buckets live in store under keys starting with prefix.
+func NewRateLimiterWithStore(config RateLimiterConfig, store RateLimiterStore, prefix string) (*RateLimiter, error) {
 	if config.GlobalRPS <= 0.0 || config.PerSenderRPS <= 0.0 {
 		return nil, errors.New("RPS values must be positive")
 	}
 	if config.GlobalBurst <= 0 || config.PerSenderBurst <= 0 {
 		return nil, errors.New("burst values must be positive")
 	}
+	if store == nil {
+		return nil, errors.New("store must not be nil")
+	}
 
 	return &RateLimiter{
-		global:    rate.NewLimiter(rate.Limit(config.GlobalRPS), config.GlobalBurst),
-		perSender: make(map[string]*rate.Limiter),
-		config:    config,
+		store:        store,
+		prefix:       prefix,
+		config:       config,
+		globalShards: 1,
 	}, nil
 }
 
 // Allow checks that the sender is not rate limited,
 // and that there is not a global rate limit.
-func (rl *RateLimiter) Allow(sender string) bool {
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
-
-	senderLimiter, ok := rl.perSender[sender]
-	if !ok {
-		senderLimiter = rate.NewLimiter(rate.Limit(rl.config.PerSenderRPS), rl.config.PerSenderBurst)
-		rl.perSender[sender] = senderLimiter
-	}
-
-	return senderLimiter.Allow() && rl.global.Allow()
+func (rl *RateLimiter) Allow(ctx context.Context, sender string) bool {
+	return rl.allowSender(ctx, sender) && rl.allowGlobal(ctx)
 }
 
 // Allow checks that the sender is not rate limited,
 // and that there is not a global rate limit.
 // Returns if allowed as separate outputs.
-func (rl *RateLimiter) AllowVerbose(sender string) (senderAllow bool, globalAllow bool) {
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
+func (rl *RateLimiter) AllowVerbose(ctx context.Context, sender string) (senderAllow bool, globalAllow bool) {
+	return rl.allowSender(ctx, sender), rl.allowGlobal(ctx)
+}
+
+func (rl *RateLimiter) allowSender(ctx context.Context, sender string) bool {
+	return rl.allow(ctx, rl.prefix+":sender:"+sender, rl.config.PerSenderRPS, rl.config.PerSenderBurst)
+}
 
-	senderLimiter, ok := rl.perSender[sender]
-	if !ok {
-		senderLimiter = rate.NewLimiter(rate.Limit(rl.config.PerSenderRPS), rl.config.PerSenderBurst)
-		rl.perSender[sender] = senderLimiter
+// allowGlobal consumes a token from one of globalShards buckets picked at random, each holding an
+// equal share of the global rate and burst. With a shared store, a single global bucket would
+// serialize every message of every replica on the same row.
+func (rl *RateLimiter) allowGlobal(ctx context.Context) bool {
+	key := rl.prefix + ":" + globalRateLimiterKey
+	shards := min(rl.globalShards, rl.config.GlobalBurst)
+	if shards <= 1 {
+		return rl.allow(ctx, key, rl.config.GlobalRPS, rl.config.GlobalBurst)
 	}
+	shard := rand.Intn(shards) //nolint:gosec // shard selection does not need a secure source
+	return rl.allow(ctx, key+":"+strconv.Itoa(shard), rl.config.GlobalRPS/float64(shards), rl.config.GlobalBurst/shards)
+}
+
+func (rl *RateLimiter) allow(ctx context.Context, key string, rps float64, burst int) bool {
+	ctx, cancel := context.WithTimeout(ctx, sharedStateTimeout)
+	defer cancel()
 
-	return senderLimiter.Allow(), rl.global.Allow()
+	allowed, err := rl.store.Allow(ctx, key, rps, burst)
+	if err != nil {
+		// falling back to a per-process limit would multiply the limit by the number of replicas
+		promSharedStateErrors.WithLabelValues("allow").Inc()
+		return false
+	}
+	return allowed
 }
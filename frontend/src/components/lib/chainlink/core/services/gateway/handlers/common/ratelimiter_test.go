@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
 )
 
@@ -17,11 +18,38 @@ func TestRateLimiter_PerSender(t *testing.T) {
 		PerSenderRPS:   1.0,
 		PerSenderBurst: 2,
 	}
+	ctx := testutils.Context(t)
 	rl, err := common.NewRateLimiter(config)
 	require.NoError(t, err)
-	require.True(t, rl.Allow("user1"))
-	require.True(t, rl.Allow("user2"))
-	require.True(t, rl.Allow("user1"))
-	require.False(t, rl.Allow("user1"))
-	require.False(t, rl.Allow("user3"))
+	require.True(t, rl.Allow(ctx, "user1"))
+	require.True(t, rl.Allow(ctx, "user2"))
+	require.True(t, rl.Allow(ctx, "user1"))
+	require.False(t, rl.Allow(ctx, "user1"))
+	require.False(t, rl.Allow(ctx, "user3"))
+}
+
+func TestRateLimiter_ShardedGlobal(t *testing.T) {
+	t.Parallel()
+
+	config := common.RateLimiterConfig{
+		GlobalRPS:      0.001,
+		GlobalBurst:    4,
+		PerSenderRPS:   1000.0,
+		PerSenderBurst: 1000,
+	}
+	ctx := testutils.Context(t)
+	state, err := common.NewSharedState(&common.SharedStateConfig{GlobalRateLimiterShards: 4}, nil, "don1")
+	require.NoError(t, err)
+	rl, err := state.NewRateLimiter(config, "user")
+	require.NoError(t, err)
+
+	// each of the 4 shards holds a single token, so no more than the global burst is allowed
+	allowed := 0
+	for i := 0; i < 100; i++ {
+		if rl.Allow(ctx, "user1") {
+			allowed++
+		}
+	}
+	require.Positive(t, allowed)
+	require.LessOrEqual(t, allowed, 4)
 }
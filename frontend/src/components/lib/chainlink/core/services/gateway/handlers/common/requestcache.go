@@ -1,7 +1,9 @@
 package common
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -13,9 +15,10 @@ import (
 // It is parameterized by responseData, which is a service-specific type storing all data needed to aggregate responses.
 // Client needs to implement a ResponseProcessor, which is called for every response (see below).
 // Additionally, each request has a timeout, after which the netry will be removed from the cache and an error sent to the callback channel.
+// Request keys are also reserved in a RequestCacheStore so that duplicates are rejected across gateway replicas sharing the store.
 // All methods are thread-safe.
 type RequestCache[T any] interface {
-	NewRequest(request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error
+	NewRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error
 	ProcessResponse(response *api.Message, process ResponseProcessor[T]) error
 }
 
@@ -24,9 +27,13 @@ type RequestCache[T any] interface {
 type ResponseProcessor[T any] func(response *api.Message, state *T) (aggregated *handlers.UserCallbackPayload, newState *T, err error)
 
 type requestCache[T any] struct {
-	cache        map[globalId]*pendingRequest[T]
+	cache map[globalId]*pendingRequest[T]
+	// reserving holds the keys being reserved in the store, which count towards maxCacheSize
+	reserving    map[globalId]struct{}
 	maxCacheSize uint32
 	timeout      time.Duration
+	store        RequestCacheStore
+	prefix       string
 	mu           sync.Mutex
 }
 
@@ -43,10 +50,21 @@ type pendingRequest[T any] struct {
 }
 
 func NewRequestCache[T any](timeout time.Duration, maxCacheSize uint32) RequestCache[T] {
-	return &requestCache[T]{cache: make(map[globalId]*pendingRequest[T]), timeout: timeout, maxCacheSize: maxCacheSize}
+	return NewRequestCacheWithStore[T](timeout, maxCacheSize, NewInMemoryRequestCacheStore(), "")
 }
 
-func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error {
+// NewRequestCacheWithStore returns a RequestCache reserving request keys in store under keys starting with prefix.
+func NewRequestCacheWithStore[T any](timeout time.Duration, maxCacheSize uint32, store RequestCacheStore, prefix string) RequestCache[T] {
+	return &requestCache[T]{cache: make(map[globalId]*pendingRequest[T]), reserving: make(map[globalId]struct{}), timeout: timeout, maxCacheSize: maxCacheSize, store: store, prefix: prefix}
+}
+
+// NewSharedRequestCache returns a RequestCache backed by the shared request cache store.
+// It is a function rather than a method because Go methods cannot have type parameters.
+func NewSharedRequestCache[T any](s *SharedState, name string, timeout time.Duration, maxCacheSize uint32) RequestCache[T] {
+	return NewRequestCacheWithStore[T](timeout, maxCacheSize, s.RequestCacheStore, s.key(name))
+}
+
+func (c *requestCache[T]) NewRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error {
 	if request == nil {
 		return errors.New("request is nil")
 	}
@@ -55,14 +73,36 @@ func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- han
 	}
 	key := globalId{request.Body.Sender, request.Body.MessageId}
 	c.mu.Lock()
-	defer c.mu.Unlock()
 	_, ok := c.cache[key]
-	if ok {
+	_, reserving := c.reserving[key]
+	if ok || reserving {
+		c.mu.Unlock()
 		return errors.New("request already exists")
 	}
-	if len(c.cache) >= int(c.maxCacheSize) {
+	if len(c.cache)+len(c.reserving) >= int(c.maxCacheSize) {
+		c.mu.Unlock()
 		return errors.New("request cache is full")
 	}
+	c.reserving[key] = struct{}{}
+	c.mu.Unlock()
+
+	// the store is called without holding c.mu, so that other requests do not wait on the backend
+	ctx, cancel := context.WithTimeout(ctx, sharedStateTimeout)
+	defer cancel()
+	reserved, err := c.store.Reserve(ctx, c.storeKey(key), c.timeout)
+	if err != nil {
+		promSharedStateErrors.WithLabelValues("reserve").Inc()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.reserving, key)
+	if err != nil {
+		return fmt.Errorf("failed to reserve request: %w", err)
+	}
+	if !reserved {
+		return errors.New("request already exists")
+	}
 	timer := time.AfterFunc(c.timeout, func() {
 		c.deleteAndSendOnce(key, handlers.UserCallbackPayload{Msg: request, ErrMsg: "timeout", ErrCode: api.RequestTimeoutError})
 	})
@@ -109,8 +149,18 @@ func (c *requestCache[T]) deleteAndSendOnce(key globalId, callbackResponse handl
 	delete(c.cache, key)
 	c.mu.Unlock()
 	if deleted {
+		ctx, cancel := context.WithTimeout(context.Background(), sharedStateTimeout)
+		// a failed release only delays re-use of the key until the reservation expires
+		if err := c.store.Release(ctx, c.storeKey(key)); err != nil {
+			promSharedStateErrors.WithLabelValues("release").Inc()
+		}
+		cancel()
 		entry.timeoutTimer.Stop()
 		entry.callbackCh <- callbackResponse
 		close(entry.callbackCh)
 	}
 }
+
+func (c *requestCache[T]) storeKey(key globalId) string {
+	return c.prefix + ":" + key.sender + ":" + key.id
+}
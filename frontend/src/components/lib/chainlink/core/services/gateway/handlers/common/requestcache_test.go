@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
@@ -25,7 +26,7 @@ func TestRequestCache_Simple(t *testing.T) {
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
 	initialState := &requestState{}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
 	go func() {
@@ -52,7 +53,7 @@ func TestRequestCache_MultiResponse(t *testing.T) {
 		chans[i] = make(chan handlers.UserCallbackPayload)
 		reqs[i] = &api.Message{Body: api.MessageBody{MessageId: "abcd", Sender: fmt.Sprintf("sender_%d", i)}}
 		initialState := &requestState{counter: 0}
-		require.NoError(t, cache.NewRequest(reqs[i], chans[i], initialState))
+		require.NoError(t, cache.NewRequest(testutils.Context(t), reqs[i], chans[i], initialState))
 	}
 
 	for i := 0; i < nRequests; i++ {
@@ -88,7 +89,7 @@ func TestRequestCache_Timeout(t *testing.T) {
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
 	initialState := &requestState{}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	finalResp := <-callbackCh
 	require.Equal(t, "aa", finalResp.Msg.Body.MessageId)
@@ -103,11 +104,11 @@ func TestRequestCache_MaxSize(t *testing.T) {
 	initialState := &requestState{}
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	req.Body.MessageId = "bb"
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	req.Body.MessageId = "cc"
-	require.Error(t, cache.NewRequest(req, callbackCh, initialState))
+	require.Error(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 }
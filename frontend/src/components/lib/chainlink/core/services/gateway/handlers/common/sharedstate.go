@@ -0,0 +1,262 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+const (
+	SharedStateBackendMemory   = "memory"
+	SharedStateBackendPostgres = "postgres"
+
+	// sharedStateTimeout bounds every call to a shared state backend so that a slow database
+	// cannot stall message handling.
+	sharedStateTimeout = time.Second
+
+	// defaultPostgresGlobalRateLimiterShards is the number of rows a global rate limit is split
+	// across in the postgres backend, so that replicas do not all contend on a single row.
+	defaultPostgresGlobalRateLimiterShards = 16
+
+	// sharedStatePrunePeriod is how often expired entries are deleted from the backend.
+	sharedStatePrunePeriod = time.Minute
+	// sharedStatePruneTimeout bounds a single prune, which may delete many rows.
+	sharedStatePruneTimeout = 10 * time.Second
+)
+
+var promSharedStateErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "gateway_shared_state_errors",
+	Help: "Metric to track failed calls to the gateway shared state backend",
+}, []string{"operation"})
+
+// SharedStateConfig selects where rate limit buckets and request cache entries are kept.
+// The in-memory backend is local to a single gateway process. The postgres backend is shared
+// by all gateway replicas pointing at the same database, so per-sender limits and request
+// de-duplication apply across the whole deployment.
+// Both fail closed: while the backend is failing, messages are rejected as rate limited and
+// new requests are rejected, and the gateway_shared_state_errors metric is incremented.
+type SharedStateConfig struct {
+	// Backend is either "memory" (default) or "postgres".
+	Backend string `json:"backend"`
+	// Namespace is prepended to all keys, allowing several handlers to share a backend.
+	// Defaults to a value derived from the handler type and DON ID.
+	Namespace string `json:"namespace"`
+	// GlobalRateLimiterShards is the number of buckets each global rate limit is split across,
+	// each holding an equal share of the rate and burst. Defaults to 16 for the postgres backend
+	// and 1 for the memory backend. It is capped at the global burst.
+	GlobalRateLimiterShards int `json:"globalRateLimiterShards"`
+}
+
+// RateLimiterStore keeps token buckets for RateLimiter.
+// Allow consumes a single token from the bucket identified by key, creating it full if needed.
+type RateLimiterStore interface {
+	Allow(ctx context.Context, key string, rps float64, burst int) (bool, error)
+}
+
+// RequestCacheStore keeps the set of in-flight request keys used for de-duplication.
+// Reserve returns false if the key is already reserved and has not yet expired.
+type RequestCacheStore interface {
+	Reserve(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, key string) error
+}
+
+// Pruner is implemented by stores that keep expired entries until they are pruned.
+// Prune deletes the entries of all namespaces that no longer affect the outcome of any call.
+type Pruner interface {
+	Prune(ctx context.Context) error
+}
+
+// SharedState bundles the backends used by a handler's rate limiters and request cache.
+// While started, it periodically prunes the backends that implement Pruner.
+// Close may be called more than once, and without Start having been called.
+type SharedState struct {
+	RateLimiterStore  RateLimiterStore
+	RequestCacheStore RequestCacheStore
+	Namespace         string
+	// GlobalRateLimiterShards is passed to every RateLimiter returned by NewRateLimiter.
+	GlobalRateLimiterShards int
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    services.StopChan
+	wg        sync.WaitGroup
+}
+
+// NewSharedState returns backends for the given config. A nil config selects the in-memory backend.
+func NewSharedState(cfg *SharedStateConfig, ds sqlutil.DataSource, defaultNamespace string) (*SharedState, error) {
+	if cfg == nil {
+		cfg = &SharedStateConfig{}
+	}
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	if cfg.GlobalRateLimiterShards < 0 {
+		return nil, errors.New("globalRateLimiterShards must not be negative")
+	}
+	shards := cfg.GlobalRateLimiterShards
+	switch cfg.Backend {
+	case "", SharedStateBackendMemory:
+		if shards == 0 {
+			shards = 1
+		}
+		return &SharedState{
+			RateLimiterStore:        NewInMemoryRateLimiterStore(),
+			RequestCacheStore:       NewInMemoryRequestCacheStore(),
+			Namespace:               namespace,
+			GlobalRateLimiterShards: shards,
+			stopCh:                  make(services.StopChan),
+		}, nil
+	case SharedStateBackendPostgres:
+		if ds == nil {
+			return nil, errors.New("postgres shared state backend requires a datasource")
+		}
+		if shards == 0 {
+			shards = defaultPostgresGlobalRateLimiterShards
+		}
+		return &SharedState{
+			RateLimiterStore:        NewPostgresRateLimiterStore(ds),
+			RequestCacheStore:       NewPostgresRequestCacheStore(ds),
+			Namespace:               namespace,
+			GlobalRateLimiterShards: shards,
+			stopCh:                  make(services.StopChan),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported shared state backend %q", cfg.Backend)
+	}
+}
+
+// NewRateLimiter returns a RateLimiter backed by the shared rate limiter store.
+// name distinguishes limiters of the same handler, e.g. "user" and "node".
+func (s *SharedState) NewRateLimiter(config RateLimiterConfig, name string) (*RateLimiter, error) {
+	rl, err := NewRateLimiterWithStore(config, s.RateLimiterStore, s.key(name))
+	if err != nil {
+		return nil, err
+	}
+	if s.GlobalRateLimiterShards > 1 {
+		rl.globalShards = s.GlobalRateLimiterShards
+	}
+	return rl, nil
+}
+
+// Start begins pruning the backends every sharedStatePrunePeriod.
+func (s *SharedState) Start(context.Context) error {
+	s.startOnce.Do(func() {
+		var pruners []Pruner
+		for _, store := range []any{s.RateLimiterStore, s.RequestCacheStore} {
+			if pruner, ok := store.(Pruner); ok {
+				pruners = append(pruners, pruner)
+			}
+		}
+		if len(pruners) == 0 {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.pruneLoop(pruners)
+		}()
+	})
+	return nil
+}
+
+// Close stops pruning and waits for an in-progress prune to return.
+func (s *SharedState) Close() error {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+	return nil
+}
+
+func (s *SharedState) pruneLoop(pruners []Pruner) {
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(sharedStatePrunePeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, pruner := range pruners {
+				pruneCtx, pruneCancel := context.WithTimeout(ctx, sharedStatePruneTimeout)
+				if err := pruner.Prune(pruneCtx); err != nil {
+					promSharedStateErrors.WithLabelValues("prune").Inc()
+				}
+				pruneCancel()
+			}
+		}
+	}
+}
+
+func (s *SharedState) key(name string) string {
+	if s.Namespace == "" {
+		return name
+	}
+	return s.Namespace + ":" + name
+}
+
+type inMemoryRateLimiterStore struct {
+	limiters map[string]*rate.Limiter
+	mu       sync.Mutex
+}
+
+var _ RateLimiterStore = (*inMemoryRateLimiterStore)(nil)
+
+func NewInMemoryRateLimiterStore() RateLimiterStore {
+	return &inMemoryRateLimiterStore{limiters: make(map[string]*rate.Limiter)}
+}
+
+func (s *inMemoryRateLimiterStore) Allow(_ context.Context, key string, rps float64, burst int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limiter, ok := s.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(rps), burst)
+		s.limiters[key] = limiter
+	}
+	return limiter.Allow(), nil
+}
+
+type inMemoryRequestCacheStore struct {
+	expiries map[string]time.Time
+	mu       sync.Mutex
+}
+
+var _ RequestCacheStore = (*inMemoryRequestCacheStore)(nil)
+
+func NewInMemoryRequestCacheStore() RequestCacheStore {
+	return &inMemoryRequestCacheStore{expiries: make(map[string]time.Time)}
+}
+
+func (s *inMemoryRequestCacheStore) Reserve(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if expiry, ok := s.expiries[key]; ok && now.Before(expiry) {
+		return false, nil
+	}
+	s.expiries[key] = now.Add(ttl)
+	return true, nil
+}
+
+func (s *inMemoryRequestCacheStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.expiries, key)
+	return nil
+}
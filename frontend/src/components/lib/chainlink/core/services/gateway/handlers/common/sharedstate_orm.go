@@ -0,0 +1,94 @@
+package common
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+type postgresRateLimiterStore struct {
+	ds sqlutil.DataSource
+}
+
+var _ RateLimiterStore = (*postgresRateLimiterStore)(nil)
+var _ Pruner = (*postgresRateLimiterStore)(nil)
+
+// NewPostgresRateLimiterStore returns a RateLimiterStore keeping token buckets in the
+// gateway_rate_limit_buckets table. Buckets are refilled lazily based on the time elapsed
+// since their last update, so a single statement both refills and consumes a token.
+// Each bucket records when it will be full again, after which it is pruned: a missing bucket
+// is created full, so deleting a full one does not change the outcome of any call.
+func NewPostgresRateLimiterStore(ds sqlutil.DataSource) RateLimiterStore {
+	return &postgresRateLimiterStore{ds: ds}
+}
+
+func (s *postgresRateLimiterStore) Allow(ctx context.Context, key string, rps float64, burst int) (bool, error) {
+	const stmt = `
+		INSERT INTO gateway_rate_limit_buckets AS b (key, tokens, updated_at, full_at)
+		VALUES ($1, $3 - 1, NOW(), NOW() + make_interval(secs => 1 / $2::float8))
+		ON CONFLICT (key) DO UPDATE SET
+			tokens = LEAST($3, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $2) - 1,
+			updated_at = NOW(),
+			full_at = NOW() + make_interval(secs => ($3 - LEAST($3, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $2) + 1) / $2::float8)
+		WHERE LEAST($3, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $2) >= 1
+		RETURNING tokens;`
+	var tokens float64
+	err := s.ds.GetContext(ctx, &tokens, stmt, key, rps, float64(burst))
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *postgresRateLimiterStore) Prune(ctx context.Context) error {
+	_, err := s.ds.ExecContext(ctx, `DELETE FROM gateway_rate_limit_buckets WHERE full_at <= NOW();`)
+	return err
+}
+
+type postgresRequestCacheStore struct {
+	ds sqlutil.DataSource
+}
+
+var _ RequestCacheStore = (*postgresRequestCacheStore)(nil)
+var _ Pruner = (*postgresRequestCacheStore)(nil)
+
+// NewPostgresRequestCacheStore returns a RequestCacheStore keeping in-flight request keys in the
+// gateway_request_cache_entries table. Expired entries are overwritten on the next reservation
+// of the same key, or deleted by Prune.
+func NewPostgresRequestCacheStore(ds sqlutil.DataSource) RequestCacheStore {
+	return &postgresRequestCacheStore{ds: ds}
+}
+
+func (s *postgresRequestCacheStore) Reserve(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	const stmt = `
+		INSERT INTO gateway_request_cache_entries AS e (key, expires_at)
+		VALUES ($1, NOW() + $2 * INTERVAL '1 millisecond')
+		ON CONFLICT (key) DO UPDATE SET expires_at = EXCLUDED.expires_at
+		WHERE e.expires_at <= NOW()
+		RETURNING key;`
+	var reserved string
+	err := s.ds.GetContext(ctx, &reserved, stmt, key, ttl.Milliseconds())
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *postgresRequestCacheStore) Release(ctx context.Context, key string) error {
+	_, err := s.ds.ExecContext(ctx, `DELETE FROM gateway_request_cache_entries WHERE key = $1;`, key)
+	return err
+}
+
+func (s *postgresRequestCacheStore) Prune(ctx context.Context) error {
+	_, err := s.ds.ExecContext(ctx, `DELETE FROM gateway_request_cache_entries WHERE expires_at <= NOW();`)
+	return err
+}
@@ -0,0 +1,225 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+type failingRateLimiterStore struct{}
+
+func (failingRateLimiterStore) Allow(context.Context, string, float64, int) (bool, error) {
+	return false, errors.New("store unavailable")
+}
+
+func TestSharedState_UnsupportedBackend(t *testing.T) {
+	t.Parallel()
+
+	_, err := common.NewSharedState(&common.SharedStateConfig{Backend: "redis"}, nil, "ns")
+	require.Error(t, err)
+
+	_, err = common.NewSharedState(&common.SharedStateConfig{Backend: common.SharedStateBackendPostgres}, nil, "ns")
+	require.Error(t, err)
+
+	_, err = common.NewSharedState(&common.SharedStateConfig{GlobalRateLimiterShards: -1}, nil, "ns")
+	require.Error(t, err)
+}
+
+func TestSharedState_StartClose(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	state, err := common.NewSharedState(&common.SharedStateConfig{Backend: common.SharedStateBackendPostgres}, db, "ns")
+	require.NoError(t, err)
+	require.Equal(t, 16, state.GlobalRateLimiterShards)
+	require.NoError(t, state.Start(testutils.Context(t)))
+	require.NoError(t, state.Close())
+	require.NoError(t, state.Close())
+
+	// handlers close their shared state even if they were never started
+	state, err = common.NewSharedState(nil, nil, "ns")
+	require.NoError(t, err)
+	require.NoError(t, state.Close())
+}
+
+func TestSharedState_RateLimiterSharedAcrossInstances(t *testing.T) {
+	t.Parallel()
+
+	config := common.RateLimiterConfig{
+		GlobalRPS:      100.0,
+		GlobalBurst:    100,
+		PerSenderRPS:   1.0,
+		PerSenderBurst: 2,
+	}
+	ctx := testutils.Context(t)
+	store := common.NewInMemoryRateLimiterStore()
+	rl1, err := common.NewRateLimiterWithStore(config, store, "don1")
+	require.NoError(t, err)
+	rl2, err := common.NewRateLimiterWithStore(config, store, "don1")
+	require.NoError(t, err)
+	other, err := common.NewRateLimiterWithStore(config, store, "don2")
+	require.NoError(t, err)
+
+	require.True(t, rl1.Allow(ctx, "user1"))
+	require.True(t, rl2.Allow(ctx, "user1"))
+	require.False(t, rl1.Allow(ctx, "user1"))
+	require.False(t, rl2.Allow(ctx, "user1"))
+	require.True(t, other.Allow(ctx, "user1"))
+}
+
+func TestSharedState_RateLimiterFailsClosedOnStoreError(t *testing.T) {
+	t.Parallel()
+
+	config := common.RateLimiterConfig{
+		GlobalRPS:      100.0,
+		GlobalBurst:    100,
+		PerSenderRPS:   1.0,
+		PerSenderBurst: 1,
+	}
+	rl, err := common.NewRateLimiterWithStore(config, failingRateLimiterStore{}, "don1")
+	require.NoError(t, err)
+	require.False(t, rl.Allow(testutils.Context(t), "user1"))
+}
+
+func TestSharedState_RequestCacheDeduplicatesAcrossInstances(t *testing.T) {
+	t.Parallel()
+
+	state, err := common.NewSharedState(nil, nil, "don1")
+	require.NoError(t, err)
+	cache1 := common.NewSharedRequestCache[requestState](state, "requests", time.Hour, 1000)
+	cache2 := common.NewSharedRequestCache[requestState](state, "requests", time.Hour, 1000)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, cache1.NewRequest(testutils.Context(t), req, callbackCh, &requestState{}))
+	require.Error(t, cache2.NewRequest(testutils.Context(t), req, make(chan handlers.UserCallbackPayload, 1), &requestState{}))
+
+	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
+	require.NoError(t, cache1.ProcessResponse(nodeResp, func(response *api.Message, _ *requestState) (*handlers.UserCallbackPayload, *requestState, error) {
+		return &handlers.UserCallbackPayload{Msg: response}, nil, nil
+	}))
+	<-callbackCh
+
+	// released once the first request completes
+	require.NoError(t, cache2.NewRequest(testutils.Context(t), req, make(chan handlers.UserCallbackPayload, 1), &requestState{}))
+}
+
+func TestSharedState_InMemoryRequestCacheStoreExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	store := common.NewInMemoryRequestCacheStore()
+	reserved, err := store.Reserve(ctx, "key", time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	require.Eventually(t, func() bool {
+		reserved, err = store.Reserve(ctx, "key", time.Hour)
+		return err == nil && reserved
+	}, testutils.WaitTimeout(t), time.Millisecond)
+
+	reserved, err = store.Reserve(ctx, "key", time.Hour)
+	require.NoError(t, err)
+	require.False(t, reserved)
+}
+
+func TestSharedState_PostgresRateLimiterStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	store := common.NewPostgresRateLimiterStore(db)
+
+	for i := 0; i < 2; i++ {
+		allowed, err := store.Allow(ctx, "don1:user:sender:0x1234", 0.001, 2)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+	allowed, err := store.Allow(ctx, "don1:user:sender:0x1234", 0.001, 2)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = store.Allow(ctx, "don1:user:sender:0x5678", 0.001, 2)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestSharedState_PostgresRequestCacheStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	store := common.NewPostgresRequestCacheStore(db)
+
+	reserved, err := store.Reserve(ctx, "don1:requests:0x1234:aa", time.Hour)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	reserved, err = store.Reserve(ctx, "don1:requests:0x1234:aa", time.Hour)
+	require.NoError(t, err)
+	require.False(t, reserved)
+
+	require.NoError(t, store.Release(ctx, "don1:requests:0x1234:aa"))
+	reserved, err = store.Reserve(ctx, "don1:requests:0x1234:aa", 0)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	// a zero TTL reservation has already expired
+	reserved, err = store.Reserve(ctx, "don1:requests:0x1234:aa", time.Hour)
+	require.NoError(t, err)
+	require.True(t, reserved)
+}
+
+func TestSharedState_PostgresPrune(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	rateLimiterStore := common.NewPostgresRateLimiterStore(db)
+	requestCacheStore := common.NewPostgresRequestCacheStore(db)
+
+	// refills in a millisecond
+	allowed, err := rateLimiterStore.Allow(ctx, "don1:user:sender:0x1234", 1000, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	// refills in ~17 minutes
+	allowed, err = rateLimiterStore.Allow(ctx, "don1:user:sender:0x5678", 0.001, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	reserved, err := requestCacheStore.Reserve(ctx, "don1:requests:0x1234:aa", 0)
+	require.NoError(t, err)
+	require.True(t, reserved)
+	reserved, err = requestCacheStore.Reserve(ctx, "don1:requests:0x1234:bb", time.Hour)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, rateLimiterStore.(common.Pruner).Prune(ctx))
+	require.NoError(t, requestCacheStore.(common.Pruner).Prune(ctx))
+
+	var buckets []string
+	require.NoError(t, db.SelectContext(ctx, &buckets, `SELECT key FROM gateway_rate_limit_buckets ORDER BY key`))
+	require.Equal(t, []string{"don1:user:sender:0x5678"}, buckets)
+	var entries []string
+	require.NoError(t, db.SelectContext(ctx, &entries, `SELECT key FROM gateway_request_cache_entries ORDER BY key`))
+	require.Equal(t, []string{"don1:requests:0x1234:bb"}, entries)
+
+	// a pruned bucket is recreated full, the same as it was before pruning
+	allowed, err = rateLimiterStore.Allow(ctx, "don1:user:sender:0x1234", 1000, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	// a bucket that was not full is kept empty
+	allowed, err = rateLimiterStore.Allow(ctx, "don1:user:sender:0x5678", 0.001, 1)
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
@@ -71,6 +71,8 @@ type FunctionsHandlerConfig struct {
 	MaxPendingRequests         uint32                `json:"maxPendingRequests"`
 	RequestTimeoutMillis       int64                 `json:"requestTimeoutMillis"`
 	AllowedHeartbeatInitiators []string              `json:"allowedHeartbeatInitiators"`
+	// Not specifying SharedState config keeps rate limits and pending requests in memory
+	SharedState *hc.SharedStateConfig `json:"sharedState"`
 }
 
 type functionsHandler struct {
@@ -85,6 +87,7 @@ type functionsHandler struct {
 	minimumBalance             *assets.Link
 	userRateLimiter            *hc.RateLimiter
 	nodeRateLimiter            *hc.RateLimiter
+	sharedState                *hc.SharedState
 	allowedHeartbeatInitiators map[string]struct{}
 	chStop                     services.StopChan
 	lggr                       logger.Logger
@@ -122,15 +125,19 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 			return nil, err2
 		}
 	}
+	sharedState, err := hc.NewSharedState(cfg.SharedState, ds, "functions:"+donConfig.DonId)
+	if err != nil {
+		return nil, err
+	}
 	var userRateLimiter, nodeRateLimiter *hc.RateLimiter
 	if cfg.UserRateLimiter != nil {
-		userRateLimiter, err = hc.NewRateLimiter(*cfg.UserRateLimiter)
+		userRateLimiter, err = sharedState.NewRateLimiter(*cfg.UserRateLimiter, "user")
 		if err != nil {
 			return nil, err
 		}
 	}
 	if cfg.NodeRateLimiter != nil {
-		nodeRateLimiter, err = hc.NewRateLimiter(*cfg.NodeRateLimiter)
+		nodeRateLimiter, err = sharedState.NewRateLimiter(*cfg.NodeRateLimiter, "node")
 		if err != nil {
 			return nil, err
 		}
@@ -156,8 +163,8 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 	for _, initiator := range cfg.AllowedHeartbeatInitiators {
 		allowedHeartbeatInitiators[strings.ToLower(initiator)] = struct{}{}
 	}
-	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
-	return NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, cfg.MinimumSubscriptionBalance, userRateLimiter, nodeRateLimiter, allowedHeartbeatInitiators, lggr), nil
+	pendingRequestsCache := hc.NewSharedRequestCache[PendingRequest](sharedState, "requests", time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
+	return NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, cfg.MinimumSubscriptionBalance, userRateLimiter, nodeRateLimiter, sharedState, allowedHeartbeatInitiators, lggr), nil
 }
 
 func NewFunctionsHandler(
@@ -170,6 +177,7 @@ func NewFunctionsHandler(
 	minimumBalance *assets.Link,
 	userRateLimiter *hc.RateLimiter,
 	nodeRateLimiter *hc.RateLimiter,
+	sharedState *hc.SharedState,
 	allowedHeartbeatInitiators map[string]struct{},
 	lggr logger.Logger) handlers.Handler {
 	return &functionsHandler{
@@ -182,6 +190,7 @@ func NewFunctionsHandler(
 		minimumBalance:             minimumBalance,
 		userRateLimiter:            userRateLimiter,
 		nodeRateLimiter:            nodeRateLimiter,
+		sharedState:                sharedState,
 		allowedHeartbeatInitiators: allowedHeartbeatInitiators,
 		chStop:                     make(services.StopChan),
 		lggr:                       lggr,
@@ -195,7 +204,7 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
 		return ErrNotAllowlisted
 	}
-	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(msg.Body.Sender) {
+	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(ctx, msg.Body.Sender) {
 		h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender)
 		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
 		return ErrRateLimited
@@ -232,7 +241,7 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 
 func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
 	h.lggr.Debugw("handleRequest: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId)
-	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
+	err := h.pendingRequests.NewRequest(ctx, msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
 	if err != nil {
 		h.lggr.Warnw("handleRequest: error adding new request", "sender", msg.Body.Sender, "err", err)
 		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
@@ -250,7 +259,7 @@ func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message,
 
 func (h *functionsHandler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
 	h.lggr.Debugw("HandleNodeMessage: processing message", "nodeAddr", nodeAddr, "receiver", msg.Body.Receiver, "id", msg.Body.MessageId)
-	if h.nodeRateLimiter != nil && !h.nodeRateLimiter.Allow(nodeAddr) {
+	if h.nodeRateLimiter != nil && !h.nodeRateLimiter.Allow(ctx, nodeAddr) {
 		h.lggr.Debugw("rate-limited", "sender", nodeAddr)
 		return errors.New("rate-limited")
 	}
@@ -362,6 +371,11 @@ func (h *functionsHandler) processHeartbeatResponse(response *api.Message, respo
 func (h *functionsHandler) Start(ctx context.Context) error {
 	return h.StartOnce("FunctionsHandler", func() error {
 		h.lggr.Info("starting FunctionsHandler")
+		if h.sharedState != nil {
+			if err := h.sharedState.Start(ctx); err != nil {
+				return err
+			}
+		}
 		if h.allowlist != nil {
 			if err := h.allowlist.Start(ctx); err != nil {
 				return err
@@ -385,6 +399,9 @@ func (h *functionsHandler) Close() error {
 		if h.subscriptions != nil {
 			err = multierr.Combine(err, h.subscriptions.Close())
 		}
+		if h.sharedState != nil {
+			err = multierr.Combine(err, h.sharedState.Close())
+		}
 		return
 	})
 }
@@ -151,6 +151,7 @@ func newLegacyChainContainer(t *testing.T, db *sqlx.DB) legacyevm.LegacyChainCon
 		keyStore,
 		estimator,
 		ht,
+		nil,
 		nil)
 	require.NoError(t, err)
 
@@ -493,9 +493,25 @@ type DirectRequestSpec struct {
 	MinIncomingConfirmations clnull.Uint32            `toml:"minIncomingConfirmations"`
 	Requesters               models.AddressCollection `toml:"requesters"`
 	MinContractPayment       *commonassets.Link       `toml:"minContractPaymentLinkJuels"`
-	EVMChainID               *big.Big                 `toml:"evmChainID"`
-	CreatedAt                time.Time                `toml:"-"`
-	UpdatedAt                time.Time                `toml:"-"`
+	// RequesterAllowlistAddress is an optional allowlist contract with a
+	// getAllAllowedSenders function and AddedAccess, BlockedAccess and
+	// UnblockedAccess events, such as a Functions TermsOfServiceAllowList. Its
+	// allowed senders may make requests in addition to Requesters.
+	RequesterAllowlistAddress *evmtypes.EIP55Address `toml:"requesterAllowlistAddress"`
+	// RequesterRateLimit is the maximum number of requests accepted from a
+	// single requester within RequesterRateLimitPeriod. Zero means no limit.
+	RequesterRateLimit       uint32          `toml:"requesterRateLimit"`
+	RequesterRateLimitPeriod models.Interval `toml:"requesterRateLimitPeriod"`
+	// RequesterDailySpendCap is the maximum gas cost of the callbacks of a
+	// single requester per UTC day. The cost of a callback is estimated when
+	// its request is accepted, and corrected once its transaction confirms.
+	// Transactions are matched to requests by the requestID of their txMeta,
+	// which the ethtx task of the job must set. Estimates without a
+	// transaction are released after an hour.
+	RequesterDailySpendCap *assets.Wei `toml:"requesterDailySpendCap"`
+	EVMChainID             *big.Big    `toml:"evmChainID"`
+	CreatedAt              time.Time   `toml:"-"`
+	UpdatedAt              time.Time   `toml:"-"`
 }
 
 type CronSpec struct {
@@ -526,28 +542,56 @@ type FluxMonitorSpec struct {
 	// AbsoluteThreshold is the maximum absolute change allowed in a fluxmonitored
 	// value before a new round should be kicked off, so that the current value
 	// can be reported on-chain.
-	AbsoluteThreshold   tomlutils.Float32 `toml:"absoluteThreshold,float"`
-	PollTimerPeriod     time.Duration
-	PollTimerDisabled   bool
-	IdleTimerPeriod     time.Duration
-	IdleTimerDisabled   bool
-	DrumbeatSchedule    string
-	DrumbeatRandomDelay time.Duration
-	DrumbeatEnabled     bool
-	MinPayment          *commonassets.Link
-	EVMChainID          *big.Big  `toml:"evmChainID"`
-	CreatedAt           time.Time `toml:"-"`
-	UpdatedAt           time.Time `toml:"-"`
+	AbsoluteThreshold tomlutils.Float32 `toml:"absoluteThreshold,float"`
+	// ThresholdUp and ThresholdDown override Threshold for increases and
+	// decreases of the answer respectively, if non-zero.
+	ThresholdUp   tomlutils.Float32 `toml:"thresholdUp,float"`
+	ThresholdDown tomlutils.Float32 `toml:"thresholdDown,float"`
+	// DeviationMode is either "all" (the default), requiring both the
+	// relative and absolute thresholds to be met, or "any", requiring
+	// either of them.
+	DeviationMode string `toml:"deviationMode"`
+	// VolatilityWindow is the number of recently submitted answers over which
+	// the volatility of the feed is computed. The relative threshold is raised
+	// to VolatilityMultiplier times the standard deviation of the relative
+	// changes between them.
+	VolatilityWindow     uint32            `toml:"volatilityWindow"`
+	VolatilityMultiplier tomlutils.Float32 `toml:"volatilityMultiplier,float"`
+	// SubmissionBudget is the maximum number of rounds the node starts on
+	// deviation within any SubmissionBudgetPeriod. Heartbeats from the idle
+	// timer and drumbeat are not limited. Zero means no limit.
+	SubmissionBudget       uint32        `toml:"submissionBudget"`
+	SubmissionBudgetPeriod time.Duration `toml:"submissionBudgetPeriod"`
+	PollTimerPeriod        time.Duration
+	PollTimerDisabled      bool
+	IdleTimerPeriod        time.Duration
+	IdleTimerDisabled      bool
+	DrumbeatSchedule       string
+	DrumbeatRandomDelay    time.Duration
+	DrumbeatEnabled        bool
+	MinPayment             *commonassets.Link
+	EVMChainID             *big.Big  `toml:"evmChainID"`
+	CreatedAt              time.Time `toml:"-"`
+	UpdatedAt              time.Time `toml:"-"`
 }
 
+const (
+	FluxMonitorDeviationModeAll = "all"
+	FluxMonitorDeviationModeAny = "any"
+)
+
 type KeeperSpec struct {
 	ID                       int32                 `toml:"-"`
 	ContractAddress          evmtypes.EIP55Address `toml:"contractAddress"`
 	MinIncomingConfirmations *uint32               `toml:"minIncomingConfirmations"`
 	FromAddress              evmtypes.EIP55Address `toml:"fromAddress"`
 	EVMChainID               *big.Big              `toml:"evmChainID"`
-	CreatedAt                time.Time             `toml:"-"`
-	UpdatedAt                time.Time             `toml:"-"`
+	// DryRun makes the job check and simulate upkeeps without broadcasting
+	// perform transactions, recording them in keeper_dry_run_transactions
+	// instead.
+	DryRun    bool      `toml:"dryRun"`
+	CreatedAt time.Time `toml:"-"`
+	UpdatedAt time.Time `toml:"-"`
 }
 
 type VRFSpec struct {
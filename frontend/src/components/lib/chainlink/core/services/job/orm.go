@@ -537,15 +537,19 @@ func (o *orm) prepareQuerySpecID(ctx context.Context, sql string, arg any) (spec
 }
 
 func (o *orm) insertDirectRequestSpec(ctx context.Context, spec *DirectRequestSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO direct_request_specs (contract_address, min_incoming_confirmations, requesters, min_contract_payment, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :min_incoming_confirmations, :requesters, :min_contract_payment, :evm_chain_id, now(), now())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO direct_request_specs (contract_address, min_incoming_confirmations, requesters, min_contract_payment,
+					requester_allowlist_address, requester_rate_limit, requester_rate_limit_period, requester_daily_spend_cap, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :min_incoming_confirmations, :requesters, :min_contract_payment,
+					:requester_allowlist_address, :requester_rate_limit, :requester_rate_limit_period, :requester_daily_spend_cap, :evm_chain_id, now(), now())
 			RETURNING id;`, spec)
 }
 
 func (o *orm) insertFluxMonitorSpec(ctx context.Context, spec *FluxMonitorSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
+	return o.prepareQuerySpecID(ctx, `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, threshold_up, threshold_down, deviation_mode,
+					volatility_window, volatility_multiplier, submission_budget, submission_budget_period, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
 					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, min_payment, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :threshold, :absolute_threshold, :poll_timer_period, :poll_timer_disabled, :idle_timer_period, :idle_timer_disabled,
+			VALUES (:contract_address, :threshold, :absolute_threshold, :threshold_up, :threshold_down, :deviation_mode,
+					:volatility_window, :volatility_multiplier, :submission_budget, :submission_budget_period, :poll_timer_period, :poll_timer_disabled, :idle_timer_period, :idle_timer_disabled,
 					:drumbeat_schedule, :drumbeat_random_delay, :drumbeat_enabled, :min_payment, :evm_chain_id, NOW(), NOW())
 			RETURNING id;`, spec)
 }
@@ -571,8 +575,8 @@ func (o *orm) insertOCR2OracleSpec(ctx context.Context, spec *OCR2OracleSpec) (s
 }
 
 func (o *orm) insertKeeperSpec(ctx context.Context, spec *KeeperSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :from_address, :evm_chain_id, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, dry_run, created_at, updated_at)
+			VALUES (:contract_address, :from_address, :evm_chain_id, :dry_run, NOW(), NOW())
 			RETURNING id;`, spec)
 }
 
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -40,6 +41,23 @@ type UpkeepRegistration struct {
 	PositioningConstant int32
 }
 
+// DryRunTransaction is a perform transaction that a dry-run keeper job
+// would have broadcast
+type DryRunTransaction struct {
+	ID                int64
+	JobID             int32
+	UpkeepID          *big.Big
+	ContractAddress   types.EIP55Address
+	FromAddress       types.EIP55Address
+	BlockNumber       int64
+	Calldata          []byte
+	GasLimit          uint64
+	GasEstimate       null.Int64
+	SimulationSuccess bool
+	SimulationError   *string
+	CreatedAt         time.Time
+}
+
 func (k *KeeperIndexMap) Scan(val interface{}) error {
 	switch v := val.(type) {
 	case []byte:
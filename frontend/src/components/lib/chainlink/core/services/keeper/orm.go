@@ -240,3 +240,31 @@ func (o *ORM) SetLastRunInfoForUpkeepOnJob(ctx context.Context, jobID int32, upk
 	}
 	return rowsAffected, nil
 }
+
+// InsertDryRunTransaction records a perform transaction that a dry-run job
+// would have broadcast
+func (o *ORM) InsertDryRunTransaction(ctx context.Context, tx *DryRunTransaction) error {
+	stmt := `
+INSERT INTO keeper_dry_run_transactions (job_id, upkeep_id, contract_address, from_address, block_number, calldata, gas_limit, gas_estimate, simulation_success, simulation_error, created_at) VALUES (
+:job_id, :upkeep_id, :contract_address, :from_address, :block_number, :calldata, :gas_limit, :gas_estimate, :simulation_success, :simulation_error, NOW()
+) RETURNING *
+`
+	query, args, err := o.ds.BindNamed(stmt, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to insert dry run transaction")
+	}
+	err = o.ds.GetContext(ctx, tx, query, args...)
+	return errors.Wrap(err, "failed to insert dry run transaction")
+}
+
+// DryRunTransactionsForJob returns the most recent dry run transactions of the
+// job with the given ID, newest first
+func (o *ORM) DryRunTransactionsForJob(ctx context.Context, jobID int32, limit int) (txs []DryRunTransaction, err error) {
+	err = o.ds.SelectContext(ctx, &txs, `
+SELECT * FROM keeper_dry_run_transactions
+WHERE job_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+`, jobID, limit)
+	return txs, errors.Wrap(err, "DryRunTransactionsForJob failed")
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -440,6 +441,55 @@ func TestKeeperDB_NewSetLastRunInfoForUpkeepOnJob(t *testing.T) {
 	assertLastRunHeight(t, db, upkeep, 101, 0)
 }
 
+func TestKeeperDB_DryRunTransactions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db, _, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+	_, otherJob := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, registry)
+
+	simulationError := "execution reverted"
+	for i, tx := range []keeper.DryRunTransaction{
+		{JobID: j.ID, SimulationSuccess: true},
+		{JobID: j.ID, SimulationError: &simulationError},
+		{JobID: otherJob.ID, SimulationSuccess: true},
+	} {
+		tx.UpkeepID = upkeep.UpkeepID
+		tx.ContractAddress = registry.ContractAddress
+		tx.FromAddress = registry.FromAddress
+		tx.BlockNumber = int64(i)
+		tx.Calldata = checkData
+		tx.GasLimit = 5_000_000
+		if tx.SimulationSuccess {
+			tx.GasEstimate = null.Int64From(100_000)
+		}
+		require.NoError(t, orm.InsertDryRunTransaction(ctx, &tx))
+		require.NotZero(t, tx.ID)
+	}
+
+	txs, err := orm.DryRunTransactionsForJob(ctx, j.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	// newest first
+	assert.Equal(t, int64(1), txs[0].BlockNumber)
+	assert.False(t, txs[0].SimulationSuccess)
+	require.NotNil(t, txs[0].SimulationError)
+	assert.Equal(t, simulationError, *txs[0].SimulationError)
+	assert.False(t, txs[0].GasEstimate.Valid)
+	assert.Equal(t, int64(0), txs[1].BlockNumber)
+	assert.True(t, txs[1].SimulationSuccess)
+	assert.Equal(t, int64(100_000), txs[1].GasEstimate.Int64)
+	assert.Equal(t, upkeep.UpkeepID.String(), txs[1].UpkeepID.String())
+	assert.Equal(t, checkData, txs[1].Calldata)
+
+	txs, err = orm.DryRunTransactionsForJob(ctx, j.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+}
+
 func TestKeeperDB_LeastSignificant(t *testing.T) {
 	t.Parallel()
 	db, _, _ := setupKeeperDB(t)
@@ -7,7 +7,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -23,6 +25,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
@@ -226,6 +229,9 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 
 	// DotDagSource in database is empty because all the Keeper pipeline runs make use of the same observation source
 	ex.job.PipelineSpec.DotDagSource = pipeline.KeepersObservationSource
+	if ex.job.KeeperSpec.DryRun {
+		ex.job.PipelineSpec.DotDagSource = pipeline.KeepersDryRunObservationSource
+	}
 	run := pipeline.NewRun(*ex.job.PipelineSpec, vars)
 
 	if _, err := ex.pr.Run(ctxService, run, true, nil); err != nil {
@@ -233,6 +239,15 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 		return
 	}
 
+	// Dry runs leave the last run info untouched, so that the upkeep is not
+	// skipped by the live jobs of other keepers or by a later live run
+	if ex.job.KeeperSpec.DryRun {
+		if err := ex.recordDryRunTransaction(ctxService, upkeep, head, run); err != nil {
+			svcLogger.Error(errors.Wrap(err, "failed to record dry run transaction"))
+		}
+		return
+	}
+
 	// Only after task runs where a tx was broadcast
 	if run.State == pipeline.RunStatusCompleted {
 		rowsAffected, err := ex.orm.SetLastRunInfoForUpkeepOnJob(ctxService, ex.job.ID, upkeep.UpkeepID, head.Number, upkeep.Registry.FromAddress)
@@ -248,6 +263,62 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	}
 }
 
+// recordDryRunTransaction records the perform transaction that the run would
+// have broadcast, if the upkeep needed performing. The simulated perform
+// result is recorded whether it succeeded or not, so that operators can see
+// transactions that would have reverted.
+func (ex *UpkeepExecuter) recordDryRunTransaction(ctx context.Context, upkeep UpkeepRegistration, head *evmtypes.Head, run *pipeline.Run) error {
+	encodeRun := run.ByDotID("encode_perform_upkeep_tx")
+	if encodeRun == nil || encodeRun.Error.Valid {
+		// checkUpkeep returned false, nothing would have been broadcast
+		return nil
+	}
+	calldataHex, ok := encodeRun.Output.Val.(string)
+	if !ok {
+		return errors.Errorf("unexpected perform calldata type %T", encodeRun.Output.Val)
+	}
+	calldata, err := hexutil.Decode(calldataHex)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode perform calldata")
+	}
+
+	tx := DryRunTransaction{
+		JobID:           ex.job.ID,
+		UpkeepID:        upkeep.UpkeepID,
+		ContractAddress: upkeep.Registry.ContractAddress,
+		FromAddress:     upkeep.Registry.FromAddress,
+		BlockNumber:     head.Number,
+		Calldata:        calldata,
+		GasLimit:        uint64(maxUpkeepPerformGas + ex.config.Registry().PerformGasOverhead()),
+	}
+
+	if simulateRun := run.ByDotID("simulate_perform_upkeep_tx"); simulateRun != nil && simulateRun.Error.Valid {
+		tx.SimulationError = &simulateRun.Error.String
+	} else if decodeRun := run.ByDotID("decode_check_perform_tx"); decodeRun != nil && !decodeRun.Error.Valid {
+		if m, ok := decodeRun.Output.Val.(map[string]interface{}); ok {
+			tx.SimulationSuccess, _ = m["success"].(bool)
+		}
+	}
+
+	to := upkeep.Registry.ContractAddress.Address()
+	gasEstimate, err := ex.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: ex.effectiveKeeperAddress,
+		To:   &to,
+		Data: calldata,
+	})
+	if err != nil {
+		ex.logger.Debugw("Failed to estimate gas for dry run perform transaction", "upkeepID", upkeep.PrettyID(), "err", err)
+	} else {
+		tx.GasEstimate = null.Int64From(int64(gasEstimate))
+	}
+
+	if err = ex.orm.InsertDryRunTransaction(ctx, &tx); err != nil {
+		return err
+	}
+	ex.logger.Infow("Recorded dry run perform transaction", "upkeepID", upkeep.PrettyID(), "blockNum", head.Number, "simulationSuccess", tx.SimulationSuccess, "gasEstimate", tx.GasEstimate)
+	return nil
+}
+
 func (ex *UpkeepExecuter) turnBlockHashBinary(ctx context.Context, registry Registry, head *evmtypes.Head, lookback int64) (string, error) {
 	turnBlock := head.Number - (head.Number % int64(registry.BlockCountPerTurn)) - lookback
 	block, err := ex.ethClient.HeadByNumber(ctx, big.NewInt(turnBlock))
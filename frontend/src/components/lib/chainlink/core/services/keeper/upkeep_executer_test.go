@@ -325,6 +325,64 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 	})
 }
 
+func Test_UpkeepExecuter_DryRun(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db, cfg, ethMock, _, _, _, _, jpv2, _, keyStore, ch, orm := setup(t, mockEstimator(t), func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(testutils.SimulatedChainID)
+	})
+
+	registry, jb := cltest.MustInsertKeeperRegistry(t, db, orm, keyStore.Eth(), 0, 1, 20)
+	jb.KeeperSpec.DryRun = true
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, registry)
+
+	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.Registry1_1ABI, registry.ContractAddress.Address())
+	registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+	registryMock.MockMatchedResponse(
+		"performUpkeep",
+		func(callArgs ethereum.CallMsg) bool { return true },
+		checkPerformResponse,
+	)
+	ethMock.On("EstimateGas", mock.Anything, mock.Anything).Return(uint64(123_456), nil)
+
+	lggr := logger.TestLogger(t)
+	// no CreateTransaction expectation is set on the tx manager, so broadcasting would fail the test
+	executer := keeper.NewUpkeepExecuter(jb, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), ch.GasEstimator(), lggr, cfg.Keeper(), jb.KeeperSpec.FromAddress.Address())
+	servicetest.Run(t, executer)
+
+	head := newHead()
+	executer.OnNewLongestChain(ctx, &head)
+	runs := cltest.WaitForPipelineComplete(t, 0, jb.ID, 1, 9, jpv2.Jrm, time.Second, 100*time.Millisecond)
+	require.Len(t, runs, 1)
+	assert.False(t, runs[0].HasErrors())
+
+	var txs []keeper.DryRunTransaction
+	require.Eventually(t, func() bool {
+		var err error
+		txs, err = orm.DryRunTransactionsForJob(ctx, jb.ID, 10)
+		require.NoError(t, err)
+		return len(txs) == 1
+	}, time.Second*2, time.Millisecond*100)
+	assert.Equal(t, upkeep.UpkeepID.String(), txs[0].UpkeepID.String())
+	assert.Equal(t, registry.ContractAddress, txs[0].ContractAddress)
+	assert.Equal(t, int64(20), txs[0].BlockNumber)
+	assert.Equal(t, uint64(5_000_000+cfg.Keeper().Registry().PerformGasOverhead()), txs[0].GasLimit)
+	assert.Equal(t, int64(123_456), txs[0].GasEstimate.Int64)
+	assert.True(t, txs[0].SimulationSuccess)
+	assert.Nil(t, txs[0].SimulationError)
+	assert.Equal(t, keeper.Registry1_1ABI.Methods["performUpkeep"].ID, txs[0].Calldata[:4])
+
+	// dry runs do not affect which keeper performs the upkeep next
+	require.NoError(t, db.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	assert.Equal(t, int64(0), upkeep.LastRunBlockHeight)
+
+	txStore := txmgr.NewTxStore(db, logger.TestLogger(t))
+	txes, err := txStore.GetAllTxes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, txes)
+}
+
 func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	t.Parallel()
 
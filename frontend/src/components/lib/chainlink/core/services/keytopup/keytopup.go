@@ -0,0 +1,315 @@
+package keytopup
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+var (
+	promTopUps = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "key_top_ups",
+		Help: "The number of top-ups of a sending key from the treasury key",
+	}, []string{"evmChainID", "address"})
+	promTopUpSpendCapReached = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "key_top_up_spend_cap_reached",
+		Help: "Set to 1 when the treasury key of a chain has sent its maximum spend over the spend window",
+	}, []string{"evmChainID", "address"})
+)
+
+// ChainTopUps tops up the sending keys of a chain from its treasury key.
+type ChainTopUps struct {
+	lggr     logger.Logger
+	orm      ORM
+	cfg      config.KeyTopUpChain
+	chainID  *big.Int
+	treasury common.Address
+
+	// keys returns the enabled sending keys of the chain
+	keys func(ctx context.Context) ([]common.Address, error)
+	// balanceAt returns the current balance of address
+	balanceAt func(ctx context.Context, address common.Address) (*big.Int, error)
+	// fee returns the fee the treasury key pays to send a top-up
+	fee func(ctx context.Context) (*big.Int, error)
+	// send transfers amount from the treasury key to address, returning the
+	// ID of the transaction
+	send func(ctx context.Context, to common.Address, amount *big.Int) (int64, error)
+	now  func() time.Time
+
+	capReached bool
+}
+
+// NewChainTopUps creates a new ChainTopUps instance.
+func NewChainTopUps(
+	lggr logger.Logger,
+	orm ORM,
+	cfg config.KeyTopUpChain,
+	keys func(ctx context.Context) ([]common.Address, error),
+	balanceAt func(ctx context.Context, address common.Address) (*big.Int, error),
+	fee func(ctx context.Context) (*big.Int, error),
+	send func(ctx context.Context, to common.Address, amount *big.Int) (int64, error),
+) *ChainTopUps {
+	return &ChainTopUps{
+		lggr:      lggr.With("evmChainID", cfg.ChainID().String(), "treasury", cfg.TreasuryKey()),
+		orm:       orm,
+		cfg:       cfg,
+		chainID:   cfg.ChainID(),
+		treasury:  cfg.TreasuryKey(),
+		keys:      keys,
+		balanceAt: balanceAt,
+		fee:       fee,
+		send:      send,
+		now:       time.Now,
+	}
+}
+
+// Run checks the balance of every sending key of the chain, and tops up those
+// below the threshold back to the target. A key is not topped up again before
+// the cooldown or while its last top-up is unconfirmed, and the treasury key
+// sends at most the maximum spend over the spend window.
+func (t *ChainTopUps) Run(ctx context.Context) error {
+	keys, err := t.keys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting sending keys")
+	}
+
+	now := t.now()
+	spent, err := t.orm.TotalAmountSince(ctx, t.chainID, t.treasury, now.Add(-t.cfg.SpendWindow()))
+	if err != nil {
+		return err
+	}
+	remaining := new(big.Int).Sub(t.cfg.MaxSpend().ToInt(), spent)
+
+	treasuryBalance, err := t.balanceAt(ctx, t.treasury)
+	if err != nil {
+		return errors.Wrap(err, "getting treasury balance")
+	}
+	fee, err := t.fee(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting top-up fee")
+	}
+
+	threshold, target := t.cfg.Threshold().ToInt(), t.cfg.Target().ToInt()
+	capReached := remaining.Sign() <= 0
+	for _, address := range keys {
+		if capReached {
+			break
+		}
+		if address == t.treasury {
+			continue
+		}
+		lggr := t.lggr.With("address", address)
+
+		balance, err := t.balanceAt(ctx, address)
+		if err != nil {
+			lggr.Warnw("Failed to get sending key balance", "err", err)
+			continue
+		}
+		if balance.Cmp(threshold) >= 0 {
+			continue
+		}
+
+		// the balance does not include a top-up that is not confirmed yet
+		pending, err := t.orm.HasPendingTopUp(ctx, t.chainID, address)
+		if err != nil {
+			return err
+		}
+		if pending {
+			lggr.Debugw("Sending key is below the threshold but its last top-up is not confirmed yet", "balance", balance)
+			continue
+		}
+
+		last, err := t.orm.LastTopUp(ctx, t.chainID, address)
+		if err != nil {
+			return err
+		}
+		if last != nil && now.Sub(last.CreatedAt) < t.cfg.Cooldown() {
+			lggr.Debugw("Sending key is below the threshold but was topped up recently", "balance", balance, "lastTopUp", last.CreatedAt)
+			continue
+		}
+
+		amount := new(big.Int).Sub(target, balance)
+		capped := amount.Cmp(remaining) >= 0
+		if capped {
+			amount.Set(remaining)
+		}
+		cost := new(big.Int).Add(amount, fee)
+		if treasuryBalance.Cmp(cost) < 0 {
+			lggr.Errorw("Treasury balance is too low to top up sending key", "balance", balance, "amount", amount, "fee", fee, "treasuryBalance", treasuryBalance)
+			break
+		}
+
+		// the top-up is recorded before it is sent, so that it is never sent
+		// without counting towards the spend
+		topUp := TopUp{
+			EVMChainID:      *ubig.New(t.chainID),
+			TreasuryAddress: t.treasury,
+			ToAddress:       address,
+			Amount:          assets.NewWei(amount),
+			Balance:         assets.NewWei(balance),
+		}
+		if err = t.orm.InsertTopUp(ctx, &topUp); err != nil {
+			return err
+		}
+
+		txID, err := t.send(ctx, address, amount)
+		if err != nil {
+			lggr.Errorw("Failed to top up sending key", "amount", amount, "err", err)
+			if err = t.orm.DeleteTopUp(ctx, topUp.ID); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = t.orm.SetEthTxID(ctx, topUp.ID, txID); err != nil {
+			return err
+		}
+		promTopUps.WithLabelValues(t.chainID.String(), address.String()).Inc()
+		lggr.Infow("Topped up sending key", "balance", balance, "amount", amount, "ethTxID", txID)
+
+		remaining.Sub(remaining, amount)
+		treasuryBalance = new(big.Int).Sub(treasuryBalance, cost)
+		capReached = capped
+	}
+
+	t.setCapReached(capReached, spent)
+	return nil
+}
+
+func (t *ChainTopUps) setCapReached(capReached bool, spent *big.Int) {
+	gauge := promTopUpSpendCapReached.WithLabelValues(t.chainID.String(), t.treasury.String())
+	switch {
+	case capReached && !t.capReached:
+		gauge.Set(1)
+		t.lggr.Criticalw("Treasury key reached its maximum spend, sending keys are no longer topped up", "maxSpend", t.cfg.MaxSpend(), "spendWindow", t.cfg.SpendWindow(), "spent", spent)
+	case !capReached && t.capReached:
+		gauge.Set(0)
+		t.lggr.Infow("Treasury key is back under its maximum spend")
+	}
+	t.capReached = capReached
+}
+
+// Manager periodically tops up the sending keys of the configured chains.
+type Manager struct {
+	services.StateMachine
+	lggr         logger.Logger
+	orm          ORM
+	cfg          config.KeyTopUp
+	legacyChains legacyevm.LegacyChainContainer
+	ks           keystore.Eth
+
+	wg     sync.WaitGroup
+	stopCh services.StopChan
+}
+
+// NewManager creates a new Manager instance.
+func NewManager(lggr logger.Logger, orm ORM, cfg config.KeyTopUp, legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth) *Manager {
+	return &Manager{
+		lggr:         lggr.Named("KeyTopUp"),
+		orm:          orm,
+		cfg:          cfg,
+		legacyChains: legacyChains,
+		ks:           ks,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start starts topping up the sending keys of the configured chains. Chains
+// that are not enabled, or whose treasury key is not enabled, are skipped.
+func (m *Manager) Start(ctx context.Context) error {
+	return m.StartOnce("KeyTopUpManager", func() error {
+		for _, cfg := range m.cfg.Chains() {
+			lggr := m.lggr.With("evmChainID", cfg.ChainID().String(), "treasury", cfg.TreasuryKey())
+			chain, err := m.legacyChains.Get(cfg.ChainID().String())
+			if err != nil {
+				lggr.Errorw("Not topping up sending keys of chain", "err", err)
+				continue
+			}
+			if err = m.ks.CheckEnabled(ctx, cfg.TreasuryKey(), cfg.ChainID()); err != nil {
+				lggr.Errorw("Not topping up sending keys of chain", "err", err)
+				continue
+			}
+			m.launch(m.newChainTopUps(chain, cfg), cfg.PollInterval())
+		}
+		return nil
+	})
+}
+
+// Close stops topping up sending keys.
+func (m *Manager) Close() error {
+	return m.StopOnce("KeyTopUpManager", func() error {
+		close(m.stopCh)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+// TopUps returns the top-ups of all chains, or of chainID if it is not nil,
+// most recent first.
+func (m *Manager) TopUps(ctx context.Context, chainID *big.Int, offset, limit int) ([]TopUp, int, error) {
+	return m.orm.FindTopUps(ctx, chainID, offset, limit)
+}
+
+func (m *Manager) newChainTopUps(chain legacyevm.Chain, cfg config.KeyTopUpChain) *ChainTopUps {
+	chainID, treasury := cfg.ChainID(), cfg.TreasuryKey()
+	return NewChainTopUps(
+		m.lggr,
+		m.orm,
+		cfg,
+		func(ctx context.Context) ([]common.Address, error) {
+			return m.ks.EnabledAddressesForChain(ctx, chainID)
+		},
+		func(ctx context.Context, address common.Address) (*big.Int, error) {
+			return chain.Client().BalanceAt(ctx, address, nil)
+		},
+		func(ctx context.Context) (*big.Int, error) {
+			price, err := chain.Client().SuggestGasPrice(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return price.Mul(price, new(big.Int).SetUint64(chain.Config().EVM().GasEstimator().LimitTransfer())), nil
+		},
+		func(ctx context.Context, to common.Address, amount *big.Int) (int64, error) {
+			etx, err := chain.TxManager().SendNativeToken(ctx, chainID, treasury, to, *amount, chain.Config().EVM().GasEstimator().LimitTransfer())
+			if err != nil {
+				return 0, err
+			}
+			return etx.ID, nil
+		},
+	)
+}
+
+func (m *Manager) launch(t *ChainTopUps, pollInterval time.Duration) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ctx, cancel := m.stopCh.NewCtx()
+		defer cancel()
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			if err := t.Run(ctx); err != nil && ctx.Err() == nil {
+				t.lggr.Errorw("Failed to top up sending keys", "err", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
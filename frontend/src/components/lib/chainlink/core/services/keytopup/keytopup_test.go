@@ -0,0 +1,188 @@
+package keytopup
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type chainConfig struct {
+	treasury  common.Address
+	maxSpend  int64
+	threshold int64
+	target    int64
+}
+
+func (c chainConfig) ChainID() *big.Int           { return big.NewInt(1) }
+func (c chainConfig) TreasuryKey() common.Address { return c.treasury }
+func (c chainConfig) Threshold() *assets.Wei      { return assets.NewWeiI(c.threshold) }
+func (c chainConfig) Target() *assets.Wei         { return assets.NewWeiI(c.target) }
+func (c chainConfig) MaxSpend() *assets.Wei       { return assets.NewWeiI(c.maxSpend) }
+func (c chainConfig) SpendWindow() time.Duration  { return 24 * time.Hour }
+func (c chainConfig) Cooldown() time.Duration     { return time.Hour }
+func (c chainConfig) PollInterval() time.Duration { return time.Minute }
+
+type fakeORM struct {
+	ORM
+	topUps  []TopUp
+	pending map[common.Address]bool
+}
+
+func (o *fakeORM) InsertTopUp(_ context.Context, t *TopUp) error {
+	t.ID = int64(len(o.topUps) + 1)
+	t.CreatedAt = time.Now()
+	o.topUps = append(o.topUps, *t)
+	return nil
+}
+
+func (o *fakeORM) SetEthTxID(_ context.Context, id, ethTxID int64) error {
+	o.topUps[id-1].EthTxID = &ethTxID
+	return nil
+}
+
+func (o *fakeORM) DeleteTopUp(_ context.Context, id int64) error {
+	o.topUps = o.topUps[:id-1]
+	return nil
+}
+
+func (o *fakeORM) HasPendingTopUp(_ context.Context, _ *big.Int, address common.Address) (bool, error) {
+	return o.pending[address], nil
+}
+
+func (o *fakeORM) LastTopUp(_ context.Context, _ *big.Int, address common.Address) (*TopUp, error) {
+	for i := len(o.topUps) - 1; i >= 0; i-- {
+		if o.topUps[i].ToAddress == address {
+			return &o.topUps[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (o *fakeORM) TotalAmountSince(_ context.Context, _ *big.Int, _ common.Address, since time.Time) (*big.Int, error) {
+	total := big.NewInt(0)
+	for _, t := range o.topUps {
+		if !t.CreatedAt.Before(since) {
+			total.Add(total, t.Amount.ToInt())
+		}
+	}
+	return total, nil
+}
+
+type sent struct {
+	to     common.Address
+	amount int64
+}
+
+// topUpFee is the fee of every top-up in tests
+const topUpFee = 10
+
+func newChainTopUps(t *testing.T, cfg chainConfig, orm *fakeORM, balances map[common.Address]int64, keys ...common.Address) (*ChainTopUps, *[]sent) {
+	var txs []sent
+	return NewChainTopUps(
+		logger.TestLogger(t),
+		orm,
+		cfg,
+		func(context.Context) ([]common.Address, error) { return keys, nil },
+		func(_ context.Context, address common.Address) (*big.Int, error) {
+			return big.NewInt(balances[address]), nil
+		},
+		func(context.Context) (*big.Int, error) { return big.NewInt(topUpFee), nil },
+		func(_ context.Context, to common.Address, amount *big.Int) (int64, error) {
+			txs = append(txs, sent{to: to, amount: amount.Int64()})
+			balances[to] += amount.Int64()
+			return int64(len(txs)), nil
+		},
+	), &txs
+}
+
+func TestChainTopUps_Run(t *testing.T) {
+	treasury, low, high := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+	cfg := chainConfig{treasury: treasury, maxSpend: 1000, threshold: 100, target: 300}
+
+	t.Run("tops up keys below the threshold to the target", func(t *testing.T) {
+		orm := &fakeORM{}
+		balances := map[common.Address]int64{treasury: 10_000, low: 40, high: 150}
+		topUps, txs := newChainTopUps(t, cfg, orm, balances, treasury, low, high)
+
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		assert.Equal(t, []sent{{to: low, amount: 260}}, *txs)
+		require.Len(t, orm.topUps, 1)
+		assert.Equal(t, low, orm.topUps[0].ToAddress)
+		assert.Equal(t, treasury, orm.topUps[0].TreasuryAddress)
+		assert.Equal(t, "40", orm.topUps[0].Balance.ToInt().String())
+		assert.Equal(t, int64(1), *orm.topUps[0].EthTxID)
+	})
+
+	t.Run("does not top up a key again during the cooldown", func(t *testing.T) {
+		orm := &fakeORM{}
+		balances := map[common.Address]int64{treasury: 10_000, low: 40}
+		topUps, txs := newChainTopUps(t, cfg, orm, balances, low)
+
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		balances[low] = 40
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		assert.Len(t, *txs, 1)
+
+		topUps.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		assert.Len(t, *txs, 2)
+	})
+
+	t.Run("sends at most the maximum spend", func(t *testing.T) {
+		orm := &fakeORM{}
+		other := testutils.NewAddress()
+		balances := map[common.Address]int64{treasury: 10_000, low: 0, high: 0, other: 0}
+		topUps, txs := newChainTopUps(t, chainConfig{treasury: treasury, maxSpend: 500, threshold: 100, target: 300}, orm, balances, low, high, other)
+
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		assert.Equal(t, []sent{{to: low, amount: 300}, {to: high, amount: 200}}, *txs)
+		assert.True(t, topUps.capReached)
+	})
+
+	t.Run("does not top up from a treasury that is too low", func(t *testing.T) {
+		orm := &fakeORM{}
+		// enough for the amount, but not for the fee
+		balances := map[common.Address]int64{treasury: 260 + topUpFee - 1, low: 40}
+		topUps, txs := newChainTopUps(t, cfg, orm, balances, low)
+
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		assert.Empty(t, *txs)
+		assert.Empty(t, orm.topUps)
+
+		balances[treasury]++
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		assert.Equal(t, []sent{{to: low, amount: 260}}, *txs)
+	})
+
+	t.Run("does not top up a key whose last top-up is unconfirmed", func(t *testing.T) {
+		orm := &fakeORM{pending: map[common.Address]bool{low: true}}
+		balances := map[common.Address]int64{treasury: 10_000, low: 40, high: 40}
+		topUps, txs := newChainTopUps(t, cfg, orm, balances, low, high)
+
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		assert.Equal(t, []sent{{to: high, amount: 260}}, *txs)
+	})
+
+	t.Run("does not keep top-ups that failed to be sent", func(t *testing.T) {
+		orm := &fakeORM{}
+		balances := map[common.Address]int64{treasury: 10_000, low: 40}
+		topUps, _ := newChainTopUps(t, cfg, orm, balances, low)
+		topUps.send = func(context.Context, common.Address, *big.Int) (int64, error) {
+			require.Len(t, orm.topUps, 1, "top-up is recorded before it is sent")
+			return 0, errors.New("boom")
+		}
+
+		require.NoError(t, topUps.Run(testutils.Context(t)))
+		assert.Empty(t, orm.topUps)
+	})
+}
@@ -0,0 +1,126 @@
+package keytopup
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// TopUp is a transfer from the treasury key of a chain to a sending key whose
+// balance was below the threshold
+type TopUp struct {
+	ID              int64
+	EVMChainID      ubig.Big       `db:"evm_chain_id"`
+	TreasuryAddress common.Address `db:"treasury_address"`
+	ToAddress       common.Address `db:"to_address"`
+	Amount          *assets.Wei
+	// Balance is the balance of the sending key when it was topped up
+	Balance   *assets.Wei
+	EthTxID   *int64 `db:"eth_tx_id"`
+	CreatedAt time.Time
+}
+
+// ORM records the top-ups of sending keys
+type ORM interface {
+	// InsertTopUp records a top-up before it is sent, so that it counts
+	// towards the spend of the treasury even if sending it is interrupted
+	InsertTopUp(ctx context.Context, t *TopUp) error
+	// SetEthTxID records the transaction that sent top-up id
+	SetEthTxID(ctx context.Context, id, ethTxID int64) error
+	// DeleteTopUp deletes top-up id, which failed to be sent
+	DeleteTopUp(ctx context.Context, id int64) error
+	// FindTopUps returns the top-ups of all chains, or of chainID if it is not
+	// nil, most recent first
+	FindTopUps(ctx context.Context, chainID *big.Int, offset, limit int) ([]TopUp, int, error)
+	// LastTopUp returns the most recent top-up of address, or nil if it was
+	// never topped up
+	LastTopUp(ctx context.Context, chainID *big.Int, address common.Address) (*TopUp, error)
+	// HasPendingTopUp returns true if a top-up of address was sent by a
+	// transaction that is not confirmed yet
+	HasPendingTopUp(ctx context.Context, chainID *big.Int, address common.Address) (bool, error)
+	// TotalAmountSince returns the total sent from treasury since the given time
+	TotalAmountSince(ctx context.Context, chainID *big.Int, treasury common.Address, since time.Time) (*big.Int, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = &orm{}
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) InsertTopUp(ctx context.Context, t *TopUp) error {
+	err := o.ds.QueryRowxContext(ctx, `
+INSERT INTO key_top_ups (evm_chain_id, treasury_address, to_address, amount, balance, eth_tx_id, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW())
+RETURNING id, created_at`,
+		t.EVMChainID, t.TreasuryAddress, t.ToAddress, t.Amount, t.Balance, t.EthTxID).Scan(&t.ID, &t.CreatedAt)
+	return errors.Wrap(err, "failed to insert key top-up")
+}
+
+func (o *orm) SetEthTxID(ctx context.Context, id, ethTxID int64) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE key_top_ups SET eth_tx_id = $1 WHERE id = $2`, ethTxID, id)
+	return errors.Wrap(err, "failed to set key top-up transaction")
+}
+
+func (o *orm) DeleteTopUp(ctx context.Context, id int64) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM key_top_ups WHERE id = $1`, id)
+	return errors.Wrap(err, "failed to delete key top-up")
+}
+
+func (o *orm) FindTopUps(ctx context.Context, chainID *big.Int, offset, limit int) (topUps []TopUp, count int, err error) {
+	where, args := "", []any{}
+	if chainID != nil {
+		where, args = "WHERE evm_chain_id = $1", append(args, ubig.New(chainID))
+	}
+	if err = o.ds.GetContext(ctx, &count, `SELECT count(*) FROM key_top_ups `+where, args...); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count key top-ups")
+	}
+	args = append(args, offset, limit)
+	stmt := fmt.Sprintf(`SELECT * FROM key_top_ups %s ORDER BY id DESC OFFSET $%d LIMIT $%d`, where, len(args)-1, len(args))
+	err = o.ds.SelectContext(ctx, &topUps, stmt, args...)
+	return topUps, count, errors.Wrap(err, "failed to find key top-ups")
+}
+
+func (o *orm) LastTopUp(ctx context.Context, chainID *big.Int, address common.Address) (*TopUp, error) {
+	var topUps []TopUp
+	err := o.ds.SelectContext(ctx, &topUps, `SELECT * FROM key_top_ups WHERE evm_chain_id = $1 AND to_address = $2 ORDER BY created_at DESC LIMIT 1`, ubig.New(chainID), address)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find last key top-up")
+	}
+	if len(topUps) == 0 {
+		return nil, nil
+	}
+	return &topUps[0], nil
+}
+
+func (o *orm) HasPendingTopUp(ctx context.Context, chainID *big.Int, address common.Address) (pending bool, err error) {
+	err = o.ds.GetContext(ctx, &pending, `
+SELECT EXISTS (
+	SELECT 1 FROM key_top_ups k
+	JOIN evm.txes t ON t.id = k.eth_tx_id
+	WHERE k.evm_chain_id = $1 AND k.to_address = $2 AND t.state IN ('unstarted', 'in_progress', 'unconfirmed')
+)`, ubig.New(chainID), address)
+	return pending, errors.Wrap(err, "failed to find pending key top-ups")
+}
+
+func (o *orm) TotalAmountSince(ctx context.Context, chainID *big.Int, treasury common.Address, since time.Time) (*big.Int, error) {
+	var total assets.Wei
+	err := o.ds.GetContext(ctx, &total, `SELECT COALESCE(SUM(amount), 0) FROM key_top_ups WHERE evm_chain_id = $1 AND treasury_address = $2 AND created_at >= $3`, ubig.New(chainID), treasury, since)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum key top-ups")
+	}
+	return total.ToInt(), nil
+}
@@ -0,0 +1,80 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+)
+
+// HTTPTargetReport is the JSON body posted by HTTPTarget for every report
+type HTTPTargetReport struct {
+	ConfigDigest   string                `json:"configDigest"`
+	SeqNr          uint64                `json:"seqNr"`
+	ReportFormat   uint32                `json:"reportFormat"`
+	LifeCycleStage string                `json:"lifeCycleStage"`
+	Report         hexutil.Bytes         `json:"report"`
+	Signatures     []HTTPTargetSignature `json:"signatures"`
+}
+
+type HTTPTargetSignature struct {
+	Signer    uint8         `json:"signer"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+var _ ReportTransmitter = (*HTTPTarget)(nil)
+
+// HTTPTarget replays reports by posting them one by one as JSON to a URL,
+// e.g. the ingestion endpoint of a data warehouse
+type HTTPTarget struct {
+	client *http.Client
+	url    string
+}
+
+func NewHTTPTarget(client *http.Client, url string) *HTTPTarget {
+	return &HTTPTarget{client, url}
+}
+
+func (t *HTTPTarget) Transmit(ctx context.Context, digest ocrtypes.ConfigDigest, seqNr uint64, report ocr3types.ReportWithInfo[llotypes.ReportInfo], sigs []ocrtypes.AttributedOnchainSignature) error {
+	r := HTTPTargetReport{
+		ConfigDigest:   digest.Hex(),
+		SeqNr:          seqNr,
+		ReportFormat:   uint32(report.Info.ReportFormat),
+		LifeCycleStage: string(report.Info.LifeCycleStage),
+		Report:         hexutil.Bytes(report.Report),
+	}
+	for _, sig := range sigs {
+		r.Signatures = append(r.Signatures, HTTPTargetSignature{Signer: uint8(sig.Signer), Signature: sig.Signature})
+	}
+	body, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post report: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		// NOTE: Truncate the returned body here as we don't want to spam the
+		// logs with potentially huge messages
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("got error from %s: (status code: %d, response body: %s)", t.url, resp.StatusCode, string(b))
+	}
+	return nil
+}
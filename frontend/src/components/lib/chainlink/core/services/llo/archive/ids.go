@@ -0,0 +1,113 @@
+package archive
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/reportcodecs"
+)
+
+// reportIDs returns the channel ID and feed ID of report. They are decoded
+// from the report where its format carries them, and otherwise looked up in
+// the channel definitions: a channel is identified by its feed ID, or by
+// being the only channel of the report format. Either ID is nil if it cannot
+// be determined.
+func reportIDs(cdc llo.ChannelDefinitionCache, report ocr3types.ReportWithInfo[llotypes.ReportInfo]) (channelID *llotypes.ChannelID, feedID *common.Hash) {
+	channelID, feedID = decodeReportIDs(report)
+	if cdc == nil || (channelID != nil && feedID != nil) {
+		return channelID, feedID
+	}
+
+	defs := cdc.Definitions()
+	if channelID == nil {
+		var matches []llotypes.ChannelID
+		for cid, cd := range defs {
+			if cd.ReportFormat != report.Info.ReportFormat {
+				continue
+			}
+			if feedID == nil || feedIDFromOpts(cd.Opts) == *feedID {
+				matches = append(matches, cid)
+			}
+		}
+		if len(matches) != 1 {
+			return nil, feedID
+		}
+		channelID = &matches[0]
+	}
+	if feedID == nil {
+		if cd, ok := defs[*channelID]; ok {
+			if fid := feedIDFromOpts(cd.Opts); fid != (common.Hash{}) {
+				feedID = &fid
+			}
+		}
+	}
+	return channelID, feedID
+}
+
+// decodeReportIDs returns the channel ID and feed ID carried by the report
+// itself, if any.
+func decodeReportIDs(report ocr3types.ReportWithInfo[llotypes.ReportInfo]) (*llotypes.ChannelID, *common.Hash) {
+	var cid llotypes.ChannelID
+	switch report.Info.ReportFormat {
+	case llotypes.ReportFormatJSON:
+		r, err := llo.JSONReportCodec{}.Decode(report.Report)
+		if err != nil {
+			return nil, nil
+		}
+		cid = r.ChannelID
+	case reportcodecs.ReportFormatProtobuf:
+		r, err := reportcodecs.ReportCodecProtobuf{}.Decode(report.Report)
+		if err != nil {
+			return nil, nil
+		}
+		cid = r.ChannelID
+	case reportcodecs.ReportFormatCBOR:
+		r, err := reportcodecs.ReportCodecCBOR{}.Decode(report.Report)
+		if err != nil {
+			return nil, nil
+		}
+		cid = r.ChannelID
+	case llotypes.ReportFormatEVMPremiumLegacy, llotypes.ReportFormatEVMABIEncodeUnpacked:
+		// both are ABI encoded with the feed ID as the first word
+		if len(report.Report) < common.HashLength {
+			return nil, nil
+		}
+		fid := common.BytesToHash(report.Report[:common.HashLength])
+		return nil, &fid
+	case llotypes.ReportFormatEVMStreamlined:
+		// prefixed with either the report format and channel ID, or the
+		// feed ID
+		b := report.Report
+		if len(b) >= 8 && binary.BigEndian.Uint32(b[:4]) == uint32(llotypes.ReportFormatEVMStreamlined) {
+			cid = binary.BigEndian.Uint32(b[4:8])
+			break
+		}
+		if len(b) < common.HashLength {
+			return nil, nil
+		}
+		fid := common.BytesToHash(b[:common.HashLength])
+		return nil, &fid
+	default:
+		return nil, nil
+	}
+	return &cid, nil
+}
+
+// feedIDFromOpts returns the feed ID set in the options of an EVM channel,
+// or the zero hash.
+func feedIDFromOpts(opts []byte) common.Hash {
+	var o struct {
+		FeedID *common.Hash `json:"feedID"`
+	}
+	if len(opts) == 0 || json.Unmarshal(opts, &o) != nil || o.FeedID == nil {
+		return common.Hash{}
+	}
+	return *o.FeedID
+}
@@ -0,0 +1,176 @@
+package archive
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+)
+
+// Record is a signed report as it was handed to the transmitter
+type Record struct {
+	ID int64
+	// ChannelID and FeedID are nil if they cannot be determined from the
+	// report or the channel definitions, see reportIDs. FeedID is only set
+	// for channels with a feed ID.
+	ChannelID    *llotypes.ChannelID
+	FeedID       *common.Hash
+	ConfigDigest ocrtypes.ConfigDigest
+	SeqNr        uint64
+	Report       ocr3types.ReportWithInfo[llotypes.ReportInfo]
+	Sigs         []ocrtypes.AttributedOnchainSignature
+	ArchivedAt   time.Time
+}
+
+// ORM is an append-only store of transmitted reports, scoped to a single DON
+// ID
+type ORM interface {
+	DonID() uint32
+	Insert(ctx context.Context, r *Record) error
+	// Get returns records archived in [from, to) with ID greater than
+	// afterID, in ascending ID order. Passing a zero from or to leaves that
+	// end of the range open.
+	Get(ctx context.Context, from, to time.Time, afterID int64, limit int) ([]*Record, error)
+}
+
+type orm struct {
+	ds    sqlutil.DataSource
+	donID uint32
+}
+
+func NewORM(ds sqlutil.DataSource, donID uint32) ORM {
+	return &orm{ds, donID}
+}
+
+func (o *orm) DonID() uint32 {
+	return o.donID
+}
+
+// Insert appends r to the archive and sets its ID and ArchivedAt
+func (o *orm) Insert(ctx context.Context, r *Record) error {
+	if r.SeqNr > math.MaxInt64 {
+		// this is to appease the linter but shouldn't ever happen
+		return fmt.Errorf("seqNr is too large (got: %d, max: %d)", r.SeqNr, math.MaxInt64)
+	}
+	var channelID sql.NullInt64
+	if r.ChannelID != nil {
+		channelID = sql.NullInt64{Int64: int64(*r.ChannelID), Valid: true}
+	}
+	var feedID []byte
+	if r.FeedID != nil {
+		feedID = r.FeedID[:]
+	}
+	signatures := make(pq.ByteaArray, len(r.Sigs))
+	signers := make(pq.Int32Array, len(r.Sigs))
+	for i, sig := range r.Sigs {
+		signatures[i] = sig.Signature
+		signers[i] = int32(sig.Signer)
+	}
+	err := o.ds.QueryRowxContext(ctx, `
+		INSERT INTO llo_report_archive (don_id, channel_id, feed_id, config_digest, seq_nr, report, lifecycle_stage, report_format, signatures, signers)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, archived_at
+	`, o.donID, channelID, feedID, r.ConfigDigest[:], int64(r.SeqNr), []byte(r.Report.Report), string(r.Report.Info.LifeCycleStage), uint32(r.Report.Info.ReportFormat), signatures, signers).Scan(&r.ID, &r.ArchivedAt)
+	if err != nil {
+		return fmt.Errorf("llo archive orm: failed to insert record: %w", err)
+	}
+	return nil
+}
+
+func (o *orm) Get(ctx context.Context, from, to time.Time, afterID int64, limit int) ([]*Record, error) {
+	params := []interface{}{o.donID, afterID, limit}
+	var rangeClause string
+	if !from.IsZero() {
+		params = append(params, from)
+		rangeClause += fmt.Sprintf("\nAND archived_at >= $%d", len(params))
+	}
+	if !to.IsZero() {
+		params = append(params, to)
+		rangeClause += fmt.Sprintf("\nAND archived_at < $%d", len(params))
+	}
+	q := fmt.Sprintf(`
+		SELECT id, channel_id, feed_id, config_digest, seq_nr, report, lifecycle_stage, report_format, signatures, signers, archived_at
+		FROM llo_report_archive
+		WHERE don_id = $1 AND id > $2%s
+		ORDER BY id ASC
+		LIMIT $3
+		`, rangeClause)
+	rows, err := o.ds.QueryContext(ctx, q, params...)
+	if err != nil {
+		return nil, fmt.Errorf("llo archive orm: failed to get records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*Record
+	for rows.Next() {
+		var r Record
+		var channelID sql.NullInt64
+		var feedID, digest []byte
+		var signatures pq.ByteaArray
+		var signers pq.Int32Array
+
+		err := rows.Scan(
+			&r.ID,
+			&channelID,
+			&feedID,
+			&digest,
+			&r.SeqNr,
+			&r.Report.Report,
+			&r.Report.Info.LifeCycleStage,
+			&r.Report.Info.ReportFormat,
+			&signatures,
+			&signers,
+			&r.ArchivedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("llo archive orm: failed to scan record: %w", err)
+		}
+		if channelID.Valid {
+			if channelID.Int64 < 0 || channelID.Int64 > math.MaxUint32 {
+				return nil, fmt.Errorf("channel ID out of range: %d", channelID.Int64)
+			}
+			cid := llotypes.ChannelID(channelID.Int64)
+			r.ChannelID = &cid
+		}
+		if feedID != nil {
+			if len(feedID) != common.HashLength {
+				return nil, fmt.Errorf("llo archive orm: invalid feed ID length in record %d", r.ID)
+			}
+			fid := common.BytesToHash(feedID)
+			r.FeedID = &fid
+		}
+		r.ConfigDigest, err = ocrtypes.BytesToConfigDigest(digest)
+		if err != nil {
+			return nil, fmt.Errorf("llo archive orm: invalid config digest: %w", err)
+		}
+		if len(signatures) != len(signers) {
+			return nil, errors.New("signatures and signers must have the same length")
+		}
+		for i, sig := range signatures {
+			if signers[i] < 0 || signers[i] > math.MaxUint8 {
+				return nil, fmt.Errorf("signer out of range: %d", signers[i])
+			}
+			r.Sigs = append(r.Sigs, ocrtypes.AttributedOnchainSignature{
+				Signature: sig,
+				Signer:    commontypes.OracleID(signers[i]),
+			})
+		}
+		records = append(records, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("llo archive orm: failed to scan records: %w", err)
+	}
+	return records, nil
+}
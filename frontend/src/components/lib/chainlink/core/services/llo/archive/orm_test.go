@@ -0,0 +1,95 @@
+package archive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func makeSampleRecord(seqNr uint64) *Record {
+	return &Record{
+		ConfigDigest: ocrtypes.ConfigDigest{1, 2, 3, 4, 5, 6},
+		SeqNr:        seqNr,
+		Report: ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+			Report: ocrtypes.Report{1, 2, 3},
+			Info: llotypes.ReportInfo{
+				LifeCycleStage: llotypes.LifeCycleStage("production"),
+				ReportFormat:   llotypes.ReportFormatEVMPremiumLegacy,
+			},
+		},
+		Sigs: []ocrtypes.AttributedOnchainSignature{{Signature: []byte{4, 5, 6}, Signer: 1}, {Signature: []byte{7, 8, 9}, Signer: 3}},
+	}
+}
+
+func TestORM(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	donID := uint32(654321)
+	orm := NewORM(db, donID)
+	assert.Equal(t, donID, orm.DonID())
+
+	const n = 5
+	records := make([]*Record, n)
+	for i := range records {
+		records[i] = makeSampleRecord(uint64(i + 1))
+		if i == 0 {
+			cid := llotypes.ChannelID(42)
+			records[i].ChannelID = &cid
+			fid := common.Hash{0x0a}
+			records[i].FeedID = &fid
+		}
+		require.NoError(t, orm.Insert(ctx, records[i]))
+		assert.NotZero(t, records[i].ID)
+		assert.False(t, records[i].ArchivedAt.IsZero())
+	}
+
+	// records from other DONs are not visible
+	require.NoError(t, NewORM(db, donID+1).Insert(ctx, makeSampleRecord(1)))
+
+	t.Run("Get returns all records in archive order", func(t *testing.T) {
+		result, err := orm.Get(ctx, time.Time{}, time.Time{}, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, result, n)
+		for i, r := range result {
+			assert.Equal(t, records[i].ID, r.ID)
+			assert.Equal(t, records[i].SeqNr, r.SeqNr)
+			assert.Equal(t, records[i].ConfigDigest, r.ConfigDigest)
+			assert.Equal(t, records[i].Report, r.Report)
+			assert.Equal(t, records[i].Sigs, r.Sigs)
+			assert.Equal(t, records[i].ChannelID, r.ChannelID)
+			assert.Equal(t, records[i].FeedID, r.FeedID)
+		}
+	})
+	t.Run("Get paginates with afterID and limit", func(t *testing.T) {
+		result, err := orm.Get(ctx, time.Time{}, time.Time{}, records[1].ID, 2)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, records[2].ID, result[0].ID)
+		assert.Equal(t, records[3].ID, result[1].ID)
+	})
+	t.Run("Get filters by time range", func(t *testing.T) {
+		result, err := orm.Get(ctx, records[0].ArchivedAt.Add(-time.Hour), records[0].ArchivedAt.Add(time.Hour), 0, 100)
+		require.NoError(t, err)
+		assert.Len(t, result, n)
+
+		result, err = orm.Get(ctx, records[n-1].ArchivedAt.Add(time.Hour), time.Time{}, 0, 100)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+
+		result, err = orm.Get(ctx, time.Time{}, records[0].ArchivedAt.Add(-time.Hour), 0, 100)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
@@ -0,0 +1,145 @@
+package archive
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const (
+	// How often queued records are written to the archive
+	flushFrequency = time.Second
+	// Records are written synchronously rather than queued beyond this
+	maxQueueSize = 10_000
+	// How long Close keeps retrying to archive the queued records
+	closeFlushTimeout = 30 * time.Second
+)
+
+// Queue writes records to an archive in the background, in the order they
+// were added. Records that fail to be written are kept at the head of the
+// queue and retried on the next flush, so nothing is lost while the archive
+// is briefly unreachable. Once the queue is full, records are written
+// synchronously by Add instead, and only queued beyond the limit if that
+// fails as well. Queue is shared by the LLO and Mercury archives.
+type Queue[T any] struct {
+	lggr   logger.SugaredLogger
+	insert func(ctx context.Context, r T) error
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	records []T
+}
+
+// NewQueue creates a queue writing records with insert. Call Start to begin
+// writing them in the background.
+func NewQueue[T any](lggr logger.Logger, insert func(ctx context.Context, r T) error) *Queue[T] {
+	return &Queue[T]{
+		lggr:   logger.Sugared(lggr),
+		insert: insert,
+		stopCh: make(services.StopChan),
+	}
+}
+
+// Start starts writing queued records every flushFrequency.
+func (q *Queue[T]) Start() {
+	q.wg.Add(1)
+	go q.runFlushLoop()
+}
+
+// Close stops the background writes and writes the records that are left,
+// retrying for up to closeFlushTimeout.
+func (q *Queue[T]) Close() {
+	close(q.stopCh)
+	q.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), closeFlushTimeout)
+	defer cancel()
+	ticker := services.NewTicker(flushFrequency)
+	defer ticker.Stop()
+	for {
+		err := q.Flush(ctx)
+		if err == nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			q.lggr.Criticalw("Failed to archive queued reports before closing, they are lost", "count", q.Len(), "err", err)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Add queues r to be written to the archive.
+func (q *Queue[T]) Add(ctx context.Context, r T) {
+	q.mu.Lock()
+	full := len(q.records) >= maxQueueSize
+	if !full {
+		q.records = append(q.records, r)
+	}
+	q.mu.Unlock()
+	if !full {
+		return
+	}
+
+	err := q.insert(ctx, r)
+	if err == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.records = append(q.records, r)
+	q.lggr.Errorw("Archive queue is full and the archive is unreachable, queueing report beyond the limit", "queued", len(q.records), "err", err)
+}
+
+// Len returns the number of queued records.
+func (q *Queue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.records)
+}
+
+func (q *Queue[T]) runFlushLoop() {
+	defer q.wg.Done()
+
+	ctx, cancel := q.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(flushFrequency)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := q.Flush(ctx); err != nil && ctx.Err() == nil {
+				q.lggr.Warnw("Failed to archive reports, retrying", "queued", q.Len(), "err", err)
+			}
+		}
+	}
+}
+
+// Flush writes the queued records in order, stopping at the first failure.
+// The failed record and the ones after it stay queued, ahead of any record
+// added in the meantime.
+func (q *Queue[T]) Flush(ctx context.Context) error {
+	q.mu.Lock()
+	records := q.records
+	q.records = nil
+	q.mu.Unlock()
+
+	for i, r := range records {
+		if err := q.insert(ctx, r); err != nil {
+			q.mu.Lock()
+			q.records = append(records[i:len(records):len(records)], q.records...)
+			q.mu.Unlock()
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type fakeArchive struct {
+	err      error
+	archived []int
+}
+
+func (f *fakeArchive) insert(_ context.Context, r int) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.archived = append(f.archived, r)
+	return nil
+}
+
+func Test_Queue(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+
+	t.Run("retries failed records in order on the next flush", func(t *testing.T) {
+		archive := &fakeArchive{err: errors.New("db down")}
+		q := NewQueue(lggr, archive.insert)
+		q.Add(ctx, 1)
+		q.Add(ctx, 2)
+
+		require.ErrorContains(t, q.Flush(ctx), "db down")
+		assert.Equal(t, 2, q.Len())
+
+		q.Add(ctx, 3)
+		archive.err = nil
+		require.NoError(t, q.Flush(ctx))
+		assert.Zero(t, q.Len())
+		assert.Equal(t, []int{1, 2, 3}, archive.archived)
+	})
+
+	t.Run("archives synchronously once full", func(t *testing.T) {
+		archive := &fakeArchive{}
+		q := NewQueue(lggr, archive.insert)
+		for i := 0; i < maxQueueSize; i++ {
+			q.Add(ctx, i)
+		}
+		assert.Empty(t, archive.archived)
+
+		q.Add(ctx, maxQueueSize)
+		assert.Equal(t, []int{maxQueueSize}, archive.archived)
+		assert.Equal(t, maxQueueSize, q.Len())
+	})
+
+	t.Run("keeps records beyond the limit rather than dropping them", func(t *testing.T) {
+		archive := &fakeArchive{err: errors.New("db down")}
+		q := NewQueue(lggr, archive.insert)
+		for i := 0; i <= maxQueueSize; i++ {
+			q.Add(ctx, i)
+		}
+		assert.Equal(t, maxQueueSize+1, q.Len())
+	})
+
+	t.Run("archives queued records on close", func(t *testing.T) {
+		archive := &fakeArchive{}
+		q := NewQueue(lggr, archive.insert)
+		q.Start()
+		q.Add(ctx, 1)
+		q.Close()
+		assert.Equal(t, []int{1}, archive.archived)
+	})
+}
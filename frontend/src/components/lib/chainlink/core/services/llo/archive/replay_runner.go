@@ -0,0 +1,173 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// How many finished replays are kept for status queries
+const maxFinishedReplays = 100
+
+// ReplayState is the state of a replay started by a ReplayRunner
+type ReplayState string
+
+const (
+	ReplayStateRunning   ReplayState = "running"
+	ReplayStateCompleted ReplayState = "completed"
+	// ReplayStateErrored means the replay stopped on an error, or because
+	// the node stopped. Replayed is the number of reports replayed before
+	// that, so the replay can be resumed from the following report.
+	ReplayStateErrored ReplayState = "errored"
+)
+
+// ReplayStatus describes a replay of a range of archived reports
+type ReplayStatus struct {
+	ID int64
+	// Archive identifies the replayed archive, e.g. "llo/1" for the archive
+	// of DON 1
+	Archive    string
+	From, To   time.Time
+	State      ReplayState
+	Replayed   int
+	Error      string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// ReplayFunc replays a range of archived reports, returning how many were
+// replayed
+type ReplayFunc func(ctx context.Context) (int, error)
+
+// ReplayRunner runs replays of archived reports in the background, so that
+// replaying a large range does not depend on the request that started it.
+// Replays are not persisted and are stopped when the node stops.
+type ReplayRunner struct {
+	services.StateMachine
+	lggr logger.SugaredLogger
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	nextID  int64
+	replays []*ReplayStatus
+}
+
+// NewReplayRunner creates a new ReplayRunner instance.
+func NewReplayRunner(lggr logger.Logger) *ReplayRunner {
+	return &ReplayRunner{
+		lggr:   logger.Sugared(lggr).Named("ReportArchiveReplays"),
+		stopCh: make(services.StopChan),
+	}
+}
+
+func (r *ReplayRunner) Start(context.Context) error {
+	return r.StartOnce("ReportArchiveReplayRunner", func() error { return nil })
+}
+
+// Close stops the running replays.
+func (r *ReplayRunner) Close() error {
+	return r.StopOnce("ReportArchiveReplayRunner", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+// Run starts replaying the reports of archive in [from, to) with fn.
+func (r *ReplayRunner) Run(archive string, from, to time.Time, fn ReplayFunc) (status ReplayStatus, err error) {
+	if !r.IfStarted(func() { status = r.launch(archive, from, to, fn) }) {
+		return ReplayStatus{}, errors.New("report archive replay runner is not started")
+	}
+	return status, nil
+}
+
+func (r *ReplayRunner) launch(archive string, from, to time.Time, fn ReplayFunc) ReplayStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	status := &ReplayStatus{
+		ID:        r.nextID,
+		Archive:   archive,
+		From:      from,
+		To:        to,
+		State:     ReplayStateRunning,
+		StartedAt: time.Now(),
+	}
+	r.replays = append(r.replays, status)
+	r.pruneLocked()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ctx, cancel := r.stopCh.NewCtx()
+		defer cancel()
+		r.run(ctx, status, fn)
+	}()
+	return *status
+}
+
+func (r *ReplayRunner) run(ctx context.Context, status *ReplayStatus, fn ReplayFunc) {
+	lggr := r.lggr.With("replayID", status.ID, "archive", status.Archive, "from", status.From, "to", status.To)
+	lggr.Infow("Replaying archived reports")
+	n, err := fn(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	status.Replayed, status.FinishedAt = n, &now
+	if err != nil {
+		lggr.Errorw("Replay of archived reports failed", "replayed", n, "err", err)
+		status.State, status.Error = ReplayStateErrored, err.Error()
+		return
+	}
+	lggr.Infow("Replay of archived reports completed", "replayed", n)
+	status.State = ReplayStateCompleted
+}
+
+// pruneLocked drops the oldest finished replays beyond maxFinishedReplays.
+func (r *ReplayRunner) pruneLocked() {
+	finished := 0
+	for _, s := range r.replays {
+		if s.State != ReplayStateRunning {
+			finished++
+		}
+	}
+	r.replays = slices.DeleteFunc(r.replays, func(s *ReplayStatus) bool {
+		if finished <= maxFinishedReplays || s.State == ReplayStateRunning {
+			return false
+		}
+		finished--
+		return true
+	})
+}
+
+// Replays returns the running and recently finished replays, most recent
+// first.
+func (r *ReplayRunner) Replays() []ReplayStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	replays := make([]ReplayStatus, 0, len(r.replays))
+	for i := len(r.replays) - 1; i >= 0; i-- {
+		replays = append(replays, *r.replays[i])
+	}
+	return replays
+}
+
+// Replay returns the replay with the given ID, if it is still known.
+func (r *ReplayRunner) Replay(id int64) (ReplayStatus, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.replays {
+		if s.ID == id {
+			return *s, true
+		}
+	}
+	return ReplayStatus{}, false
+}
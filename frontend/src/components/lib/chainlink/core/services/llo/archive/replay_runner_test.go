@@ -0,0 +1,53 @@
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func Test_ReplayRunner(t *testing.T) {
+	ctx := testutils.Context(t)
+	r := NewReplayRunner(logger.Test(t))
+
+	_, err := r.Run("llo/1", time.Time{}, time.Time{}, func(context.Context) (int, error) { return 0, nil })
+	require.ErrorContains(t, err, "not started")
+
+	require.NoError(t, r.Start(ctx))
+
+	completed, err := r.Run("llo/1", time.Time{}, time.Time{}, func(context.Context) (int, error) { return 3, nil })
+	require.NoError(t, err)
+	assert.Equal(t, ReplayStateRunning, completed.State)
+	require.Eventually(t, func() bool {
+		s, ok := r.Replay(completed.ID)
+		return ok && s.State == ReplayStateCompleted && s.Replayed == 3
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	started := make(chan struct{})
+	stopped, err := r.Run("mercury/2", time.Time{}, time.Time{}, func(ctx context.Context) (int, error) {
+		close(started)
+		<-ctx.Done()
+		return 1, ctx.Err()
+	})
+	require.NoError(t, err)
+	<-started
+
+	replays := r.Replays()
+	require.Len(t, replays, 2)
+	assert.Equal(t, stopped.ID, replays[0].ID)
+
+	// closing stops running replays
+	require.NoError(t, r.Close())
+	s, ok := r.Replay(stopped.ID)
+	require.True(t, ok)
+	assert.Equal(t, ReplayStateErrored, s.State)
+	assert.Equal(t, 1, s.Replayed)
+	assert.NotNil(t, s.FinishedAt)
+}
@@ -0,0 +1,118 @@
+// Package archive keeps a local, append-only record of every signed LLO
+// report handed to the transmitter, so that ranges of reports can be
+// queried and replayed after the fact (e.g. to backfill a downstream store
+// after a Mercury outage).
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+const (
+	// How many records are loaded per query during replay
+	replayBatchSize = 1000
+)
+
+type Transmitter interface {
+	llotypes.Transmitter
+	services.Service
+}
+
+// ReportTransmitter is the subset of llotypes.Transmitter needed to replay
+// reports
+type ReportTransmitter interface {
+	Transmit(ctx context.Context, digest ocrtypes.ConfigDigest, seqNr uint64, report ocr3types.ReportWithInfo[llotypes.ReportInfo], sigs []ocrtypes.AttributedOnchainSignature) error
+}
+
+var _ Transmitter = (*transmitter)(nil)
+
+// transmitter queues every report for archiving before passing it on to the
+// wrapped transmitter
+type transmitter struct {
+	Transmitter
+	cdc   llo.ChannelDefinitionCache
+	queue *Queue[*Record]
+
+	once services.StateMachine
+}
+
+// NewTransmitter wraps t so that every report is written to orm. Reports are
+// archived asynchronously so that archiving never fails transmission, see
+// Queue. The channel definitions in cdc, which may be nil, identify the
+// channel and feed of report formats that do not carry both.
+func NewTransmitter(lggr logger.Logger, orm ORM, cdc llo.ChannelDefinitionCache, t Transmitter) Transmitter {
+	return &transmitter{
+		Transmitter: t,
+		cdc:         cdc,
+		queue:       NewQueue(logger.Named(lggr, "ReportArchive"), orm.Insert),
+	}
+}
+
+func (t *transmitter) Start(ctx context.Context) error {
+	return t.once.StartOnce("LLOReportArchive", func() error {
+		if err := t.Transmitter.Start(ctx); err != nil {
+			return err
+		}
+		t.queue.Start()
+		return nil
+	})
+}
+
+func (t *transmitter) Close() error {
+	return t.once.StopOnce("LLOReportArchive", func() error {
+		t.queue.Close()
+		return t.Transmitter.Close()
+	})
+}
+
+func (t *transmitter) Transmit(
+	ctx context.Context,
+	digest ocrtypes.ConfigDigest,
+	seqNr uint64,
+	report ocr3types.ReportWithInfo[llotypes.ReportInfo],
+	sigs []ocrtypes.AttributedOnchainSignature,
+) error {
+	channelID, feedID := reportIDs(t.cdc, report)
+	t.queue.Add(ctx, &Record{
+		ChannelID:    channelID,
+		FeedID:       feedID,
+		ConfigDigest: digest,
+		SeqNr:        seqNr,
+		Report:       report,
+		Sigs:         sigs,
+	})
+	return t.Transmitter.Transmit(ctx, digest, seqNr, report, sigs)
+}
+
+// Replay transmits every record archived in [from, to) to target, in the
+// order they were archived, and returns the number of records replayed.
+// Replay stops at the first transmit error.
+func Replay(ctx context.Context, orm ORM, from, to time.Time, target ReportTransmitter) (n int, err error) {
+	var afterID int64
+	for {
+		records, err := orm.Get(ctx, from, to, afterID, replayBatchSize)
+		if err != nil {
+			return n, err
+		}
+		for _, r := range records {
+			if err := target.Transmit(ctx, r.ConfigDigest, r.SeqNr, r.Report, r.Sigs); err != nil {
+				return n, fmt.Errorf("failed to replay archived report %d (digest: %s, seqNr: %d): %w", r.ID, r.ConfigDigest, r.SeqNr, err)
+			}
+			n++
+			afterID = r.ID
+		}
+		if len(records) < replayBatchSize {
+			return n, nil
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+type transmission struct {
+	digest ocrtypes.ConfigDigest
+	seqNr  uint64
+	report ocr3types.ReportWithInfo[llotypes.ReportInfo]
+	sigs   []ocrtypes.AttributedOnchainSignature
+}
+
+type mockTransmitter struct {
+	services.Service
+	err           error
+	transmissions []transmission
+}
+
+func (m *mockTransmitter) Start(context.Context) error { return nil }
+
+func (m *mockTransmitter) Close() error { return nil }
+
+func (m *mockTransmitter) Transmit(ctx context.Context, digest ocrtypes.ConfigDigest, seqNr uint64, report ocr3types.ReportWithInfo[llotypes.ReportInfo], sigs []ocrtypes.AttributedOnchainSignature) error {
+	m.transmissions = append(m.transmissions, transmission{digest, seqNr, report, sigs})
+	return m.err
+}
+
+func (m *mockTransmitter) FromAccount(context.Context) (ocrtypes.Account, error) {
+	return "", nil
+}
+
+type failingORM struct{ ORM }
+
+type staticChannelDefinitions llotypes.ChannelDefinitions
+
+func (s staticChannelDefinitions) Definitions() llotypes.ChannelDefinitions {
+	return llotypes.ChannelDefinitions(s)
+}
+
+func (failingORM) Insert(context.Context, *Record) error { return errors.New("insert failed") }
+
+func Test_Transmitter(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db, 1)
+
+	jsonReport, err := llo.JSONReportCodec{}.Encode(llo.Report{
+		ConfigDigest: ocrtypes.ConfigDigest{1},
+		SeqNr:        1,
+		ChannelID:    7,
+		Values:       []llo.StreamValue{},
+	}, llotypes.ChannelDefinition{})
+	require.NoError(t, err)
+
+	t.Run("archives reports and forwards them to the wrapped transmitter", func(t *testing.T) {
+		inner := &mockTransmitter{}
+		tr := NewTransmitter(lggr, orm, nil, inner)
+
+		r := makeSampleRecord(1)
+		require.NoError(t, tr.Transmit(ctx, r.ConfigDigest, r.SeqNr, r.Report, r.Sigs))
+		jsonRecord := makeSampleRecord(2)
+		jsonRecord.Report.Report = jsonReport
+		jsonRecord.Report.Info.ReportFormat = llotypes.ReportFormatJSON
+		require.NoError(t, tr.Transmit(ctx, jsonRecord.ConfigDigest, jsonRecord.SeqNr, jsonRecord.Report, jsonRecord.Sigs))
+
+		assert.Len(t, inner.transmissions, 2)
+
+		// reports are archived asynchronously
+		records, err := orm.Get(ctx, time.Time{}, time.Time{}, 0, 100)
+		require.NoError(t, err)
+		require.Empty(t, records)
+		require.NoError(t, tr.(*transmitter).queue.Flush(ctx))
+
+		records, err = orm.Get(ctx, time.Time{}, time.Time{}, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Nil(t, records[0].ChannelID)
+		require.NotNil(t, records[1].ChannelID)
+		assert.Equal(t, llotypes.ChannelID(7), *records[1].ChannelID)
+	})
+
+	t.Run("identifies the channel and feed of EVM reports from the channel definitions", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		orm := NewORM(db, 3)
+		feedID := common.Hash{0x00, 0x03, 0xab}
+		cdc := staticChannelDefinitions{
+			9:  {ReportFormat: llotypes.ReportFormatEVMPremiumLegacy, Opts: []byte(`{"feedID":"` + feedID.Hex() + `"}`)},
+			10: {ReportFormat: llotypes.ReportFormatEVMPremiumLegacy, Opts: []byte(`{"feedID":"` + common.Hash{0x01}.Hex() + `"}`)},
+			11: {ReportFormat: llotypes.ReportFormatJSON},
+		}
+		tr := NewTransmitter(lggr, orm, cdc, &mockTransmitter{})
+
+		evmRecord := makeSampleRecord(1)
+		evmRecord.Report.Report = append(feedID.Bytes(), make([]byte, 64)...)
+		require.NoError(t, tr.Transmit(ctx, evmRecord.ConfigDigest, evmRecord.SeqNr, evmRecord.Report, evmRecord.Sigs))
+		jsonRecord := makeSampleRecord(2)
+		jsonRecord.Report.Info.ReportFormat = llotypes.ReportFormatJSON
+		jsonRecord.Report.Report = jsonReport
+		require.NoError(t, tr.Transmit(ctx, jsonRecord.ConfigDigest, jsonRecord.SeqNr, jsonRecord.Report, jsonRecord.Sigs))
+		require.NoError(t, tr.(*transmitter).queue.Flush(ctx))
+
+		records, err := orm.Get(ctx, time.Time{}, time.Time{}, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.NotNil(t, records[0].ChannelID)
+		assert.Equal(t, llotypes.ChannelID(9), *records[0].ChannelID)
+		require.NotNil(t, records[0].FeedID)
+		assert.Equal(t, feedID, *records[0].FeedID)
+		// the channel ID is decoded from the report, even if unknown
+		require.NotNil(t, records[1].ChannelID)
+		assert.Equal(t, llotypes.ChannelID(7), *records[1].ChannelID)
+		assert.Nil(t, records[1].FeedID)
+	})
+
+	t.Run("still transmits and keeps the report queued if archiving fails", func(t *testing.T) {
+		inner := &mockTransmitter{}
+		tr := NewTransmitter(lggr, failingORM{orm}, nil, inner)
+
+		r := makeSampleRecord(3)
+		require.NoError(t, tr.Transmit(ctx, r.ConfigDigest, r.SeqNr, r.Report, r.Sigs))
+		assert.Len(t, inner.transmissions, 1)
+		queue := tr.(*transmitter).queue
+		require.ErrorContains(t, queue.Flush(ctx), "insert failed")
+		assert.Equal(t, 1, queue.Len())
+	})
+
+	t.Run("archives queued reports on close", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		orm := NewORM(db, 2)
+		tr := NewTransmitter(lggr, orm, nil, &mockTransmitter{})
+		require.NoError(t, tr.Start(ctx))
+
+		r := makeSampleRecord(4)
+		require.NoError(t, tr.Transmit(ctx, r.ConfigDigest, r.SeqNr, r.Report, r.Sigs))
+		require.NoError(t, tr.Close())
+
+		records, err := orm.Get(ctx, time.Time{}, time.Time{}, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, r.SeqNr, records[0].SeqNr)
+	})
+}
+
+func Test_Replay(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db, 1)
+
+	const n = 3
+	for i := 0; i < n; i++ {
+		require.NoError(t, orm.Insert(ctx, makeSampleRecord(uint64(i+1))))
+	}
+
+	t.Run("replays all records in range in order", func(t *testing.T) {
+		target := &mockTransmitter{}
+		replayed, err := Replay(ctx, orm, time.Time{}, time.Time{}, target)
+		require.NoError(t, err)
+		assert.Equal(t, n, replayed)
+		require.Len(t, target.transmissions, n)
+		for i, tr := range target.transmissions {
+			expected := makeSampleRecord(uint64(i + 1))
+			assert.Equal(t, expected.SeqNr, tr.seqNr)
+			assert.Equal(t, expected.ConfigDigest, tr.digest)
+			assert.Equal(t, expected.Report, tr.report)
+			assert.Equal(t, expected.Sigs, tr.sigs)
+		}
+	})
+
+	t.Run("replays nothing outside of range", func(t *testing.T) {
+		target := &mockTransmitter{}
+		replayed, err := Replay(ctx, orm, time.Now().Add(time.Hour), time.Time{}, target)
+		require.NoError(t, err)
+		assert.Zero(t, replayed)
+		assert.Empty(t, target.transmissions)
+	})
+
+	t.Run("stops at the first transmit error", func(t *testing.T) {
+		target := &mockTransmitter{err: errors.New("boom")}
+		replayed, err := Replay(ctx, orm, time.Time{}, time.Time{}, target)
+		require.ErrorContains(t, err, "boom")
+		assert.Zero(t, replayed)
+		assert.Len(t, target.transmissions, 1)
+	})
+}
@@ -16,20 +16,22 @@ type ChannelDefinitionCacheFactory interface {
 
 var _ ChannelDefinitionCacheFactory = &channelDefinitionCacheFactory{}
 
-func NewChannelDefinitionCacheFactory(lggr logger.Logger, orm ChannelDefinitionCacheORM, lp logpoller.LogPoller, client *http.Client) ChannelDefinitionCacheFactory {
+func NewChannelDefinitionCacheFactory(lggr logger.Logger, orm ChannelDefinitionCacheORM, lp logpoller.LogPoller, client *http.Client, chainSelector uint64) ChannelDefinitionCacheFactory {
 	return &channelDefinitionCacheFactory{
 		lggr,
 		orm,
 		lp,
 		client,
+		chainSelector,
 	}
 }
 
 type channelDefinitionCacheFactory struct {
-	lggr   logger.Logger
-	orm    ChannelDefinitionCacheORM
-	lp     logpoller.LogPoller
-	client *http.Client
+	lggr          logger.Logger
+	orm           ChannelDefinitionCacheORM
+	lp            logpoller.LogPoller
+	client        *http.Client
+	chainSelector uint64
 }
 
 func (f *channelDefinitionCacheFactory) NewCache(cfg lloconfig.PluginConfig) (llotypes.ChannelDefinitionCache, error) {
@@ -37,6 +39,10 @@ func (f *channelDefinitionCacheFactory) NewCache(cfg lloconfig.PluginConfig) (ll
 		return NewStaticChannelDefinitionCache(f.lggr, cfg.ChannelDefinitions)
 	}
 
+	if src := cfg.ChannelDefinitionsSource; src != nil {
+		return NewSignedChannelDefinitionCache(f.lggr, f.orm, f.client, f.chainSelector, cfg.DonID, src.URL, src.SignatureURL, src.Signers, src.PollInterval.Duration())
+	}
+
 	addr := cfg.ChannelDefinitionsContractAddress
 	fromBlock := cfg.ChannelDefinitionsContractFromBlock
 	donID := cfg.DonID
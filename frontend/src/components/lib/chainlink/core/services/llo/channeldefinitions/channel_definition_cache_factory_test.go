@@ -12,7 +12,7 @@ import (
 
 func Test_ChannelDefinitionCacheFactory(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	cdcFactory := NewChannelDefinitionCacheFactory(lggr, nil, nil, nil)
+	cdcFactory := NewChannelDefinitionCacheFactory(lggr, &mockCDCORM{}, nil, nil, 1)
 
 	t.Run("NewCache", func(t *testing.T) {
 		t.Run("when ChannelDefinitions is present, returns static cache", func(t *testing.T) {
@@ -23,6 +23,17 @@ func Test_ChannelDefinitionCacheFactory(t *testing.T) {
 			require.NoError(t, err)
 			require.IsType(t, &staticCDC{}, cdc)
 		})
+		t.Run("when ChannelDefinitionsSource is present, returns signed cache", func(t *testing.T) {
+			_, err := cdcFactory.NewCache(lloconfig.PluginConfig{ChannelDefinitionsSource: &lloconfig.ChannelDefinitionsSourceConfig{URL: "/tmp/definitions.json"}})
+			require.EqualError(t, err, "at least one signer must be specified")
+
+			cdc, err := cdcFactory.NewCache(lloconfig.PluginConfig{ChannelDefinitionsSource: &lloconfig.ChannelDefinitionsSourceConfig{
+				URL:     "/tmp/definitions.json",
+				Signers: []common.Address{common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")},
+			}})
+			require.NoError(t, err)
+			require.IsType(t, &signedCDC{}, cdc)
+		})
 		t.Run("when ChannelDefinitions is not present, returns dynamic cache", func(t *testing.T) {
 			cdc, err := cdcFactory.NewCache(lloconfig.PluginConfig{
 				ChannelDefinitionsContractAddress: common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
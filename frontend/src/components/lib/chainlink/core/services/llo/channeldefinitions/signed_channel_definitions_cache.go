@@ -0,0 +1,361 @@
+package channeldefinitions
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
+)
+
+const (
+	// How often we poll the signed source if not otherwise specified
+	defaultSignedSourcePollInterval = 1 * time.Minute
+	// Detached signatures are looked up next to the document unless
+	// otherwise specified
+	signatureURLSuffix = ".sig"
+	// A hex encoded 65-byte signature with optional 0x prefix and whitespace
+	maxSignatureFileSize = 1024
+	// Prefixed to the signed hash so that a signature over channel
+	// definitions cannot be reused in any other context
+	channelDefinitionsSigningDomain = "chainlink-llo-channel-definitions-v1"
+)
+
+// Signed sources have no contract address, so their definitions are
+// persisted under the zero address and the DON ID
+var signedSourceAddress = common.Address{}
+
+// SignedChannelDefinitions is the document served by a signed source. The
+// detached signature is a hex-encoded secp256k1 signature over
+// ChannelDefinitionsSigningHash of the exact document bytes.
+type SignedChannelDefinitions struct {
+	Version            uint32                      `json:"version"`
+	ChannelDefinitions llotypes.ChannelDefinitions `json:"channelDefinitions"`
+}
+
+var _ llotypes.ChannelDefinitionCache = &signedCDC{}
+
+// A CDC that polls channel definitions from an HTTP(S) URL or local file
+// and accepts them only if signed by one of the configured signers. As with
+// the on-chain cache, a new document is only applied if its version is
+// greater than the current one, and the accepted version is persisted so
+// that older documents cannot be replayed after a restart.
+type signedCDC struct {
+	services.StateMachine
+	lggr logger.SugaredLogger
+
+	orm           ChannelDefinitionCacheORM
+	chainSelector uint64
+	donID         uint32
+	client        HTTPClient
+	httpLimit     int64
+	url           string
+	sigURL        string
+	signers       map[common.Address]struct{}
+	pollInterval  time.Duration
+
+	definitionsMu      sync.RWMutex
+	definitions        llotypes.ChannelDefinitions
+	definitionsVersion uint32
+	lastSig            []byte
+	persistedVersion   uint32
+
+	wg     sync.WaitGroup
+	chStop services.StopChan
+}
+
+// NewSignedChannelDefinitionCache returns a CDC that polls rawURL, which may
+// be an http(s):// URL, a file:// URL or a plain filesystem path. If sigURL
+// is empty, the signature is fetched from rawURL with a ".sig" suffix.
+func NewSignedChannelDefinitionCache(lggr logger.Logger, orm ChannelDefinitionCacheORM, client HTTPClient, chainSelector uint64, donID uint32, rawURL, sigURL string, signers []common.Address, pollInterval time.Duration) (llotypes.ChannelDefinitionCache, error) {
+	if orm == nil {
+		return nil, errors.New("orm must not be nil")
+	}
+	if rawURL == "" {
+		return nil, errors.New("url must be specified")
+	}
+	if len(signers) == 0 {
+		return nil, errors.New("at least one signer must be specified")
+	}
+	if sigURL == "" {
+		sigURL = rawURL + signatureURLSuffix
+	}
+	if pollInterval <= 0 {
+		pollInterval = defaultSignedSourcePollInterval
+	}
+	signerSet := make(map[common.Address]struct{}, len(signers))
+	for _, s := range signers {
+		signerSet[s] = struct{}{}
+	}
+	return &signedCDC{
+		lggr:          logger.Sugared(lggr).Named("SignedChannelDefinitionCache").With("url", rawURL, "donID", donID),
+		orm:           orm,
+		chainSelector: chainSelector,
+		donID:         donID,
+		client:        client,
+		httpLimit:     MaxChannelDefinitionsFileSize,
+		url:           rawURL,
+		sigURL:        sigURL,
+		signers:       signerSet,
+		pollInterval:  pollInterval,
+		definitions:   make(llotypes.ChannelDefinitions),
+		chStop:        make(chan struct{}),
+	}, nil
+}
+
+func (c *signedCDC) Start(ctx context.Context) error {
+	return c.StartOnce("SignedChannelDefinitionCache", func() error {
+		// Load the last accepted definitions so that only newer versions are
+		// accepted from the source
+		if pd, err := c.orm.LoadChannelDefinitions(ctx, signedSourceAddress, c.donID); err != nil {
+			return err
+		} else if pd != nil {
+			c.definitions = pd.Definitions
+			c.definitionsVersion = pd.Version
+			c.persistedVersion = pd.Version
+		}
+		// Best-effort initial load so that definitions are available
+		// immediately; failures will be retried by the poll loop
+		if err := c.poll(ctx); err != nil {
+			c.lggr.Warnw("Initial fetch of signed channel definitions failed", "err", err)
+		}
+		c.wg.Add(1)
+		go c.pollLoop()
+		return nil
+	})
+}
+
+func (c *signedCDC) pollLoop() {
+	defer c.wg.Done()
+
+	ctx, cancel := c.chStop.NewCtx()
+	defer cancel()
+
+	pollT := services.NewTicker(c.pollInterval)
+	defer pollT.Stop()
+
+	for {
+		select {
+		case <-c.chStop:
+			return
+		case <-pollT.C:
+			// failures will be tried again on the next tick
+			if err := c.poll(ctx); err != nil {
+				c.lggr.Errorw("Failed to fetch signed channel definitions", "err", err)
+			}
+		}
+	}
+}
+
+func (c *signedCDC) poll(ctx context.Context) error {
+	// Retry persisting definitions that failed to persist on a previous poll
+	if err := c.persist(ctx); err != nil {
+		return err
+	}
+
+	sig, err := c.fetch(ctx, c.sigURL, maxSignatureFileSize)
+	if err != nil {
+		return fmt.Errorf("failed to fetch signature: %w", err)
+	}
+	c.definitionsMu.RLock()
+	unchanged := bytes.Equal(sig, c.lastSig)
+	c.definitionsMu.RUnlock()
+	if unchanged {
+		// Signatures are deterministic for a given document, so an
+		// unchanged signature means an unchanged document
+		return nil
+	}
+
+	doc, err := c.fetch(ctx, c.url, c.httpLimit)
+	if err != nil {
+		return fmt.Errorf("failed to fetch channel definitions: %w", err)
+	}
+	signer, err := VerifyChannelDefinitionsSignature(c.chainSelector, c.donID, doc, sig, c.signers)
+	if err != nil {
+		return err
+	}
+
+	var scd SignedChannelDefinitions
+	if err = json.Unmarshal(doc, &scd); err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	c.definitionsMu.Lock()
+	c.lastSig = sig
+	if scd.Version <= c.definitionsVersion {
+		c.lggr.Debugw("Ignoring signed channel definitions with version not newer than current", "version", scd.Version, "currentVersion", c.definitionsVersion)
+		c.definitionsMu.Unlock()
+		return nil
+	}
+	if scd.ChannelDefinitions == nil {
+		scd.ChannelDefinitions = make(llotypes.ChannelDefinitions)
+	}
+	c.definitions = scd.ChannelDefinitions
+	c.definitionsVersion = scd.Version
+	c.definitionsMu.Unlock()
+	c.lggr.Infow("Set new signed channel definitions", "version", scd.Version, "signer", signer)
+
+	return c.persist(ctx)
+}
+
+// persist saves the current definitions if they are newer than the persisted ones
+func (c *signedCDC) persist(ctx context.Context) error {
+	c.definitionsMu.RLock()
+	version, dfns := c.definitionsVersion, maps.Clone(c.definitions)
+	persisted := c.persistedVersion
+	c.definitionsMu.RUnlock()
+	if version <= persisted {
+		return nil
+	}
+
+	if err := c.orm.StoreChannelDefinitions(ctx, signedSourceAddress, c.donID, version, dfns, 0); err != nil {
+		return fmt.Errorf("failed to persist signed channel definitions: %w", err)
+	}
+
+	c.definitionsMu.Lock()
+	defer c.definitionsMu.Unlock()
+	if version > c.persistedVersion {
+		c.persistedVersion = version
+	}
+	return nil
+}
+
+// ChannelDefinitionsSigningHash returns the hash signed by the signers of a
+// SignedChannelDefinitions document for the given chain and DON:
+// keccak256(domain || chainSelector || donID || keccak256(doc)), where
+// domain is "chainlink-llo-channel-definitions-v1" and integers are
+// big-endian
+func ChannelDefinitionsSigningHash(chainSelector uint64, donID uint32, doc []byte) []byte {
+	msg := []byte(channelDefinitionsSigningDomain)
+	msg = binary.BigEndian.AppendUint64(msg, chainSelector)
+	msg = binary.BigEndian.AppendUint32(msg, donID)
+	msg = append(msg, crypto.Keccak256(doc)...)
+	return crypto.Keccak256(msg)
+}
+
+// VerifyChannelDefinitionsSignature checks that hexSig is a signature over
+// ChannelDefinitionsSigningHash of doc by one of signers, and returns the
+// signer
+func VerifyChannelDefinitionsSignature(chainSelector uint64, donID uint32, doc []byte, hexSig []byte, signers map[common.Address]struct{}) (common.Address, error) {
+	s := strings.TrimPrefix(strings.TrimSpace(string(hexSig)), "0x")
+	sig, err := hex.DecodeString(s)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	pubkey, err := crypto.SigToPub(ChannelDefinitionsSigningHash(chainSelector, donID, doc), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	signer := crypto.PubkeyToAddress(*pubkey)
+	if _, ok := signers[signer]; !ok {
+		return common.Address{}, fmt.Errorf("channel definitions signed by unrecognized signer %s", signer)
+	}
+	return signer, nil
+}
+
+func (c *signedCDC) fetch(ctx context.Context, rawURL string, limit int64) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url %q: %w", rawURL, err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return c.fetchHTTP(ctx, rawURL, limit)
+	case "file":
+		return readFileLimited(u.Path, limit)
+	case "":
+		return readFileLimited(rawURL, limit)
+	default:
+		return nil, fmt.Errorf("unsupported url scheme %q", u.Scheme)
+	}
+}
+
+func (c *signedCDC) fetchHTTP(ctx context.Context, rawURL string, limit int64) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http.Request; %w", err)
+	}
+
+	httpRequest := clhttp.HTTPRequest{
+		Client:  c.client,
+		Request: request,
+		Config:  clhttp.HTTPRequestConfig{SizeLimit: limit},
+		Logger:  c.lggr.Named("HTTPRequest").With("url", rawURL),
+	}
+
+	reader, statusCode, _, err := httpRequest.SendRequestReader()
+	if err != nil {
+		return nil, fmt.Errorf("error making http request: %w", err)
+	}
+	defer reader.Close()
+
+	if statusCode >= 400 {
+		// NOTE: Truncate the returned body here as we don't want to spam the
+		// logs with potentially huge messages
+		body := http.MaxBytesReader(nil, reader, 1024)
+		defer body.Close()
+		bodyBytes, _ := io.ReadAll(body)
+		return nil, fmt.Errorf("got error from %s: (status code: %d, response body: %s)", rawURL, statusCode, string(bodyBytes))
+	}
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from body: %w", err)
+	}
+	return b, nil
+}
+
+func readFileLimited(path string, limit int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	b, err := io.ReadAll(io.LimitReader(f, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > limit {
+		return nil, fmt.Errorf("file %s exceeds size limit of %d bytes", path, limit)
+	}
+	return b, nil
+}
+
+func (c *signedCDC) Close() error {
+	return c.StopOnce("SignedChannelDefinitionCache", func() error {
+		close(c.chStop)
+		c.wg.Wait()
+		return nil
+	})
+}
+
+func (c *signedCDC) HealthReport() map[string]error {
+	report := map[string]error{c.Name(): c.Healthy()}
+	return report
+}
+
+func (c *signedCDC) Name() string { return c.lggr.Name() }
+
+func (c *signedCDC) Definitions() llotypes.ChannelDefinitions {
+	c.definitionsMu.RLock()
+	defer c.definitionsMu.RUnlock()
+	return maps.Clone(c.definitions)
+}
@@ -0,0 +1,200 @@
+package channeldefinitions
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/types"
+)
+
+const (
+	testChainSelector = uint64(1)
+	testDonID         = uint32(7)
+)
+
+var _ ChannelDefinitionCacheORM = &memoryCDCORM{}
+
+// memoryCDCORM persists definitions in memory, only updating them with newer versions
+type memoryCDCORM struct {
+	pds map[uint32]*types.PersistedDefinitions
+}
+
+func (m *memoryCDCORM) LoadChannelDefinitions(_ context.Context, addr common.Address, donID uint32) (*types.PersistedDefinitions, error) {
+	return m.pds[donID], nil
+}
+
+func (m *memoryCDCORM) StoreChannelDefinitions(_ context.Context, addr common.Address, donID, version uint32, dfns llotypes.ChannelDefinitions, blockNum int64) error {
+	if m.pds == nil {
+		m.pds = make(map[uint32]*types.PersistedDefinitions)
+	}
+	if pd, ok := m.pds[donID]; !ok || version > pd.Version {
+		m.pds[donID] = &types.PersistedDefinitions{Address: addr, DonID: donID, Version: version, Definitions: dfns, BlockNum: blockNum}
+	}
+	return nil
+}
+
+func (m *memoryCDCORM) CleanupChannelDefinitions(_ context.Context, addr common.Address, donID uint32) error {
+	delete(m.pds, donID)
+	return nil
+}
+
+func signDoc(t *testing.T, doc []byte) (sig []byte, signer common.Address) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	s, err := crypto.Sign(ChannelDefinitionsSigningHash(testChainSelector, testDonID, doc), key)
+	require.NoError(t, err)
+	return []byte("0x" + hex.EncodeToString(s)), crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func writeSignedDoc(t *testing.T, dir string, doc, sig []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, "definitions.json")
+	require.NoError(t, os.WriteFile(path, doc, 0600))
+	require.NoError(t, os.WriteFile(path+".sig", sig, 0600))
+	return path
+}
+
+func Test_SignedChannelDefinitionCache(t *testing.T) {
+	lggr := logger.Test(t)
+	docV1 := []byte(`{"version":1,"channelDefinitions":{"1":{"reportFormat":2,"streams":[{"streamId":1,"aggregator":1}]}}}`)
+	docV2 := []byte(`{"version":2,"channelDefinitions":{"2":{"reportFormat":2,"streams":[{"streamId":2,"aggregator":1}]}}}`)
+
+	t.Run("loads signed definitions from file and hot-swaps newer versions", func(t *testing.T) {
+		ctx := tests.Context(t)
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		sign := func(doc []byte) []byte {
+			s, err2 := crypto.Sign(ChannelDefinitionsSigningHash(testChainSelector, testDonID, doc), key)
+			require.NoError(t, err2)
+			return []byte(hex.EncodeToString(s))
+		}
+		dir := t.TempDir()
+		path := writeSignedDoc(t, dir, docV1, sign(docV1))
+
+		orm := &memoryCDCORM{}
+		cdc, err := NewSignedChannelDefinitionCache(lggr, orm, nil, testChainSelector, testDonID, path, "", []common.Address{crypto.PubkeyToAddress(key.PublicKey)}, time.Hour)
+		require.NoError(t, err)
+		c := cdc.(*signedCDC)
+
+		require.NoError(t, c.poll(ctx))
+		assert.Contains(t, c.Definitions(), llotypes.ChannelID(1))
+		assert.Equal(t, uint32(1), c.definitionsVersion)
+
+		writeSignedDoc(t, dir, docV2, sign(docV2))
+		require.NoError(t, c.poll(ctx))
+		assert.Contains(t, c.Definitions(), llotypes.ChannelID(2))
+		assert.NotContains(t, c.Definitions(), llotypes.ChannelID(1))
+		assert.Equal(t, uint32(2), c.definitionsVersion)
+
+		// older versions are ignored
+		writeSignedDoc(t, dir, docV1, sign(docV1))
+		require.NoError(t, c.poll(ctx))
+		assert.Contains(t, c.Definitions(), llotypes.ChannelID(2))
+		assert.Equal(t, uint32(2), c.definitionsVersion)
+		assert.Equal(t, uint32(2), orm.pds[testDonID].Version)
+
+		// and still ignored after a restart
+		restarted, err := NewSignedChannelDefinitionCache(lggr, orm, nil, testChainSelector, testDonID, path, "", []common.Address{crypto.PubkeyToAddress(key.PublicKey)}, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, restarted.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, restarted.Close()) })
+		assert.Contains(t, restarted.Definitions(), llotypes.ChannelID(2))
+		assert.NotContains(t, restarted.Definitions(), llotypes.ChannelID(1))
+	})
+
+	t.Run("rejects signatures for another DON or over the raw document", func(t *testing.T) {
+		ctx := tests.Context(t)
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		signer := crypto.PubkeyToAddress(key.PublicKey)
+
+		otherDON, err := crypto.Sign(ChannelDefinitionsSigningHash(testChainSelector, testDonID+1, docV1), key)
+		require.NoError(t, err)
+		raw, err := crypto.Sign(crypto.Keccak256(docV1), key)
+		require.NoError(t, err)
+
+		for _, sig := range [][]byte{otherDON, raw} {
+			path := writeSignedDoc(t, t.TempDir(), docV1, []byte(hex.EncodeToString(sig)))
+			cdc, err := NewSignedChannelDefinitionCache(lggr, &memoryCDCORM{}, nil, testChainSelector, testDonID, path, "", []common.Address{signer}, time.Hour)
+			require.NoError(t, err)
+			require.ErrorContains(t, cdc.(*signedCDC).poll(ctx), "channel definitions signed by unrecognized signer")
+			assert.Empty(t, cdc.Definitions())
+		}
+	})
+
+	t.Run("rejects definitions from unrecognized signers", func(t *testing.T) {
+		ctx := tests.Context(t)
+		sig, _ := signDoc(t, docV1)
+		path := writeSignedDoc(t, t.TempDir(), docV1, sig)
+
+		cdc, err := NewSignedChannelDefinitionCache(lggr, &memoryCDCORM{}, nil, testChainSelector, testDonID, "file://"+path, "", []common.Address{common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")}, time.Hour)
+		require.NoError(t, err)
+		c := cdc.(*signedCDC)
+
+		err = c.poll(ctx)
+		require.ErrorContains(t, err, "channel definitions signed by unrecognized signer")
+		assert.Empty(t, c.Definitions())
+	})
+
+	t.Run("rejects tampered definitions", func(t *testing.T) {
+		ctx := tests.Context(t)
+		sig, signer := signDoc(t, docV1)
+		path := writeSignedDoc(t, t.TempDir(), docV2, sig)
+
+		cdc, err := NewSignedChannelDefinitionCache(lggr, &memoryCDCORM{}, nil, testChainSelector, testDonID, path, "", []common.Address{signer}, time.Hour)
+		require.NoError(t, err)
+		c := cdc.(*signedCDC)
+
+		require.Error(t, c.poll(ctx))
+		assert.Empty(t, c.Definitions())
+	})
+
+	t.Run("loads signed definitions over HTTP", func(t *testing.T) {
+		ctx := tests.Context(t)
+		sig, signer := signDoc(t, docV1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/definitions.json":
+				_, _ = w.Write(docV1)
+			case "/signatures/definitions.json.sig":
+				_, _ = w.Write(sig)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		t.Cleanup(srv.Close)
+
+		cdc, err := NewSignedChannelDefinitionCache(lggr, &memoryCDCORM{}, srv.Client(), testChainSelector, testDonID, srv.URL+"/definitions.json", srv.URL+"/signatures/definitions.json.sig", []common.Address{signer}, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, cdc.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, cdc.Close()) })
+
+		assert.Contains(t, cdc.Definitions(), llotypes.ChannelID(1))
+	})
+
+	t.Run("errors on missing signature", func(t *testing.T) {
+		ctx := tests.Context(t)
+		path := filepath.Join(t.TempDir(), "definitions.json")
+		require.NoError(t, os.WriteFile(path, docV1, 0600))
+
+		cdc, err := NewSignedChannelDefinitionCache(lggr, &memoryCDCORM{}, nil, testChainSelector, testDonID, path, "", []common.Address{common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")}, time.Hour)
+		require.NoError(t, err)
+		require.ErrorContains(t, cdc.(*signedCDC).poll(ctx), "failed to fetch signature")
+	})
+}
@@ -28,6 +28,7 @@ import (
 
 	corelogger "github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/grpc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/reportcodecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -87,6 +88,8 @@ type server struct {
 	evmPremiumLegacyPacker ReportPacker
 	evmStreamlinedPacker   ReportPacker
 	jsonPacker             ReportPacker
+	protobufPacker         ReportPacker
+	cborPacker             ReportPacker
 
 	transmitSuccessCount            prometheus.Counter
 	transmitDuplicateCount          prometheus.Counter
@@ -128,6 +131,8 @@ func newServer(lggr logger.Logger, verboseLogging bool, cfg QueueConfig, client
 		evm.NewReportCodecPremiumLegacy(codecLggr, pm.DonID()),
 		evm.NewReportCodecStreamlined(),
 		llo.JSONReportCodec{},
+		reportcodecs.NewReportCodecProtobuf(),
+		reportcodecs.NewReportCodecCBOR(),
 		promTransmitSuccessCount.WithLabelValues(donIDStr, serverURL),
 		promTransmitDuplicateCount.WithLabelValues(donIDStr, serverURL),
 		promTransmitConnectionErrorCount.WithLabelValues(donIDStr, serverURL),
@@ -299,6 +304,10 @@ func (s *server) transmit(ctx context.Context, t *Transmission) (*rpc.TransmitRe
 		payload, err = s.evmPremiumLegacyPacker.Pack(t.ConfigDigest, t.SeqNr, t.Report.Report, t.Sigs)
 	case llotypes.ReportFormatEVMStreamlined:
 		payload, err = s.evmStreamlinedPacker.Pack(t.ConfigDigest, t.SeqNr, t.Report.Report, t.Sigs)
+	case reportcodecs.ReportFormatProtobuf:
+		payload, err = s.protobufPacker.Pack(t.ConfigDigest, t.SeqNr, t.Report.Report, t.Sigs)
+	case reportcodecs.ReportFormatCBOR:
+		payload, err = s.cborPacker.Pack(t.ConfigDigest, t.SeqNr, t.Report.Report, t.Sigs)
 	default:
 		return nil, nil, fmt.Errorf("Transmit failed; don't know how to Pack unsupported report format: %q", t.Report.Info.ReportFormat)
 	}
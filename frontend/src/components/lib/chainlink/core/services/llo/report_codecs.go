@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-data-streams/llo/reportcodecs/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/cre"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/reportcodecs"
 )
 
 // NOTE: All supported codecs must be specified here
@@ -18,6 +19,8 @@ func NewReportCodecs(lggr logger.Logger, donID uint32) map[llotypes.ReportFormat
 	codecs[llotypes.ReportFormatEVMABIEncodeUnpacked] = evm.NewReportCodecEVMABIEncodeUnpacked(lggr, donID)
 	codecs[llotypes.ReportFormatCapabilityTrigger] = cre.NewReportCodecCapabilityTrigger(lggr, donID)
 	codecs[llotypes.ReportFormatEVMStreamlined] = evm.NewReportCodecStreamlined()
+	codecs[reportcodecs.ReportFormatProtobuf] = reportcodecs.NewReportCodecProtobuf()
+	codecs[reportcodecs.ReportFormatCBOR] = reportcodecs.NewReportCodecCBOR()
 
 	return codecs
 }
@@ -7,6 +7,7 @@ import (
 
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/reportcodecs"
 )
 
 func Test_NewReportCodecs(t *testing.T) {
@@ -14,4 +15,6 @@ func Test_NewReportCodecs(t *testing.T) {
 
 	assert.Contains(t, c, llotypes.ReportFormatJSON, "expected JSON to be supported")
 	assert.Contains(t, c, llotypes.ReportFormatEVMPremiumLegacy, "expected EVMPremiumLegacy to be supported")
+	assert.Contains(t, c, reportcodecs.ReportFormatProtobuf, "expected Protobuf to be supported")
+	assert.Contains(t, c, reportcodecs.ReportFormatCBOR, "expected CBOR to be supported")
 }
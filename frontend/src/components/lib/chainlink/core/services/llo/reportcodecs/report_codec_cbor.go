@@ -0,0 +1,175 @@
+package reportcodecs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+var _ llo.ReportCodec = ReportCodecCBOR{}
+
+var (
+	// Core Deterministic Encoding (RFC 8949 section 4.2.1), so that a given
+	// report always encodes to the same bytes
+	cborEncMode = mustEncMode(cbor.CoreDetEncOptions())
+	// Reject anything the encoder would not have produced
+	cborDecMode = mustDecMode(cbor.DecOptions{
+		DupMapKey:         cbor.DupMapKeyEnforcedAPF,
+		ExtraReturnErrors: cbor.ExtraDecErrorUnknownField,
+	})
+)
+
+// Reports and packed reports are CBOR maps with small integer keys, matching
+// the field numbers of report.proto. Stream values and signatures are
+// encoded as arrays.
+type cborReport struct {
+	ConfigDigest                    []byte             `cbor:"1,keyasint"`
+	SeqNr                           uint64             `cbor:"2,keyasint"`
+	ChannelID                       llotypes.ChannelID `cbor:"3,keyasint"`
+	ValidAfterNanoseconds           uint64             `cbor:"4,keyasint"`
+	ObservationTimestampNanoseconds uint64             `cbor:"5,keyasint"`
+	Values                          []cborStreamValue  `cbor:"6,keyasint"`
+	Specimen                        bool               `cbor:"7,keyasint"`
+}
+
+type cborStreamValue struct {
+	_        struct{} `cbor:",toarray"`
+	StreamID llotypes.StreamID
+	Type     llo.LLOStreamValue_Type
+	Value    string
+}
+
+type cborPackedReport struct {
+	ConfigDigest []byte          `cbor:"1,keyasint"`
+	SeqNr        uint64          `cbor:"2,keyasint"`
+	Report       []byte          `cbor:"3,keyasint"`
+	Signatures   []cborSignature `cbor:"4,keyasint"`
+}
+
+type cborSignature struct {
+	_         struct{} `cbor:",toarray"`
+	Signer    commontypes.OracleID
+	Signature []byte
+}
+
+// ReportCodecCBOR encodes reports as canonical CBOR
+type ReportCodecCBOR struct{}
+
+func NewReportCodecCBOR() ReportCodecCBOR {
+	return ReportCodecCBOR{}
+}
+
+func (ReportCodecCBOR) Encode(r llo.Report, cd llotypes.ChannelDefinition) ([]byte, error) {
+	values, err := encodeStreamValues(r, cd)
+	if err != nil {
+		return nil, err
+	}
+	e := cborReport{
+		ConfigDigest:                    r.ConfigDigest[:],
+		SeqNr:                           r.SeqNr,
+		ChannelID:                       r.ChannelID,
+		ValidAfterNanoseconds:           r.ValidAfterNanoseconds,
+		ObservationTimestampNanoseconds: r.ObservationTimestampNanoseconds,
+		Values:                          make([]cborStreamValue, len(values)),
+		Specimen:                        r.Specimen,
+	}
+	for i, v := range values {
+		e.Values[i] = cborStreamValue{StreamID: v.StreamID, Type: v.Type, Value: v.Value}
+	}
+	return cborEncMode.Marshal(e)
+}
+
+func (ReportCodecCBOR) Verify(cd llotypes.ChannelDefinition) error {
+	return verifyNoOpts(ReportFormatCBOR, cd)
+}
+
+func (ReportCodecCBOR) Decode(b []byte) (r DecodedReport, err error) {
+	var d cborReport
+	if err = cborDecMode.Unmarshal(b, &d); err != nil {
+		return r, fmt.Errorf("failed to decode cbor report: %w", err)
+	}
+	if d.SeqNr == 0 {
+		// catch obviously bad inputs, since a valid report can never have SeqNr == 0
+		return r, errors.New("missing SeqNr")
+	}
+	r.ConfigDigest, err = ocrtypes.BytesToConfigDigest(d.ConfigDigest)
+	if err != nil {
+		return r, fmt.Errorf("invalid ConfigDigest; %w", err)
+	}
+	r.SeqNr = d.SeqNr
+	r.ChannelID = d.ChannelID
+	r.ValidAfterNanoseconds = d.ValidAfterNanoseconds
+	r.ObservationTimestampNanoseconds = d.ObservationTimestampNanoseconds
+	r.Specimen = d.Specimen
+	values := make([]encodedStreamValue, len(d.Values))
+	for i, v := range d.Values {
+		values[i] = encodedStreamValue{StreamID: v.StreamID, Type: v.Type, Value: v.Value}
+	}
+	r.Values, r.StreamIDs, err = decodeStreamValues(values)
+	return r, err
+}
+
+func (ReportCodecCBOR) Pack(digest ocrtypes.ConfigDigest, seqNr uint64, report ocrtypes.Report, sigs []ocrtypes.AttributedOnchainSignature) ([]byte, error) {
+	p := cborPackedReport{
+		ConfigDigest: digest[:],
+		SeqNr:        seqNr,
+		Report:       report,
+		Signatures:   make([]cborSignature, len(sigs)),
+	}
+	for i, sig := range sigs {
+		p.Signatures[i] = cborSignature{Signer: sig.Signer, Signature: sig.Signature}
+	}
+	return cborEncMode.Marshal(p)
+}
+
+func (ReportCodecCBOR) Unpack(b []byte) (digest ocrtypes.ConfigDigest, seqNr uint64, report ocrtypes.Report, sigs []ocrtypes.AttributedOnchainSignature, err error) {
+	var p cborPackedReport
+	if err = cborDecMode.Unmarshal(b, &p); err != nil {
+		return digest, seqNr, report, sigs, fmt.Errorf("failed to unpack cbor report: %w", err)
+	}
+	digest, err = ocrtypes.BytesToConfigDigest(p.ConfigDigest)
+	if err != nil {
+		return digest, seqNr, report, sigs, fmt.Errorf("invalid ConfigDigest; %w", err)
+	}
+	sigs = make([]ocrtypes.AttributedOnchainSignature, len(p.Signatures))
+	for i, sig := range p.Signatures {
+		sigs[i] = ocrtypes.AttributedOnchainSignature{Signer: sig.Signer, Signature: sig.Signature}
+	}
+	return digest, p.SeqNr, p.Report, sigs, nil
+}
+
+// UnpackVerifyDecode unpacks a report, checks its signatures with
+// VerifySignatures and decodes it
+func (cdc ReportCodecCBOR) UnpackVerifyDecode(b []byte, signers []common.Address, minSigners int) (DecodedReport, error) {
+	digest, seqNr, report, sigs, err := cdc.Unpack(b)
+	if err != nil {
+		return DecodedReport{}, err
+	}
+	if err = VerifySignatures(digest, seqNr, report, sigs, signers, minSigners); err != nil {
+		return DecodedReport{}, err
+	}
+	return cdc.Decode(report)
+}
+
+func mustEncMode(opts cbor.EncOptions) cbor.EncMode {
+	em, err := opts.EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return em
+}
+
+func mustDecMode(opts cbor.DecOptions) cbor.DecMode {
+	dm, err := opts.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return dm
+}
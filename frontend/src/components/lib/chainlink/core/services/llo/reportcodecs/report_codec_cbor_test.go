@@ -0,0 +1,77 @@
+package reportcodecs
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+func Test_ReportCodecCBOR(t *testing.T) {
+	c := NewReportCodecCBOR()
+
+	t.Run("Encode/Decode round trip", func(t *testing.T) {
+		r, cd := newTestReport()
+		encoded, err := c.Encode(r, cd)
+		require.NoError(t, err)
+		require.True(t, cbor.Wellformed(encoded))
+
+		decoded, err := c.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, r.ConfigDigest, decoded.ConfigDigest)
+		assert.Equal(t, r.SeqNr, decoded.SeqNr)
+		assert.Equal(t, r.ChannelID, decoded.ChannelID)
+		assert.Equal(t, r.ValidAfterNanoseconds, decoded.ValidAfterNanoseconds)
+		assert.Equal(t, r.ObservationTimestampNanoseconds, decoded.ObservationTimestampNanoseconds)
+		assert.True(t, decoded.Specimen)
+		assert.Equal(t, []llotypes.StreamID{1, 2}, decoded.StreamIDs)
+		require.Len(t, decoded.Values, 2)
+		assert.Equal(t, "123456789.123456789123456789", decoded.Values[0].(*llo.Decimal).String())
+		assert.Equal(t, "1", decoded.Values[1].(*llo.Quote).Bid.String())
+
+		// canonical
+		encoded2, err := c.Encode(r, cd)
+		require.NoError(t, err)
+		assert.Equal(t, encoded, encoded2)
+	})
+
+	t.Run("Decode rejects unknown fields", func(t *testing.T) {
+		b, err := cbor.Marshal(map[int]any{2: 1, 99: "foo"})
+		require.NoError(t, err)
+		_, err = c.Decode(b)
+		require.Error(t, err)
+	})
+
+	t.Run("Decode fails on missing SeqNr", func(t *testing.T) {
+		b, err := cbor.Marshal(map[int]any{3: 1})
+		require.NoError(t, err)
+		_, err = c.Decode(b)
+		require.EqualError(t, err, "missing SeqNr")
+	})
+
+	t.Run("Verify rejects opts", func(t *testing.T) {
+		require.NoError(t, c.Verify(llotypes.ChannelDefinition{}))
+		require.Error(t, c.Verify(llotypes.ChannelDefinition{Opts: []byte(`{"foo":1}`)}))
+	})
+
+	t.Run("Pack/UnpackVerifyDecode round trip", func(t *testing.T) {
+		r, cd := newTestReport()
+		encoded, err := c.Encode(r, cd)
+		require.NoError(t, err)
+		keys, signers := newTestSigners(t, 4)
+		sigs := signReport(t, keys[1:3], r.ConfigDigest, r.SeqNr, encoded)
+		// signatures are attributed to oracles 1 and 2
+		sigs[0].Signer, sigs[1].Signer = 1, 2
+
+		packed, err := c.Pack(r.ConfigDigest, r.SeqNr, encoded, sigs)
+		require.NoError(t, err)
+
+		decoded, err := c.UnpackVerifyDecode(packed, signers, 2)
+		require.NoError(t, err)
+		assert.Equal(t, r.ChannelID, decoded.ChannelID)
+	})
+}
@@ -0,0 +1,303 @@
+package reportcodecs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+var _ llo.ReportCodec = ReportCodecProtobuf{}
+
+// ReportCodecProtobuf encodes reports as the LLOReport message of
+// report.proto.
+//
+// Messages are written directly with protowire, in field number order and
+// omitting zero values, so that encoding is canonical and consumers can
+// decode reports with code generated from report.proto in any language.
+type ReportCodecProtobuf struct{}
+
+func NewReportCodecProtobuf() ReportCodecProtobuf {
+	return ReportCodecProtobuf{}
+}
+
+func (ReportCodecProtobuf) Encode(r llo.Report, cd llotypes.ChannelDefinition) ([]byte, error) {
+	values, err := encodeStreamValues(r, cd)
+	if err != nil {
+		return nil, err
+	}
+	var b []byte
+	b = appendBytesField(b, 1, r.ConfigDigest[:])
+	b = appendVarintField(b, 2, r.SeqNr)
+	b = appendVarintField(b, 3, uint64(r.ChannelID))
+	b = appendVarintField(b, 4, r.ValidAfterNanoseconds)
+	b = appendVarintField(b, 5, r.ObservationTimestampNanoseconds)
+	for _, v := range values {
+		var vb []byte
+		vb = appendVarintField(vb, 1, uint64(v.StreamID))
+		vb = appendVarintField(vb, 2, uint64(v.Type))
+		vb = appendBytesField(vb, 3, []byte(v.Value))
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		b = protowire.AppendBytes(b, vb)
+	}
+	if r.Specimen {
+		b = appendVarintField(b, 7, 1)
+	}
+	return b, nil
+}
+
+func (ReportCodecProtobuf) Verify(cd llotypes.ChannelDefinition) error {
+	return verifyNoOpts(ReportFormatProtobuf, cd)
+}
+
+func (ReportCodecProtobuf) Decode(b []byte) (r DecodedReport, err error) {
+	var values []encodedStreamValue
+	err = consumeFields(b, func(num protowire.Number, typ protowire.Type, field []byte) error {
+		switch num {
+		case 1:
+			v, err := expectBytes(typ, field)
+			if err != nil {
+				return err
+			}
+			r.ConfigDigest, err = ocrtypes.BytesToConfigDigest(v)
+			return err
+		case 2:
+			return expectVarint(typ, field, &r.SeqNr)
+		case 3:
+			var v uint64
+			if err := expectVarint(typ, field, &v); err != nil {
+				return err
+			}
+			if v > math.MaxUint32 {
+				return fmt.Errorf("channel ID out of range: %d", v)
+			}
+			r.ChannelID = llotypes.ChannelID(v)
+			return nil
+		case 4:
+			return expectVarint(typ, field, &r.ValidAfterNanoseconds)
+		case 5:
+			return expectVarint(typ, field, &r.ObservationTimestampNanoseconds)
+		case 6:
+			vb, err := expectBytes(typ, field)
+			if err != nil {
+				return err
+			}
+			v, err := decodeProtobufStreamValue(vb)
+			if err != nil {
+				return err
+			}
+			values = append(values, v)
+			return nil
+		case 7:
+			var v uint64
+			if err := expectVarint(typ, field, &v); err != nil {
+				return err
+			}
+			r.Specimen = v != 0
+			return nil
+		default:
+			return fmt.Errorf("unknown field %d", num)
+		}
+	})
+	if err != nil {
+		return r, fmt.Errorf("failed to decode protobuf report: %w", err)
+	}
+	if r.SeqNr == 0 {
+		// catch obviously bad inputs, since a valid report can never have SeqNr == 0
+		return r, errors.New("missing SeqNr")
+	}
+	r.Values, r.StreamIDs, err = decodeStreamValues(values)
+	return r, err
+}
+
+func decodeProtobufStreamValue(b []byte) (v encodedStreamValue, err error) {
+	err = consumeFields(b, func(num protowire.Number, typ protowire.Type, field []byte) error {
+		var n uint64
+		switch num {
+		case 1:
+			if err := expectVarint(typ, field, &n); err != nil {
+				return err
+			}
+			if n > math.MaxUint32 {
+				return fmt.Errorf("stream ID out of range: %d", n)
+			}
+			v.StreamID = llotypes.StreamID(n)
+		case 2:
+			if err := expectVarint(typ, field, &n); err != nil {
+				return err
+			}
+			if n > math.MaxInt32 {
+				return fmt.Errorf("stream value type out of range: %d", n)
+			}
+			v.Type = llo.LLOStreamValue_Type(n)
+		case 3:
+			s, err := expectBytes(typ, field)
+			if err != nil {
+				return err
+			}
+			v.Value = string(s)
+		default:
+			return fmt.Errorf("unknown stream value field %d", num)
+		}
+		return nil
+	})
+	return v, err
+}
+
+// Pack encodes a report with its signatures as the LLOReportWithSignatures
+// message of report.proto
+func (ReportCodecProtobuf) Pack(digest ocrtypes.ConfigDigest, seqNr uint64, report ocrtypes.Report, sigs []ocrtypes.AttributedOnchainSignature) ([]byte, error) {
+	var b []byte
+	b = appendBytesField(b, 1, digest[:])
+	b = appendVarintField(b, 2, seqNr)
+	b = appendBytesField(b, 3, report)
+	for _, sig := range sigs {
+		var sb []byte
+		sb = appendVarintField(sb, 1, uint64(sig.Signer))
+		sb = appendBytesField(sb, 2, sig.Signature)
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendBytes(b, sb)
+	}
+	return b, nil
+}
+
+func (ReportCodecProtobuf) Unpack(b []byte) (digest ocrtypes.ConfigDigest, seqNr uint64, report ocrtypes.Report, sigs []ocrtypes.AttributedOnchainSignature, err error) {
+	err = consumeFields(b, func(num protowire.Number, typ protowire.Type, field []byte) error {
+		switch num {
+		case 1:
+			v, err := expectBytes(typ, field)
+			if err != nil {
+				return err
+			}
+			digest, err = ocrtypes.BytesToConfigDigest(v)
+			return err
+		case 2:
+			return expectVarint(typ, field, &seqNr)
+		case 3:
+			v, err := expectBytes(typ, field)
+			if err != nil {
+				return err
+			}
+			report = ocrtypes.Report(v)
+			return nil
+		case 4:
+			sb, err := expectBytes(typ, field)
+			if err != nil {
+				return err
+			}
+			var sig ocrtypes.AttributedOnchainSignature
+			err = consumeFields(sb, func(num protowire.Number, typ protowire.Type, field []byte) error {
+				switch num {
+				case 1:
+					var n uint64
+					if err := expectVarint(typ, field, &n); err != nil {
+						return err
+					}
+					if n > math.MaxUint8 {
+						return fmt.Errorf("signer out of range: %d", n)
+					}
+					sig.Signer = commontypes.OracleID(n)
+				case 2:
+					v, err := expectBytes(typ, field)
+					if err != nil {
+						return err
+					}
+					sig.Signature = v
+				default:
+					return fmt.Errorf("unknown signature field %d", num)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sigs = append(sigs, sig)
+			return nil
+		default:
+			return fmt.Errorf("unknown field %d", num)
+		}
+	})
+	if err != nil {
+		return digest, seqNr, report, sigs, fmt.Errorf("failed to unpack protobuf report: %w", err)
+	}
+	return digest, seqNr, report, sigs, nil
+}
+
+// UnpackVerifyDecode unpacks a report, checks its signatures with
+// VerifySignatures and decodes it
+func (cdc ReportCodecProtobuf) UnpackVerifyDecode(b []byte, signers []common.Address, minSigners int) (DecodedReport, error) {
+	digest, seqNr, report, sigs, err := cdc.Unpack(b)
+	if err != nil {
+		return DecodedReport{}, err
+	}
+	if err = VerifySignatures(digest, seqNr, report, sigs, signers, minSigners); err != nil {
+		return DecodedReport{}, err
+	}
+	return cdc.Decode(report)
+}
+
+func appendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// consumeFields calls fn with the raw value of every field in b
+func consumeFields(b []byte, fn func(num protowire.Number, typ protowire.Type, field []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if err := fn(num, typ, b[:n]); err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+func expectVarint(typ protowire.Type, field []byte, out *uint64) error {
+	if typ != protowire.VarintType {
+		return fmt.Errorf("expected varint, got wire type %d", typ)
+	}
+	v, n := protowire.ConsumeVarint(field)
+	if n < 0 {
+		return protowire.ParseError(n)
+	}
+	*out = v
+	return nil
+}
+
+func expectBytes(typ protowire.Type, field []byte) ([]byte, error) {
+	if typ != protowire.BytesType {
+		return nil, fmt.Errorf("expected length-delimited field, got wire type %d", typ)
+	}
+	v, n := protowire.ConsumeBytes(field)
+	if n < 0 {
+		return nil, protowire.ParseError(n)
+	}
+	return v, nil
+}
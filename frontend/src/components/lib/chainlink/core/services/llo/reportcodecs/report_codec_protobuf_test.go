@@ -0,0 +1,139 @@
+package reportcodecs
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+func newTestReport() (llo.Report, llotypes.ChannelDefinition) {
+	r := llo.Report{
+		ConfigDigest:                    types.ConfigDigest{1, 2, 3},
+		SeqNr:                           32,
+		ChannelID:                       llotypes.ChannelID(31),
+		ValidAfterNanoseconds:           1_700_000_000_000_000_000,
+		ObservationTimestampNanoseconds: 1_700_000_000_500_000_001,
+		Values: []llo.StreamValue{
+			llo.ToDecimal(decimal.RequireFromString("123456789.123456789123456789")),
+			&llo.Quote{Bid: decimal.NewFromInt(1), Benchmark: decimal.NewFromInt(2), Ask: decimal.NewFromInt(3)},
+		},
+		Specimen: true,
+	}
+	cd := llotypes.ChannelDefinition{
+		Streams: []llotypes.Stream{{StreamID: 1}, {StreamID: 2}},
+	}
+	return r, cd
+}
+
+func newTestSigners(t *testing.T, n int) ([]ocr2key.KeyBundle, []common.Address) {
+	t.Helper()
+	keys := make([]ocr2key.KeyBundle, n)
+	signers := make([]common.Address, n)
+	for i := range keys {
+		keys[i] = ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+		signers[i] = common.BytesToAddress(keys[i].PublicKey())
+	}
+	return keys, signers
+}
+
+func signReport(t *testing.T, keys []ocr2key.KeyBundle, digest types.ConfigDigest, seqNr uint64, report []byte) []types.AttributedOnchainSignature {
+	t.Helper()
+	sigs := make([]types.AttributedOnchainSignature, len(keys))
+	for i, k := range keys {
+		sig, err := k.Sign3(digest, seqNr, report)
+		require.NoError(t, err)
+		sigs[i] = types.AttributedOnchainSignature{Signer: commontypes.OracleID(i), Signature: sig}
+	}
+	return sigs
+}
+
+func Test_ReportCodecProtobuf(t *testing.T) {
+	c := NewReportCodecProtobuf()
+
+	t.Run("Encode/Decode round trip", func(t *testing.T) {
+		r, cd := newTestReport()
+		encoded, err := c.Encode(r, cd)
+		require.NoError(t, err)
+
+		decoded, err := c.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, r.ConfigDigest, decoded.ConfigDigest)
+		assert.Equal(t, r.SeqNr, decoded.SeqNr)
+		assert.Equal(t, r.ChannelID, decoded.ChannelID)
+		assert.Equal(t, r.ValidAfterNanoseconds, decoded.ValidAfterNanoseconds)
+		assert.Equal(t, r.ObservationTimestampNanoseconds, decoded.ObservationTimestampNanoseconds)
+		assert.True(t, decoded.Specimen)
+		assert.Equal(t, []llotypes.StreamID{1, 2}, decoded.StreamIDs)
+		require.Len(t, decoded.Values, 2)
+		assert.Equal(t, "123456789.123456789123456789", decoded.Values[0].(*llo.Decimal).String())
+		assert.Equal(t, "3", decoded.Values[1].(*llo.Quote).Ask.String())
+
+		// canonical
+		encoded2, err := c.Encode(r, cd)
+		require.NoError(t, err)
+		assert.Equal(t, encoded, encoded2)
+	})
+
+	t.Run("Encode fails on mismatched streams", func(t *testing.T) {
+		r, cd := newTestReport()
+		cd.Streams = cd.Streams[:1]
+		_, err := c.Encode(r, cd)
+		require.Error(t, err)
+	})
+
+	t.Run("Encode fails on nil values", func(t *testing.T) {
+		r, cd := newTestReport()
+		r.Values[0] = nil
+		_, err := c.Encode(r, cd)
+		require.Error(t, err)
+	})
+
+	t.Run("Decode fails on garbage", func(t *testing.T) {
+		_, err := c.Decode([]byte{0xff, 0xff, 0xff})
+		require.Error(t, err)
+		_, err = c.Decode(nil)
+		require.EqualError(t, err, "missing SeqNr")
+	})
+
+	t.Run("Verify rejects opts", func(t *testing.T) {
+		require.NoError(t, c.Verify(llotypes.ChannelDefinition{}))
+		require.Error(t, c.Verify(llotypes.ChannelDefinition{Opts: []byte(`{"foo":1}`)}))
+	})
+
+	t.Run("Pack/UnpackVerifyDecode round trip", func(t *testing.T) {
+		r, cd := newTestReport()
+		encoded, err := c.Encode(r, cd)
+		require.NoError(t, err)
+		keys, signers := newTestSigners(t, 4)
+		sigs := signReport(t, keys[:2], r.ConfigDigest, r.SeqNr, encoded)
+
+		packed, err := c.Pack(r.ConfigDigest, r.SeqNr, encoded, sigs)
+		require.NoError(t, err)
+
+		digest, seqNr, report, unpackedSigs, err := c.Unpack(packed)
+		require.NoError(t, err)
+		assert.Equal(t, r.ConfigDigest, digest)
+		assert.Equal(t, r.SeqNr, seqNr)
+		assert.Equal(t, encoded, []byte(report))
+		assert.Equal(t, sigs, unpackedSigs)
+
+		decoded, err := c.UnpackVerifyDecode(packed, signers, 2)
+		require.NoError(t, err)
+		assert.Equal(t, r.SeqNr, decoded.SeqNr)
+
+		_, err = c.UnpackVerifyDecode(packed, signers, 3)
+		require.Error(t, err)
+	})
+}
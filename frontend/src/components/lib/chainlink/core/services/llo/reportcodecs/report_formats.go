@@ -0,0 +1,104 @@
+// Package reportcodecs implements chain-agnostic LLO report formats intended
+// for off-chain consumers and non-EVM chains. Unlike the JSON format, both
+// encodings are canonical (a given report always encodes to the same bytes)
+// and carry integers and decimals without loss of precision.
+package reportcodecs
+
+import (
+	"fmt"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+// Report formats are defined here rather than in chainlink-common until
+// they are stable. Values are chosen well clear of the upstream range to
+// avoid collisions.
+const (
+	ReportFormatProtobuf llotypes.ReportFormat = 100
+	ReportFormatCBOR     llotypes.ReportFormat = 101
+)
+
+var reportFormatNames = map[llotypes.ReportFormat]string{
+	ReportFormatProtobuf: "protobuf",
+	ReportFormatCBOR:     "cbor",
+}
+
+// ReportFormats returns all report formats defined in this package
+func ReportFormats() []llotypes.ReportFormat {
+	return []llotypes.ReportFormat{ReportFormatProtobuf, ReportFormatCBOR}
+}
+
+// ReportFormatFromString is like llotypes.ReportFormatFromString but also
+// recognizes the report formats defined in this package
+func ReportFormatFromString(s string) (llotypes.ReportFormat, error) {
+	for rf, name := range reportFormatNames {
+		if s == name {
+			return rf, nil
+		}
+	}
+	return llotypes.ReportFormatFromString(s)
+}
+
+// ReportFormatString is like llotypes.ReportFormat.String but also
+// recognizes the report formats defined in this package
+func ReportFormatString(rf llotypes.ReportFormat) string {
+	if name, ok := reportFormatNames[rf]; ok {
+		return name
+	}
+	return rf.String()
+}
+
+// DecodedReport is a report decoded from one of the formats in this package.
+// Unlike llo.Report it carries the stream ID of each value.
+type DecodedReport struct {
+	llo.Report
+	StreamIDs []llotypes.StreamID
+}
+
+type encodedStreamValue struct {
+	StreamID llotypes.StreamID
+	Type     llo.LLOStreamValue_Type
+	Value    string
+}
+
+func encodeStreamValues(r llo.Report, cd llotypes.ChannelDefinition) ([]encodedStreamValue, error) {
+	if len(cd.Streams) != len(r.Values) {
+		// Invariant violation
+		return nil, fmt.Errorf("expected %d streams, got %d", len(cd.Streams), len(r.Values))
+	}
+	values := make([]encodedStreamValue, len(r.Values))
+	for i, sv := range r.Values {
+		t, err := llo.NewTypedTextStreamValue(sv)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode StreamValue for stream %d: %w", cd.Streams[i].StreamID, err)
+		}
+		values[i] = encodedStreamValue{
+			StreamID: cd.Streams[i].StreamID,
+			Type:     t.Type,
+			Value:    t.SerializedStreamValue,
+		}
+	}
+	return values, nil
+}
+
+func decodeStreamValues(encoded []encodedStreamValue) ([]llo.StreamValue, []llotypes.StreamID, error) {
+	values := make([]llo.StreamValue, len(encoded))
+	streamIDs := make([]llotypes.StreamID, len(encoded))
+	for i, e := range encoded {
+		sv, err := llo.UnmarshalTypedTextStreamValue(&llo.TypedTextStreamValue{Type: e.Type, SerializedStreamValue: e.Value})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode StreamValue for stream %d: %w", e.StreamID, err)
+		}
+		values[i] = sv
+		streamIDs[i] = e.StreamID
+	}
+	return values, streamIDs, nil
+}
+
+func verifyNoOpts(rf llotypes.ReportFormat, cd llotypes.ChannelDefinition) error {
+	if len(cd.Opts) > 0 {
+		return fmt.Errorf("unexpected Opts in ChannelDefinition (%s report format expects no opts), got: %q", ReportFormatString(rf), cd.Opts)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package reportcodecs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+// VerifySignatures checks that report was signed by at least minSigners
+// distinct oracles. Reports in the formats of this package are signed by the
+// EVM onchain key over the OCR3 report context (config digest and sequence
+// number), see ocr2key.ReportToSigData3. signers holds the address of each
+// oracle's onchain key, indexed by oracle ID.
+func VerifySignatures(digest ocrtypes.ConfigDigest, seqNr uint64, report []byte, sigs []ocrtypes.AttributedOnchainSignature, signers []common.Address, minSigners int) error {
+	if minSigners <= 0 {
+		return errors.New("minSigners must be positive")
+	}
+	sigData := ocr2key.ReportToSigData3(digest, seqNr, report)
+	seen := make(map[int]struct{}, len(sigs))
+	for _, sig := range sigs {
+		i := int(sig.Signer)
+		if i >= len(signers) {
+			return fmt.Errorf("signature from unknown oracle %d", i)
+		}
+		if _, ok := seen[i]; ok {
+			return fmt.Errorf("duplicate signature from oracle %d", i)
+		}
+		seen[i] = struct{}{}
+		pubkey, err := crypto.SigToPub(sigData, sig.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature from oracle %d: %w", i, err)
+		}
+		if crypto.PubkeyToAddress(*pubkey) != signers[i] {
+			return fmt.Errorf("signature from oracle %d does not match its signing address %s", i, signers[i])
+		}
+	}
+	if len(seen) < minSigners {
+		return fmt.Errorf("expected at least %d signatures, got %d", minSigners, len(seen))
+	}
+	return nil
+}
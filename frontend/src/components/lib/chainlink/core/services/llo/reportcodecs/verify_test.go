@@ -0,0 +1,51 @@
+package reportcodecs
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VerifySignatures(t *testing.T) {
+	keys, signers := newTestSigners(t, 4)
+	digest := types.ConfigDigest{1}
+	seqNr := uint64(42)
+	report := []byte("report")
+	sigs := signReport(t, keys, digest, seqNr, report)
+
+	t.Run("valid signatures", func(t *testing.T) {
+		require.NoError(t, VerifySignatures(digest, seqNr, report, sigs, signers, 4))
+	})
+
+	t.Run("not enough signatures", func(t *testing.T) {
+		require.EqualError(t, VerifySignatures(digest, seqNr, report, sigs[:1], signers, 2), "expected at least 2 signatures, got 1")
+	})
+
+	t.Run("duplicate signer", func(t *testing.T) {
+		require.EqualError(t, VerifySignatures(digest, seqNr, report, append(sigs[:1:1], sigs[0]), signers, 1), "duplicate signature from oracle 0")
+	})
+
+	t.Run("unknown signer", func(t *testing.T) {
+		require.Error(t, VerifySignatures(digest, seqNr, report, sigs, signers[:2], 1))
+	})
+
+	t.Run("wrong report context", func(t *testing.T) {
+		require.Error(t, VerifySignatures(digest, seqNr+1, report, sigs, signers, 1))
+		require.Error(t, VerifySignatures(types.ConfigDigest{2}, seqNr, report, sigs, signers, 1))
+	})
+
+	t.Run("tampered report", func(t *testing.T) {
+		require.Error(t, VerifySignatures(digest, seqNr, []byte("tampered"), sigs, signers, 1))
+	})
+}
+
+func Test_ReportFormatFromString(t *testing.T) {
+	for _, rf := range ReportFormats() {
+		parsed, err := ReportFormatFromString(ReportFormatString(rf))
+		require.NoError(t, err)
+		require.Equal(t, rf, parsed)
+	}
+	_, err := ReportFormatFromString("not a format")
+	require.Error(t, err)
+}
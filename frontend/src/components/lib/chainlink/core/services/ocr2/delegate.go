@@ -50,6 +50,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/reportcodecs"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipcommit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
@@ -63,6 +64,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/autotelemetry21"
 	ocr2keeper21core "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/otelwrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -134,6 +136,7 @@ type DelegateConfig interface {
 	Insecure() insecureConfig
 	Mercury() coreconfig.Mercury
 	Threshold() coreconfig.Threshold
+	Tracing() coreconfig.Tracing
 }
 
 // concrete implementation of DelegateConfig so it can be explicitly composed
@@ -144,6 +147,7 @@ type delegateConfig struct {
 	insecure    insecureConfig
 	mercury     mercuryConfig
 	threshold   thresholdConfig
+	tracing     coreconfig.Tracing
 }
 
 func (d *delegateConfig) JobPipeline() jobPipelineConfig {
@@ -166,6 +170,10 @@ func (d *delegateConfig) OCR2() ocr2Config {
 	return d.ocr2
 }
 
+func (d *delegateConfig) Tracing() coreconfig.Tracing {
+	return d.tracing
+}
+
 type ocr2Config interface {
 	BlockchainTimeout() time.Duration
 	CaptureEATelemetry() bool
@@ -202,7 +210,7 @@ type thresholdConfig interface {
 	ThresholdKeyShare() string
 }
 
-func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Threshold, i insecureConfig, jp jobPipelineConfig, pluginProcessCfg plugins.RegistrarConfig) DelegateConfig {
+func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Threshold, i insecureConfig, jp jobPipelineConfig, tr coreconfig.Tracing, pluginProcessCfg plugins.RegistrarConfig) DelegateConfig {
 	return &delegateConfig{
 		ocr2:            ocr2Cfg,
 		RegistrarConfig: pluginProcessCfg,
@@ -210,6 +218,7 @@ func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Th
 		insecure:        i,
 		mercury:         m,
 		threshold:       t,
+		tracing:         tr,
 	}
 }
 
@@ -750,7 +759,7 @@ func (d *Delegate) newServicesGenericPlugin(
 			OffchainConfigDigester:       provider.OffchainConfigDigester(),
 			MetricsRegisterer:            prometheus.WrapRegistererWith(map[string]string{"job_name": jb.Name.ValueOrZero()}, prometheus.DefaultRegisterer),
 		}
-		oracleArgs.ReportingPluginFactory = plugin
+		oracleArgs.ReportingPluginFactory = otelwrapper.NewOtelFactory(plugin, pCfg.PluginName, rid.Network, rid.ChainID, d.cfg.Tracing())
 		srvs = append(srvs, plugin)
 		oracle, oracleErr := libocr2.NewOracle(oracleArgs)
 		if oracleErr != nil {
@@ -999,7 +1008,7 @@ func (d *Delegate) newServicesLLO(
 		if err3 != nil {
 			return nil, fmt.Errorf("job %d (%s) specified key bundle ID %q for report format %s, but got error trying to load it: %w", jb.ID, jb.Name.ValueOrZero(), kbid, rfStr, err3)
 		}
-		rf, err4 := llotypes.ReportFormatFromString(rfStr)
+		rf, err4 := reportcodecs.ReportFormatFromString(rfStr)
 		if err4 != nil {
 			return nil, fmt.Errorf("job %d (%s) specified key bundle ID %q for report format %s, but it is not a recognized report format: %w", jb.ID, jb.Name.ValueOrZero(), kbid, rfStr, err4)
 		}
@@ -1018,6 +1027,8 @@ func (d *Delegate) newServicesLLO(
 		llotypes.ReportFormatEVMABIEncodeUnpacked,
 		llotypes.ReportFormatCapabilityTrigger,
 		llotypes.ReportFormatEVMStreamlined,
+		reportcodecs.ReportFormatProtobuf,
+		reportcodecs.ReportFormatCBOR,
 	}
 	for _, rf := range evmKeySignedFormats {
 		if _, exists := kbm[rf]; !exists {
@@ -1029,7 +1040,7 @@ func (d *Delegate) newServicesLLO(
 			if len(kbs) == 0 {
 				return nil, fmt.Errorf("no on-chain signing keys found for report format %s", "evm")
 			} else if len(kbs) > 1 {
-				lggr.Debugf("Multiple on-chain signing keys found for report format %s, using the first", rf.String())
+				lggr.Debugf("Multiple on-chain signing keys found for report format %s, using the first", reportcodecs.ReportFormatString(rf))
 			}
 			kbm[rf] = kbs[0]
 		}
@@ -1128,6 +1139,7 @@ func (d *Delegate) newServicesMedian(
 	mConfig := median.NewMedianConfig(
 		d.cfg.JobPipeline().MaxSuccessfulRuns(),
 		d.cfg.JobPipeline().ResultWriteQueueDepth(),
+		d.cfg.Tracing(),
 		d.cfg,
 	)
 
@@ -1686,6 +1698,7 @@ func (d *Delegate) newServicesCCIPCommit(ctx context.Context, lggr logger.Sugare
 		logError,
 		pluginJobSpecConfig,
 		d.RelayGetter,
+		d.cfg.Tracing(),
 	)
 }
 
@@ -1854,7 +1867,7 @@ func (d *Delegate) newServicesCCIPExecution(ctx context.Context, lggr logger.Sug
 		MetricsRegisterer:      prometheus.WrapRegistererWith(map[string]string{"job_name": jb.Name.ValueOrZero()}, prometheus.DefaultRegisterer),
 	}
 
-	return ccipexec.NewExecServices(ctx, lggr, jb, srcProvider, dstProvider, int64(srcChainID), dstChainID, d.isNewlyCreatedJob, oracleArgsNoPlugin2, logError)
+	return ccipexec.NewExecServices(ctx, lggr, jb, srcProvider, dstProvider, int64(srcChainID), dstChainID, d.isNewlyCreatedJob, oracleArgsNoPlugin2, logError, d.cfg.Tracing())
 }
 
 func (d *Delegate) ccipExecGetDstProvider(ctx context.Context, jb job.Job, pluginJobSpecConfig ccipconfig.ExecPluginJobSpecConfig, transmitterID string) (types.CCIPExecProvider, error) {
@@ -33,6 +33,7 @@ import (
 	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
@@ -41,6 +42,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/oraclelib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/otelwrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
@@ -68,6 +70,7 @@ func NewCommitServices(
 	logError func(string),
 	pluginJobSpecConfig ccipconfig.CommitPluginJobSpecConfig,
 	relayGetter RelayGetter,
+	tracing coreconfig.Tracing,
 ) ([]job.ServiceCtx, error) {
 	spec := jb.OCR2OracleSpec
 
@@ -209,6 +212,7 @@ func NewCommitServices(
 		priceService:                  priceService,
 	})
 	argsNoPlugin.ReportingPluginFactory = promwrapper.NewPromFactory(wrappedPluginFactory, "CCIPCommit", jb.OCR2OracleSpec.Relay, big.NewInt(0).SetInt64(destChainID))
+	argsNoPlugin.ReportingPluginFactory = otelwrapper.NewOtelFactory(argsNoPlugin.ReportingPluginFactory, "CCIPCommit", jb.OCR2OracleSpec.Relay, strconv.FormatInt(destChainID, 10), tracing)
 	argsNoPlugin.Logger = commonlogger.NewOCRWrapper(commitLggr, true, logError)
 	oracle, err := libocr2.NewOracle(argsNoPlugin)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -30,11 +31,21 @@ type PluginConfig struct {
 	// ChannelDefinitionsContractFromBlock will be ignored
 	ChannelDefinitions string `json:"channelDefinitions" toml:"channelDefinitions"`
 
+	// NOTE: ChannelDefinitionsSource is also an override.
+	// If specified, channel definitions are polled from the given URL or file
+	// and values for ChannelDefinitionsContractAddress and
+	// ChannelDefinitionsContractFromBlock will be ignored
+	ChannelDefinitionsSource *ChannelDefinitionsSourceConfig `json:"channelDefinitionsSource" toml:"channelDefinitionsSource"`
+
 	// BenchmarkMode is a flag to enable benchmarking mode. In this mode, the
 	// transmitter will not transmit anything at all and instead emit
 	// logs/metrics.
 	BenchmarkMode bool `json:"benchmarkMode" toml:"benchmarkMode"`
 
+	// ArchiveReports enables a local, append-only archive of every
+	// transmitted report, which can later be queried and replayed
+	ArchiveReports bool `json:"archiveReports" toml:"archiveReports"`
+
 	// KeyBundleIDs maps supported keys to their respective bundle IDs
 	// Key must match llo's ReportFormat
 	KeyBundleIDs map[string]string `json:"keyBundleIDs" toml:"keyBundleIDs"`
@@ -47,6 +58,58 @@ type PluginConfig struct {
 	Transmitters []TransmitterConfig `json:"transmitters" toml:"transmitters"`
 }
 
+type ChannelDefinitionsSourceConfig struct {
+	// URL is an http(s):// URL, file:// URL or filesystem path of a
+	// channeldefinitions.SignedChannelDefinitions document
+	URL string `json:"url" toml:"url"`
+	// SignatureURL locates the detached signature of the document. Defaults
+	// to URL with a ".sig" suffix
+	SignatureURL string `json:"signatureURL" toml:"signatureURL"`
+	// Signers are the addresses whose signatures are accepted
+	Signers []common.Address `json:"signers" toml:"signers"`
+	// PollInterval defaults to 1m if unset
+	PollInterval models.Interval `json:"pollInterval" toml:"pollInterval"`
+}
+
+func (c ChannelDefinitionsSourceConfig) Validate() (merr error) {
+	if c.URL == "" {
+		merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsSource.URL must be specified"))
+	} else if err := validateSourceURL(c.URL); err != nil {
+		merr = errors.Join(merr, fmt.Errorf("llo: invalid value for ChannelDefinitionsSource.URL: %w", err))
+	}
+	if c.SignatureURL != "" {
+		if err := validateSourceURL(c.SignatureURL); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("llo: invalid value for ChannelDefinitionsSource.SignatureURL: %w", err))
+		}
+	}
+	if len(c.Signers) == 0 {
+		merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsSource.Signers must contain at least one address"))
+	}
+	for _, s := range c.Signers {
+		if s == (common.Address{}) {
+			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsSource.Signers must not contain the zero address"))
+		}
+	}
+	return merr
+}
+
+func validateSourceURL(rawURL string) error {
+	if !schemeRegexp.MatchString(rawURL) {
+		// plain filesystem path
+		return nil
+	}
+	uri, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	switch uri.Scheme {
+	case "http", "https", "file":
+		return nil
+	default:
+		return fmt.Errorf("unsupported scheme %q, expected http, https or file", uri.Scheme)
+	}
+}
+
 type TransmitterType int
 
 const (
@@ -110,7 +173,19 @@ func (p PluginConfig) Validate() (merr error) {
 		}
 	}
 
-	if p.ChannelDefinitions != "" {
+	if p.ChannelDefinitions != "" && p.ChannelDefinitionsSource != nil {
+		merr = errors.Join(merr, errors.New("llo: ChannelDefinitions and ChannelDefinitionsSource are mutually exclusive"))
+	}
+
+	if p.ChannelDefinitionsSource != nil {
+		if p.ChannelDefinitionsContractAddress != (common.Address{}) {
+			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsContractAddress is not allowed if ChannelDefinitionsSource is specified"))
+		}
+		if p.ChannelDefinitionsContractFromBlock != 0 {
+			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsContractFromBlock is not allowed if ChannelDefinitionsSource is specified"))
+		}
+		merr = errors.Join(merr, p.ChannelDefinitionsSource.Validate())
+	} else if p.ChannelDefinitions != "" {
 		if p.ChannelDefinitionsContractAddress != (common.Address{}) {
 			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsContractAddress is not allowed if ChannelDefinitions is specified"))
 		}
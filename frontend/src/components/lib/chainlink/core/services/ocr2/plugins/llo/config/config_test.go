@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
@@ -97,6 +98,46 @@ func Test_Config(t *testing.T) {
 			err = mc.Validate()
 			require.NoError(t, err)
 		})
+		t.Run("with only channelDefinitionsSource", func(t *testing.T) {
+			rawToml := `
+			Servers = { "example.com:80" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93" }
+			DonID = 12345
+			[ChannelDefinitionsSource]
+			URL = "https://example.com/channel-definitions.json"
+			Signers = ["0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"]
+			PollInterval = "30s"`
+
+			var mc PluginConfig
+			err := toml.Unmarshal([]byte(rawToml), &mc)
+			require.NoError(t, err)
+
+			require.NotNil(t, mc.ChannelDefinitionsSource)
+			assert.Equal(t, "https://example.com/channel-definitions.json", mc.ChannelDefinitionsSource.URL)
+			require.Len(t, mc.ChannelDefinitionsSource.Signers, 1)
+			assert.Equal(t, "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF", mc.ChannelDefinitionsSource.Signers[0].Hex())
+			assert.Equal(t, 30*time.Second, mc.ChannelDefinitionsSource.PollInterval.Duration())
+
+			err = mc.Validate()
+			require.NoError(t, err)
+		})
+		t.Run("with invalid channelDefinitionsSource", func(t *testing.T) {
+			rawToml := `
+			Servers = { "example.com:80" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93" }
+			DonID = 12345
+			ChannelDefinitionsContractAddress = "0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+			[ChannelDefinitionsSource]
+			URL = "ftp://example.com/channel-definitions.json"`
+
+			var mc PluginConfig
+			err := toml.Unmarshal([]byte(rawToml), &mc)
+			require.NoError(t, err)
+
+			err = mc.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "llo: ChannelDefinitionsContractAddress is not allowed if ChannelDefinitionsSource is specified")
+			assert.Contains(t, err.Error(), `llo: invalid value for ChannelDefinitionsSource.URL: unsupported scheme "ftp", expected http, https or file`)
+			assert.Contains(t, err.Error(), "llo: ChannelDefinitionsSource.Signers must contain at least one address")
+		})
 		t.Run("with missing ChannelDefinitionsContractAddress", func(t *testing.T) {
 			rawToml := `
 			DonID = 12345
@@ -13,11 +13,13 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-feeds/median"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/otelwrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
@@ -26,6 +28,7 @@ import (
 type MedianConfig interface {
 	JobPipelineMaxSuccessfulRuns() uint64
 	JobPipelineResultWriteQueueDepth() uint64
+	Tracing() coreconfig.Tracing
 	plugins.RegistrarConfig
 }
 
@@ -33,13 +36,15 @@ type MedianConfig interface {
 type medianConfig struct {
 	jobPipelineMaxSuccessfulRuns     uint64
 	jobPipelineResultWriteQueueDepth uint64
+	tracing                          coreconfig.Tracing
 	plugins.RegistrarConfig
 }
 
-func NewMedianConfig(jobPipelineMaxSuccessfulRuns uint64, jobPipelineResultWriteQueueDepth uint64, pluginProcessCfg plugins.RegistrarConfig) MedianConfig {
+func NewMedianConfig(jobPipelineMaxSuccessfulRuns uint64, jobPipelineResultWriteQueueDepth uint64, tracing coreconfig.Tracing, pluginProcessCfg plugins.RegistrarConfig) MedianConfig {
 	return &medianConfig{
 		jobPipelineMaxSuccessfulRuns:     jobPipelineMaxSuccessfulRuns,
 		jobPipelineResultWriteQueueDepth: jobPipelineResultWriteQueueDepth,
+		tracing:                          tracing,
 		RegistrarConfig:                  pluginProcessCfg,
 	}
 }
@@ -52,6 +57,10 @@ func (m *medianConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return m.jobPipelineResultWriteQueueDepth
 }
 
+func (m *medianConfig) Tracing() coreconfig.Tracing {
+	return m.tracing
+}
+
 func NewMedianServices(ctx context.Context,
 	jb job.Job,
 	isNewlyCreatedJob bool,
@@ -179,6 +188,13 @@ func NewMedianServices(ctx context.Context,
 		}
 	}
 
+	rid, err := spec.RelayID()
+	if err != nil {
+		abort()
+		return
+	}
+	argsNoPlugin.ReportingPluginFactory = otelwrapper.NewOtelFactory(argsNoPlugin.ReportingPluginFactory, "Median", rid.Network, rid.ChainID, cfg.Tracing())
+
 	var oracle libocr.Oracle
 	oracle, err = libocr.NewOracle(argsNoPlugin)
 	if err != nil {
@@ -38,6 +38,10 @@ type PluginConfig struct {
 
 	LinkFeedID   *mercuryutils.FeedID `json:"linkFeedID" toml:"linkFeedID"`
 	NativeFeedID *mercuryutils.FeedID `json:"nativeFeedID" toml:"nativeFeedID"`
+
+	// ArchiveReports enables a local, append-only archive of every
+	// transmitted report, which can later be queried and replayed
+	ArchiveReports bool `json:"archiveReports" toml:"archiveReports"`
 }
 
 func validateURL(rawServerURL string) error {
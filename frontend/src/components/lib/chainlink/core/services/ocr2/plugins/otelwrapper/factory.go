@@ -0,0 +1,43 @@
+package otelwrapper
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+var _ types.ReportingPluginFactory = &otelFactory{}
+
+type otelFactory struct {
+	wrapped       types.ReportingPluginFactory
+	name          string
+	chainType     string
+	chainID       string
+	samplingRatio float64
+}
+
+func (p *otelFactory) NewReportingPlugin(ctx context.Context, config types.ReportingPluginConfig) (types.ReportingPlugin, types.ReportingPluginInfo, error) {
+	plugin, info, err := p.wrapped.NewReportingPlugin(ctx, config)
+	if err != nil {
+		return nil, types.ReportingPluginInfo{}, err
+	}
+
+	return New(plugin, p.name, p.chainType, p.chainID, config, p.samplingRatio, nil), info, nil
+}
+
+// NewOtelFactory wraps the plugins created by wrapped if tracing is enabled,
+// and otherwise returns wrapped unchanged
+func NewOtelFactory(wrapped types.ReportingPluginFactory, name, chainType, chainID string, cfg config.Tracing) types.ReportingPluginFactory {
+	if cfg == nil || !cfg.Enabled() {
+		return wrapped
+	}
+	return &otelFactory{
+		wrapped:       wrapped,
+		name:          name,
+		chainType:     chainType,
+		chainID:       chainID,
+		samplingRatio: cfg.SamplingRatio(),
+	}
+}
@@ -0,0 +1,171 @@
+// otelwrapper wraps another OCR2 reporting plugin and emits an OpenTelemetry
+// span for each of the OCR2 phases (Query, Observation, Report,
+// ShouldAcceptFinalizedReport and ShouldTransmitAcceptedReport).
+//
+// All spans of a round share a trace ID derived from the round's config
+// digest, epoch and round number, so the phases of a round (and any pipeline
+// runs or bridge calls made with the phase's context) can be found in a
+// single trace, on every node of the DON. Spans are exported through the
+// global tracer provider, which is configured from the node's Tracing
+// config.
+package otelwrapper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/otelwrapper"
+
+var _ types.ReportingPlugin = &otelPlugin{}
+
+// otelPlugin consumes a report plugin and wraps its core functions e.g Report(), Observe()...
+type otelPlugin struct {
+	wrapped       types.ReportingPlugin
+	tracer        trace.Tracer
+	samplingRatio float64
+	attributes    []attribute.KeyValue
+}
+
+// New wraps plugin. Rounds are sampled with probability samplingRatio,
+// consistently across phases and nodes. If tracer is nil, a tracer from the
+// global tracer provider is used.
+func New(
+	plugin types.ReportingPlugin,
+	name string,
+	chainType string,
+	chainID string,
+	config types.ReportingPluginConfig,
+	samplingRatio float64,
+	tracer trace.Tracer,
+) types.ReportingPlugin {
+	if tracer == nil {
+		tracer = otel.Tracer(tracerName)
+	}
+	return &otelPlugin{
+		wrapped:       plugin,
+		tracer:        tracer,
+		samplingRatio: samplingRatio,
+		attributes: []attribute.KeyValue{
+			attribute.String("ocr2.plugin", name),
+			attribute.String("ocr2.chain_type", chainType),
+			attribute.String("ocr2.chain_id", chainID),
+			attribute.Int("ocr2.oracle_id", int(config.OracleID)),
+		},
+	}
+}
+
+func (p *otelPlugin) Query(ctx context.Context, timestamp types.ReportTimestamp) (q types.Query, err error) {
+	ctx, span := p.start(ctx, "Query", timestamp)
+	defer func() { end(span, err) }()
+
+	return p.wrapped.Query(ctx, timestamp)
+}
+
+func (p *otelPlugin) Observation(ctx context.Context, timestamp types.ReportTimestamp, query types.Query) (o types.Observation, err error) {
+	ctx, span := p.start(ctx, "Observation", timestamp)
+	defer func() { end(span, err) }()
+
+	return p.wrapped.Observation(ctx, timestamp, query)
+}
+
+func (p *otelPlugin) Report(ctx context.Context, timestamp types.ReportTimestamp, query types.Query, observations []types.AttributedObservation) (shouldReport bool, report types.Report, err error) {
+	ctx, span := p.start(ctx, "Report", timestamp)
+	span.SetAttributes(attribute.Int("ocr2.observations", len(observations)))
+	defer func() {
+		span.SetAttributes(attribute.Bool("ocr2.should_report", shouldReport))
+		end(span, err)
+	}()
+
+	return p.wrapped.Report(ctx, timestamp, query, observations)
+}
+
+func (p *otelPlugin) ShouldAcceptFinalizedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (accept bool, err error) {
+	ctx, span := p.start(ctx, "ShouldAcceptFinalizedReport", timestamp)
+	defer func() {
+		span.SetAttributes(attribute.Bool("ocr2.should_accept", accept))
+		end(span, err)
+	}()
+
+	return p.wrapped.ShouldAcceptFinalizedReport(ctx, timestamp, report)
+}
+
+func (p *otelPlugin) ShouldTransmitAcceptedReport(ctx context.Context, timestamp types.ReportTimestamp, report types.Report) (transmit bool, err error) {
+	ctx, span := p.start(ctx, "ShouldTransmitAcceptedReport", timestamp)
+	defer func() {
+		span.SetAttributes(attribute.Bool("ocr2.should_transmit", transmit))
+		end(span, err)
+	}()
+
+	return p.wrapped.ShouldTransmitAcceptedReport(ctx, timestamp, report)
+}
+
+// Note: the 'Close' method does not have access to a report timestamp, as it is not part of report generation.
+func (p *otelPlugin) Close() error {
+	return p.wrapped.Close()
+}
+
+func (p *otelPlugin) start(ctx context.Context, phase string, timestamp types.ReportTimestamp) (context.Context, trace.Span) {
+	ctx = trace.ContextWithRemoteSpanContext(ctx, roundSpanContext(timestamp, p.samplingRatio))
+	attrs := append([]attribute.KeyValue{
+		attribute.String("ocr2.config_digest", hex.EncodeToString(timestamp.ConfigDigest[:])),
+		attribute.Int64("ocr2.epoch", int64(timestamp.Epoch)),
+		attribute.Int("ocr2.round", int(timestamp.Round)),
+	}, p.attributes...)
+	return p.tracer.Start(ctx, "OCR2."+phase, trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(attrs...))
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// roundSpanContext returns a span context that is the same for every phase
+// of a round on every node, to be used as the remote parent of phase spans.
+// The sampling decision is made the same way as the SDK's TraceIDRatioBased
+// sampler so that it is also consistent across nodes.
+func roundSpanContext(timestamp types.ReportTimestamp, samplingRatio float64) trace.SpanContext {
+	b := make([]byte, 0, len(timestamp.ConfigDigest)+5)
+	b = append(b, timestamp.ConfigDigest[:]...)
+	b = binary.BigEndian.AppendUint32(b, timestamp.Epoch)
+	b = append(b, timestamp.Round)
+	sum := sha256.Sum256(b)
+
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+
+	var flags trace.TraceFlags
+	if sampled(traceID, samplingRatio) {
+		flags = trace.FlagsSampled
+	}
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: flags,
+		Remote:     true,
+	})
+}
+
+func sampled(traceID trace.TraceID, ratio float64) bool {
+	if ratio >= 1 {
+		return true
+	}
+	if ratio <= 0 {
+		return false
+	}
+	bound := uint64(ratio * (1 << 63))
+	return binary.BigEndian.Uint64(traceID[8:16])>>1 < bound
+}
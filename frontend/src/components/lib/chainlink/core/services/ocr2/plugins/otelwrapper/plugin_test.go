@@ -0,0 +1,150 @@
+package otelwrapper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// fakeReportingPlugin records the span context it is called with.
+type fakeReportingPlugin struct {
+	spanContexts []trace.SpanContext
+	reportErr    error
+}
+
+func (f *fakeReportingPlugin) Query(ctx context.Context, _ types.ReportTimestamp) (types.Query, error) {
+	f.spanContexts = append(f.spanContexts, trace.SpanContextFromContext(ctx))
+	return nil, nil
+}
+func (f *fakeReportingPlugin) Observation(ctx context.Context, _ types.ReportTimestamp, _ types.Query) (types.Observation, error) {
+	f.spanContexts = append(f.spanContexts, trace.SpanContextFromContext(ctx))
+	return nil, nil
+}
+func (f *fakeReportingPlugin) Report(ctx context.Context, _ types.ReportTimestamp, _ types.Query, _ []types.AttributedObservation) (bool, types.Report, error) {
+	f.spanContexts = append(f.spanContexts, trace.SpanContextFromContext(ctx))
+	return true, nil, f.reportErr
+}
+func (f *fakeReportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, _ types.ReportTimestamp, _ types.Report) (bool, error) {
+	f.spanContexts = append(f.spanContexts, trace.SpanContextFromContext(ctx))
+	return true, nil
+}
+func (f *fakeReportingPlugin) ShouldTransmitAcceptedReport(ctx context.Context, _ types.ReportTimestamp, _ types.Report) (bool, error) {
+	f.spanContexts = append(f.spanContexts, trace.SpanContextFromContext(ctx))
+	return false, nil
+}
+func (f *fakeReportingPlugin) Close() error {
+	return nil
+}
+
+var _ types.ReportingPlugin = &fakeReportingPlugin{}
+
+func newTestTracer(t *testing.T) (trace.Tracer, *tracetest.SpanRecorder) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	return tp.Tracer("test"), sr
+}
+
+func runRound(ctx context.Context, t *testing.T, p types.ReportingPlugin, ts types.ReportTimestamp) {
+	_, err := p.Query(ctx, ts)
+	require.NoError(t, err)
+	_, err = p.Observation(ctx, ts, nil)
+	require.NoError(t, err)
+	_, _, _ = p.Report(ctx, ts, nil, nil)
+	_, err = p.ShouldAcceptFinalizedReport(ctx, ts, nil)
+	require.NoError(t, err)
+	_, err = p.ShouldTransmitAcceptedReport(ctx, ts, nil)
+	require.NoError(t, err)
+}
+
+func TestPlugin_Spans(t *testing.T) {
+	ctx := testutils.Context(t)
+	configDigest := common.BytesToHash(crypto.Keccak256([]byte("foobar")))
+	ts := types.ReportTimestamp{
+		ConfigDigest: types.ConfigDigest(configDigest),
+		Epoch:        2,
+		Round:        3,
+	}
+
+	tracer, sr := newTestTracer(t)
+	reportingPlugin := &fakeReportingPlugin{reportErr: errors.New("report failed")}
+	p := New(reportingPlugin, "test-plugin", "EVM", "1", types.ReportingPluginConfig{OracleID: 4}, 1, tracer)
+	runRound(ctx, t, p, ts)
+
+	spans := sr.Ended()
+	require.Len(t, spans, 5)
+	names := []string{"OCR2.Query", "OCR2.Observation", "OCR2.Report", "OCR2.ShouldAcceptFinalizedReport", "OCR2.ShouldTransmitAcceptedReport"}
+	for i, span := range spans {
+		assert.Equal(t, names[i], span.Name())
+		// all phases of a round share a trace
+		assert.Equal(t, spans[0].SpanContext().TraceID(), span.SpanContext().TraceID())
+		assert.True(t, span.SpanContext().IsSampled())
+		// the wrapped plugin is called with the phase span in its context
+		assert.Equal(t, span.SpanContext(), reportingPlugin.spanContexts[i])
+
+		attrs := attribute.NewSet(span.Attributes()...)
+		for _, expected := range []attribute.KeyValue{
+			attribute.String("ocr2.config_digest", common.Bytes2Hex(configDigest[:])),
+			attribute.Int64("ocr2.epoch", 2),
+			attribute.Int("ocr2.round", 3),
+			attribute.String("ocr2.plugin", "test-plugin"),
+			attribute.String("ocr2.chain_type", "EVM"),
+			attribute.String("ocr2.chain_id", "1"),
+			attribute.Int("ocr2.oracle_id", 4),
+		} {
+			v, ok := attrs.Value(expected.Key)
+			require.True(t, ok, "missing attribute %s on %s", expected.Key, span.Name())
+			assert.Equal(t, expected.Value, v)
+		}
+	}
+
+	assert.Equal(t, codes.Error, spans[2].Status().Code)
+	assert.Equal(t, "report failed", spans[2].Status().Description)
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+	v, _ := attribute.NewSet(spans[4].Attributes()...).Value("ocr2.should_transmit")
+	assert.False(t, v.AsBool())
+
+	t.Run("trace ID is deterministic per round", func(t *testing.T) {
+		tracer2, sr2 := newTestTracer(t)
+		p2 := New(&fakeReportingPlugin{}, "test-plugin", "EVM", "1", types.ReportingPluginConfig{OracleID: 5}, 1, tracer2)
+		runRound(ctx, t, p2, ts)
+		next := ts
+		next.Round++
+		runRound(ctx, t, p2, next)
+
+		spans2 := sr2.Ended()
+		require.Len(t, spans2, 10)
+		assert.Equal(t, spans[0].SpanContext().TraceID(), spans2[0].SpanContext().TraceID())
+		assert.NotEqual(t, spans[0].SpanContext().TraceID(), spans2[5].SpanContext().TraceID())
+	})
+
+	t.Run("respects sampling ratio", func(t *testing.T) {
+		tracer3, sr3 := newTestTracer(t)
+		p3 := New(&fakeReportingPlugin{}, "test-plugin", "EVM", "1", types.ReportingPluginConfig{}, 0, tracer3)
+		runRound(ctx, t, p3, ts)
+		assert.Empty(t, sr3.Ended())
+	})
+}
+
+func Test_sampled(t *testing.T) {
+	var lo, hi trace.TraceID
+	hi[8] = 0xff
+	assert.True(t, sampled(hi, 1))
+	assert.False(t, sampled(lo, 0))
+	assert.True(t, sampled(lo, 0.5))
+	assert.False(t, sampled(hi, 0.5))
+}
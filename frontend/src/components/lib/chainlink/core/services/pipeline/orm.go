@@ -19,8 +19,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
-// KeepersObservationSource is the same for all keeper jobs and it is not persisted in DB
-const KeepersObservationSource = `
+// keepersCheckAndSimulateTasks check an upkeep and simulate its perform
+// transaction, and are shared by live and dry-run keeper jobs
+const keepersCheckAndSimulateTasks = `
     encode_check_upkeep_tx      [type=ethabiencode
                                  abi="checkUpkeep(uint256 id, address from)"
                                  data="{\"id\":$(jobSpec.upkeepID),\"from\":$(jobSpec.effectiveKeeperAddress)}"]
@@ -56,7 +57,12 @@ const KeepersObservationSource = `
                                  data="$(encode_perform_upkeep_tx)"]
     decode_check_perform_tx     [type=ethabidecode
                                  abi="bool success"]
-    check_success            	[type=conditional
+`
+
+const keepersCheckAndSimulateEdges = `encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx`
+
+// KeepersObservationSource is the same for all keeper jobs and it is not persisted in DB
+const KeepersObservationSource = keepersCheckAndSimulateTasks + `    check_success            	[type=conditional
                                  failEarly=true
                                  data="$(decode_check_perform_tx.success)"]
     perform_upkeep_tx        	[type=ethtx
@@ -67,7 +73,13 @@ const KeepersObservationSource = `
                                  data="$(encode_perform_upkeep_tx)"
                                  gasLimit="$(jobSpec.performUpkeepGasLimit)"
                                  txMeta="{\"jobID\":$(jobSpec.jobID),\"upkeepID\":$(jobSpec.prettyID)}"]
-    encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success -> perform_upkeep_tx
+    ` + keepersCheckAndSimulateEdges + ` -> check_success -> perform_upkeep_tx
+`
+
+// KeepersDryRunObservationSource is used instead of KeepersObservationSource by
+// keeper jobs in dry-run mode. It checks upkeeps and simulates the perform
+// call, but never broadcasts a transaction.
+const KeepersDryRunObservationSource = keepersCheckAndSimulateTasks + `    ` + keepersCheckAndSimulateEdges + `
 `
 
 type CreateDataSource interface {
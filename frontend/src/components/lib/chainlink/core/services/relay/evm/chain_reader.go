@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"iter"
 	"maps"
+	"math/big"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	commoncodec "github.com/smartcontractkit/chainlink-common/pkg/codec"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -31,6 +34,18 @@ import (
 type ChainReaderService interface {
 	services.ServiceCtx
 	commontypes.ContractReader
+	HistoricalContractReader
+}
+
+// HistoricalContractReader reads contract methods at a specific block rather than at a confidence level. Blocks older
+// than the state retained by the RPC node can only be read from an archive node.
+type HistoricalContractReader interface {
+	// GetValueAtBlock reads a method at the block number and returns the head it was read at.
+	GetValueAtBlock(ctx context.Context, readName string, blockNumber *big.Int, params, returnVal any) (*commontypes.Head, error)
+	// GetValueAtBlockHash reads a method at the block hash, which must be canonical, and returns the head it was read at.
+	GetValueAtBlockHash(ctx context.Context, readName string, blockHash common.Hash, params, returnVal any) (*commontypes.Head, error)
+	// IsArchiveNode returns true if the RPC node serves the state of old blocks.
+	IsArchiveNode(ctx context.Context) (bool, error)
 }
 
 type chainReader struct {
@@ -43,6 +58,9 @@ type chainReader struct {
 	bindings *read.BindingsRegistry
 	codec    commontypes.RemoteCodec
 	commonservices.StateMachine
+
+	archiveMu sync.Mutex
+	isArchive *bool
 }
 
 type EVMClient interface {
@@ -74,14 +92,31 @@ func NewChainReaderService(_ context.Context, lggr logger.Logger, lp logpoller.L
 		return nil, err
 	}
 
-	cr.bindings.SetBatchCaller(read.NewDynamicLimitedBatchCaller(
-		cr.lggr,
-		cr.codec,
-		cr.client,
-		read.DefaultRpcBatchSizeLimit,
-		read.DefaultRpcBatchBackOffMultiplier,
-		read.DefaultMaxParallelRpcCalls,
-	))
+	if config.Multicall3 != nil {
+		multicallAddress := read.DefaultMulticall3Address
+		if config.Multicall3.Address != nil {
+			multicallAddress = *config.Multicall3.Address
+		}
+
+		cr.bindings.SetBatchCaller(read.NewMulticall3BatchCaller(
+			cr.lggr,
+			cr.codec,
+			cr.client,
+			multicallAddress,
+			read.DefaultRpcBatchSizeLimit,
+			read.DefaultRpcBatchBackOffMultiplier,
+			read.DefaultMaxParallelRpcCalls,
+		))
+	} else {
+		cr.bindings.SetBatchCaller(read.NewDynamicLimitedBatchCaller(
+			cr.lggr,
+			cr.codec,
+			cr.client,
+			read.DefaultRpcBatchSizeLimit,
+			read.DefaultRpcBatchBackOffMultiplier,
+			read.DefaultMaxParallelRpcCalls,
+		))
+	}
 
 	cr.bindings.SetCodecAll(cr.codec)
 
@@ -263,6 +298,77 @@ func (cr *chainReader) GetLatestValueWithHeadData(ctx context.Context, readName
 	return head, nil
 }
 
+func (cr *chainReader) GetValueAtBlock(ctx context.Context, readName string, blockNumber *big.Int, params, returnVal any) (*commontypes.Head, error) {
+	if blockNumber == nil || !blockNumber.IsInt64() || blockNumber.Sign() < 0 {
+		return nil, fmt.Errorf("%w: invalid block number: %v", commontypes.ErrInvalidType, blockNumber)
+	}
+
+	return cr.getValueAtBlock(ctx, readName, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(blockNumber.Int64())), params, returnVal)
+}
+
+func (cr *chainReader) GetValueAtBlockHash(ctx context.Context, readName string, blockHash common.Hash, params, returnVal any) (*commontypes.Head, error) {
+	return cr.getValueAtBlock(ctx, readName, rpc.BlockNumberOrHashWithHash(blockHash, true), params, returnVal)
+}
+
+func (cr *chainReader) getValueAtBlock(ctx context.Context, readName string, block rpc.BlockNumberOrHash, params, returnVal any) (*commontypes.Head, error) {
+	binding, address, err := cr.bindings.GetReader(readName)
+	if err != nil {
+		return nil, err
+	}
+
+	methodBinding, ok := binding.(*read.MethodBinding)
+	if !ok {
+		return nil, fmt.Errorf("%w: read %s is not a method", commontypes.ErrInvalidType, readName)
+	}
+
+	ptrToValue, isValue := returnVal.(*values.Value)
+	if !isValue {
+		return methodBinding.GetValueAtBlock(ctx, common.HexToAddress(address), block, params, returnVal)
+	}
+
+	contractType, err := cr.CreateContractType(readName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := methodBinding.GetValueAtBlock(ctx, common.HexToAddress(address), block, params, contractType)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := values.Wrap(contractType)
+	if err != nil {
+		return nil, err
+	}
+
+	*ptrToValue = value
+
+	return head, nil
+}
+
+// IsArchiveNode probes the RPC node on the first call and caches the result once the probe succeeds.
+func (cr *chainReader) IsArchiveNode(ctx context.Context) (bool, error) {
+	cr.archiveMu.Lock()
+	defer cr.archiveMu.Unlock()
+
+	if cr.isArchive != nil {
+		return *cr.isArchive, nil
+	}
+
+	isArchive, err := read.DetectArchiveNode(ctx, cr.client)
+	if err != nil {
+		return false, err
+	}
+
+	if !isArchive {
+		cr.lggr.Info("RPC node is not an archive node, reads of blocks older than the state it retains will fail")
+	}
+
+	cr.isArchive = &isArchive
+
+	return isArchive, nil
+}
+
 func (cr *chainReader) BatchGetLatestValues(ctx context.Context, request commontypes.BatchGetLatestValuesRequest) (commontypes.BatchGetLatestValuesResult, error) {
 	return cr.bindings.BatchGetLatestValues(ctx, request)
 }
@@ -364,7 +470,15 @@ func (cr *chainReader) addMethod(
 		return err
 	}
 
-	if err = cr.bindings.AddReader(contractName, methodName, read.NewMethodBinding(contractName, methodName, cr.client, cr.ht, confirmations, cr.lggr)); err != nil {
+	methodBinding := read.NewMethodBinding(contractName, methodName, cr.client, cr.ht, confirmations, cr.lggr)
+	if chainReaderDefinition.CacheEnabled {
+		methodBinding.EnableCache(chainReaderDefinition.CacheTTL.Duration())
+	}
+	if chainReaderDefinition.MulticallDisabled {
+		methodBinding.DisableMulticall()
+	}
+
+	if err = cr.bindings.AddReader(contractName, methodName, methodBinding); err != nil {
 		return err
 	}
 
@@ -21,12 +21,14 @@ import (
 	evmtxmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/codec"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/read"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 )
 
 type ChainWriterService interface {
 	services.ServiceCtx
 	commontypes.ContractWriter
+	BundleWriter
 }
 
 // Compile-time assertion that chainWriter implements the ChainWriterService interface.
@@ -48,6 +50,14 @@ func NewChainWriterService(logger logger.Logger, client evmclient.Client, txm ev
 		parsedContracts: &codec.ParsedTypes{EncoderDefs: map[string]types.CodecEntry{}, DecoderDefs: map[string]types.CodecEntry{}},
 	}
 
+	if config.Multicall3 != nil {
+		multicallAddress := read.DefaultMulticall3Address
+		if config.Multicall3.Address != nil {
+			multicallAddress = *config.Multicall3.Address
+		}
+		w.multicall3 = &multicallAddress
+	}
+
 	if err := w.parseContracts(); err != nil {
 		return nil, fmt.Errorf("%w: failed to parse contracts", err)
 	}
@@ -68,6 +78,7 @@ type chainWriter struct {
 	txm         evmtxmgr.TxManager
 	ge          gas.EvmFeeEstimator
 	maxGasPrice *assets.Wei
+	multicall3  *common.Address
 
 	contracts       map[string]*types.ContractConfig
 	parsedContracts *codec.ParsedTypes
@@ -81,23 +92,38 @@ type chainWriter struct {
 // `nil` values, including for slices. Until the bug is fixed we need to ensure that there are no
 // `nil` values passed in the request.
 func (w *chainWriter) SubmitTransaction(ctx context.Context, contract, method string, args any, transactionID string, toAddress string, meta *commontypes.TxMeta, value *big.Int) error {
+	req, err := w.newTxRequest(ctx, contract, method, args, toAddress, meta, value)
+	if err != nil {
+		return err
+	}
+	req.IdempotencyKey = &transactionID
+
+	_, err = w.txm.CreateTransaction(ctx, req)
+	if err != nil {
+		return fmt.Errorf("%w; failed to create tx", err)
+	}
+
+	return nil
+}
+
+func (w *chainWriter) newTxRequest(ctx context.Context, contract, method string, args any, toAddress string, meta *commontypes.TxMeta, value *big.Int) (evmtxmgr.TxRequest, error) {
 	if !common.IsHexAddress(toAddress) {
-		return fmt.Errorf("toAddress is not a valid ethereum address: %v", toAddress)
+		return evmtxmgr.TxRequest{}, fmt.Errorf("toAddress is not a valid ethereum address: %v", toAddress)
 	}
 
 	contractConfig, ok := w.contracts[contract]
 	if !ok {
-		return fmt.Errorf("contract config not found: %v", contract)
+		return evmtxmgr.TxRequest{}, fmt.Errorf("contract config not found: %v", contract)
 	}
 
 	methodConfig, ok := contractConfig.Configs[method]
 	if !ok {
-		return fmt.Errorf("method config not found: %v", method)
+		return evmtxmgr.TxRequest{}, fmt.Errorf("method config not found: %v", method)
 	}
 
 	calldata, err := w.encoder.Encode(ctx, args, codec.WrapItemType(contract, method, true))
 	if err != nil {
-		return fmt.Errorf("%w: failed to encode args", err)
+		return evmtxmgr.TxRequest{}, fmt.Errorf("%w: failed to encode args", err)
 	}
 
 	var checker evmtxmgr.TransmitCheckerSpec
@@ -122,24 +148,16 @@ func (w *chainWriter) SubmitTransaction(ctx context.Context, contract, method st
 		gasLimit = meta.GasLimit.Uint64()
 	}
 
-	req := evmtxmgr.TxRequest{
+	return evmtxmgr.TxRequest{
 		FromAddress:    methodConfig.FromAddress,
 		ToAddress:      common.HexToAddress(toAddress),
 		EncodedPayload: calldata,
 		FeeLimit:       gasLimit,
 		Meta:           txMeta,
-		IdempotencyKey: &transactionID,
 		Strategy:       txmgr.NewSendEveryStrategy(),
 		Checker:        checker,
 		Value:          *v,
-	}
-
-	_, err = w.txm.CreateTransaction(ctx, req)
-	if err != nil {
-		return fmt.Errorf("%w; failed to create tx", err)
-	}
-
-	return nil
+	}, nil
 }
 
 func (w *chainWriter) parseContracts() error {
@@ -175,7 +193,16 @@ func (w *chainWriter) parseContracts() error {
 }
 
 func (w *chainWriter) GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error) {
-	return w.txm.GetTransactionStatus(ctx, transactionID)
+	status, err := w.txm.GetTransactionStatus(ctx, transactionID)
+	if err == nil {
+		return status, nil
+	}
+
+	if bundleStatus, ok := w.getBundleStatus(ctx, transactionID); ok {
+		return bundleStatus, nil
+	}
+
+	return status, err
 }
 
 // GetFeeComponents the execution and data availability (L1Oracle) fees for the chain.
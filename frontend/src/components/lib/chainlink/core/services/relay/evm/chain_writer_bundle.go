@@ -0,0 +1,182 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	evmtxmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+const multicall3Aggregate3ValueABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3Value[]","name":"calls","type":"tuple[]"}],"name":"aggregate3Value","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicall3WriterABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(multicall3Aggregate3ValueABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+type multicall3CallValue struct {
+	Target       common.Address
+	AllowFailure bool
+	Value        *big.Int
+	CallData     []byte
+}
+
+// BundleCall is a contract call of a transaction bundle.
+type BundleCall struct {
+	Contract  string
+	Method    string
+	Args      any
+	ToAddress string
+	Value     *big.Int
+}
+
+// BundleWriter submits ordered bundles of contract calls.
+type BundleWriter interface {
+	// SubmitBundle submits the calls in order, all sent from the same key. The bundle is sent atomically as a single
+	// Multicall3 transaction when Multicall3 is configured, or as one transaction per call with consecutive nonces
+	// otherwise. With Multicall3, the contracts are called by the Multicall3 contract rather than by the sending key,
+	// so calls that check msg.sender must not be bundled that way. GetTransactionStatus reports the status of the whole
+	// bundle by its ID. A bundle that was not created in full, e.g. because the queue of the key was full, is not sent
+	// until SubmitBundle is retried with the same ID. If it is still incomplete after ten minutes, the transaction
+	// reaper aborts it and it must be submitted again with another ID.
+	SubmitBundle(ctx context.Context, bundleID string, calls []BundleCall, meta *commontypes.TxMeta) error
+}
+
+// bundleTxID is the idempotency key of a transaction of a bundle sent with consecutive nonces.
+func bundleTxID(bundleID string, index int) string {
+	return fmt.Sprintf("%s/%d", bundleID, index)
+}
+
+func (w *chainWriter) SubmitBundle(ctx context.Context, bundleID string, calls []BundleCall, meta *commontypes.TxMeta) error {
+	if bundleID == "" {
+		return errors.New("bundle ID is required")
+	}
+
+	if len(calls) == 0 {
+		return errors.New("bundle has no calls")
+	}
+
+	reqs := make([]evmtxmgr.TxRequest, len(calls))
+	for i, call := range calls {
+		req, err := w.newTxRequest(ctx, call.Contract, call.Method, call.Args, call.ToAddress, meta, call.Value)
+		if err != nil {
+			return fmt.Errorf("call %d of bundle %s: %w", i, bundleID, err)
+		}
+
+		if i > 0 && req.FromAddress != reqs[0].FromAddress {
+			return fmt.Errorf("call %d of bundle %s is sent from %s, all calls of a bundle must be sent from %s", i, bundleID, req.FromAddress, reqs[0].FromAddress)
+		}
+
+		reqs[i] = req
+	}
+
+	if w.multicall3 != nil {
+		return w.submitMulticallBundle(ctx, bundleID, reqs, meta)
+	}
+
+	// each transaction is created like any other, and the bundle is only sent once all of them exist
+	for i := range reqs {
+		txID := bundleTxID(bundleID, i)
+		reqs[i].IdempotencyKey = &txID
+		reqs[i].Strategy = evmtxmgr.NewBundleStrategy(bundleID, i, len(reqs))
+		if _, err := w.txm.CreateTransaction(ctx, reqs[i]); err != nil {
+			return fmt.Errorf("%w; failed to create tx %d of bundle %s", err, i, bundleID)
+		}
+	}
+
+	return nil
+}
+
+// submitMulticallBundle sends the bundle as a single aggregate3Value call, which reverts if any call reverts. Note that
+// the contracts are called by the Multicall3 contract rather than by the sending key.
+func (w *chainWriter) submitMulticallBundle(ctx context.Context, bundleID string, reqs []evmtxmgr.TxRequest, meta *commontypes.TxMeta) error {
+	calls := make([]multicall3CallValue, len(reqs))
+	value := big.NewInt(0)
+	var gasLimit uint64
+	for i, req := range reqs {
+		calls[i] = multicall3CallValue{
+			Target:   req.ToAddress,
+			Value:    new(big.Int).Set(&req.Value),
+			CallData: req.EncodedPayload,
+		}
+		value.Add(value, &req.Value)
+		gasLimit += req.FeeLimit
+	}
+
+	if meta != nil && meta.GasLimit != nil {
+		gasLimit = meta.GasLimit.Uint64()
+	}
+
+	payload, err := multicall3WriterABI.Pack("aggregate3Value", calls)
+	if err != nil {
+		return fmt.Errorf("%w: failed to encode multicall3 bundle", err)
+	}
+
+	req := reqs[0]
+	req.ToAddress = *w.multicall3
+	req.EncodedPayload = payload
+	req.FeeLimit = gasLimit
+	req.Value = *value
+	req.IdempotencyKey = &bundleID
+	// checkers of the individual calls don't apply to the aggregated call
+	req.Checker = evmtxmgr.TransmitCheckerSpec{}
+
+	if _, err = w.txm.CreateTransaction(ctx, req); err != nil {
+		return fmt.Errorf("%w; failed to create tx for bundle %s", err, bundleID)
+	}
+
+	return nil
+}
+
+// getBundleStatus returns the status of a bundle sent with consecutive nonces, and false if there is no such bundle.
+func (w *chainWriter) getBundleStatus(ctx context.Context, bundleID string) (commontypes.TransactionStatus, bool) {
+	var statuses []commontypes.TransactionStatus
+	for i := 0; ; i++ {
+		status, err := w.txm.GetTransactionStatus(ctx, bundleTxID(bundleID, i))
+		if err != nil {
+			break
+		}
+		statuses = append(statuses, status)
+	}
+
+	if len(statuses) == 0 {
+		return commontypes.Unknown, false
+	}
+
+	return aggregateBundleStatus(statuses), true
+}
+
+// aggregateBundleStatus returns the status of a bundle from the statuses of its transactions: the bundle failed if any
+// transaction failed, and otherwise progresses as its least advanced transaction.
+func aggregateBundleStatus(statuses []commontypes.TransactionStatus) commontypes.TransactionStatus {
+	for _, failed := range []commontypes.TransactionStatus{commontypes.Fatal, commontypes.Failed} {
+		for _, status := range statuses {
+			if status == failed {
+				return failed
+			}
+		}
+	}
+
+	bundleStatus := commontypes.Finalized
+	for _, status := range statuses {
+		switch status {
+		case commontypes.Unknown, commontypes.Pending:
+			return commontypes.Pending
+		case commontypes.Unconfirmed:
+			bundleStatus = commontypes.Unconfirmed
+		}
+	}
+
+	return bundleStatus
+}
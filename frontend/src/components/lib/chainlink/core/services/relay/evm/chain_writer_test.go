@@ -1,10 +1,12 @@
 package evm
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,8 +21,10 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
 	gasmocks "github.com/smartcontractkit/chainlink-evm/pkg/gas/mocks"
 	rollupmocks "github.com/smartcontractkit/chainlink-evm/pkg/gas/rollups/mocks"
+	evmtxmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/read"
 	relayevmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 )
 
@@ -61,6 +65,77 @@ func TestChainWriter(t *testing.T) {
 		// TODO: implement
 	})
 
+	t.Run("SubmitBundle", func(t *testing.T) {
+		args := struct {
+			Receiver      common.Address
+			RawReport     []byte
+			ReportContext []byte
+			Signatures    [][]byte
+		}{testutils.NewAddress(), []byte{1}, []byte{2}, [][]byte{{3}}}
+		toAddress := testutils.NewAddress().Hex()
+		calls := []BundleCall{
+			{Contract: "forwarder", Method: "report", Args: args, ToAddress: toAddress},
+			{Contract: "forwarder", Method: "report", Args: args, ToAddress: toAddress, Value: big.NewInt(5)},
+		}
+
+		t.Run("Sends the calls with consecutive nonces", func(t *testing.T) {
+			for i := range calls {
+				txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req evmtxmgr.TxRequest) bool {
+					strategy, ok := req.Strategy.(evmtxmgr.BundleStrategy)
+					return ok && strategy == evmtxmgr.BundleStrategy{ID: "bundle", Index: i, Size: 2} &&
+						*req.IdempotencyKey == fmt.Sprintf("bundle/%d", i) && req.Value.Int64() == int64(i*5)
+				})).Return(evmtxmgr.Tx{}, nil).Once()
+			}
+
+			require.NoError(t, cw.(BundleWriter).SubmitBundle(ctx, "bundle", calls, nil))
+		})
+
+		t.Run("Sends the calls atomically with Multicall3", func(t *testing.T) {
+			multicallConfig := modifyChainWriterConfig(newBaseChainWriterConfig(), func(cfg *relayevmtypes.ChainWriterConfig) {
+				cfg.Multicall3 = &relayevmtypes.Multicall3Config{}
+			})
+			multicallWriter, err := NewChainWriterService(lggr, client, txm, ge, multicallConfig)
+			require.NoError(t, err)
+
+			txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req evmtxmgr.TxRequest) bool {
+				return req.ToAddress == read.DefaultMulticall3Address && *req.IdempotencyKey == "multicall-bundle" &&
+					req.Value.Int64() == 5 && req.FeeLimit == 400_000 && req.Checker.CheckerType == ""
+			})).Return(evmtxmgr.Tx{}, nil).Once()
+
+			require.NoError(t, multicallWriter.SubmitBundle(ctx, "multicall-bundle", calls, nil))
+		})
+
+		t.Run("Fails with calls from several keys", func(t *testing.T) {
+			otherKeyConfig := modifyChainWriterConfig(newBaseChainWriterConfig(), func(cfg *relayevmtypes.ChainWriterConfig) {
+				cfg.Contracts["other"] = &relayevmtypes.ContractConfig{
+					ContractABI: forwarder.KeystoneForwarderABI,
+					Configs: map[string]*relayevmtypes.ChainWriterDefinition{
+						"report": {ChainSpecificName: "report", FromAddress: testutils.NewAddress(), GasLimit: 200_000},
+					},
+				}
+			})
+			otherKeyWriter, err := NewChainWriterService(lggr, client, txm, ge, otherKeyConfig)
+			require.NoError(t, err)
+
+			err = otherKeyWriter.SubmitBundle(ctx, "invalid-bundle", []BundleCall{
+				{Contract: "forwarder", Method: "report", Args: args, ToAddress: toAddress},
+				{Contract: "other", Method: "report", Args: args, ToAddress: toAddress},
+			}, nil)
+			require.ErrorContains(t, err, "all calls of a bundle must be sent from")
+		})
+
+		t.Run("Reports the status of the bundle", func(t *testing.T) {
+			txm.On("GetTransactionStatus", mock.Anything, "status-bundle").Return(commontypes.Unknown, errors.New("not found")).Once()
+			txm.On("GetTransactionStatus", mock.Anything, "status-bundle/0").Return(commontypes.Finalized, nil).Once()
+			txm.On("GetTransactionStatus", mock.Anything, "status-bundle/1").Return(commontypes.Unconfirmed, nil).Once()
+			txm.On("GetTransactionStatus", mock.Anything, "status-bundle/2").Return(commontypes.Unknown, errors.New("not found")).Once()
+
+			status, err := cw.GetTransactionStatus(ctx, "status-bundle")
+			require.NoError(t, err)
+			assert.Equal(t, commontypes.Unconfirmed, status)
+		})
+	})
+
 	t.Run("GetTransactionStatus", func(t *testing.T) {
 		txs := []struct {
 			txid   string
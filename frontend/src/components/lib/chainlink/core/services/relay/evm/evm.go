@@ -144,6 +144,7 @@ type Relayer struct {
 	lggr                 logger.SugaredLogger
 	csaKeystore          coretypes.Keystore
 	evmKeystore          keys.Store
+	keySelector          *txm.KeySelector
 	codec                commontypes.Codec
 	capabilitiesRegistry coretypes.CapabilitiesRegistry
 
@@ -174,6 +175,9 @@ type RelayerOpts struct {
 	MercuryConfig
 	CapabilitiesRegistry coretypes.CapabilitiesRegistry
 	HTTPClient           *http.Client
+	// KeySelection is the default selection of the sending key of the OCR2, OCR3 and CCIP transmissions of the jobs,
+	// which jobs can override in their relay config.
+	KeySelection txm.KeySelection
 }
 
 func (c RelayerOpts) Validate() error {
@@ -209,8 +213,21 @@ func NewRelayer(lggr logger.Logger, chain legacyevm.Chain, opts RelayerOpts) (*R
 			return nil, fmt.Errorf("failed to get chain selector for chain id %s: %w", chain.ID(), err)
 		}
 		lloORM := llo.NewChainScopedORM(opts.DS, chainSelector)
-		return channeldefinitions.NewChannelDefinitionCacheFactory(sugared, lloORM, chain.LogPoller(), opts.HTTPClient), nil
+		return channeldefinitions.NewChannelDefinitionCacheFactory(sugared, lloORM, chain.LogPoller(), opts.HTTPClient, chainSelector), nil
 	})
+	if err = validateKeySelection(chain, opts.KeySelection); err != nil {
+		return nil, fmt.Errorf("cannot create evm relayer: %w", err)
+	}
+	// the key selector reads the transactions of the chain from the tx store of its txm. Chains without one, e.g.
+	// because they are disabled, pick their sending keys round-robin.
+	var keySelector *txm.KeySelector
+	if txStore, ok := txm.TxStoreOf(chain.TxManager()); ok {
+		keySelector = txm.NewKeySelector(sugared, chain.ID(), txStore, func(ctx context.Context, address common.Address) (*big.Int, error) {
+			return chain.Client().BalanceAt(ctx, address, nil)
+		}, opts.KeySelection)
+	} else if s := opts.KeySelection.Strategy; (s != "" && s != txm.KeySelectionRoundRobin) || opts.KeySelection.ExcludeStuck {
+		sugared.Warnw("Chain has no EVM transaction manager, its sending keys are picked round-robin", "strategy", opts.KeySelection.Strategy)
+	}
 	return &Relayer{
 		ds:                    opts.DS,
 		chain:                 chain,
@@ -218,6 +235,7 @@ func NewRelayer(lggr logger.Logger, chain legacyevm.Chain, opts RelayerOpts) (*R
 		registerer:            opts.Registerer,
 		csaKeystore:           opts.CSAKeystore,
 		evmKeystore:           opts.EVMKeystore,
+		keySelector:           keySelector,
 		mercuryPool:           opts.MercuryPool,
 		cdcFactory:            cdcFactory,
 		retirementReportCache: opts.RetirementReportCache,
@@ -333,7 +351,7 @@ func (r *Relayer) NewOCR3CapabilityProvider(ctx context.Context, rargs commontyp
 		return nil, err
 	}
 
-	transmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.evmKeystore, configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI)
+	transmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.evmKeystore, configWatcher, configTransmitterOpts{keySelector: r.keySelector}, OCR2AggregatorTransmissionContractABI)
 	if err != nil {
 		return nil, err
 	}
@@ -378,7 +396,7 @@ func (r *Relayer) NewPluginProvider(ctx context.Context, rargs commontypes.Relay
 	if err != nil {
 		return nil, err
 	}
-	transmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.evmKeystore, configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI)
+	transmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.evmKeystore, configWatcher, configTransmitterOpts{keySelector: r.keySelector}, OCR2AggregatorTransmissionContractABI)
 	if err != nil {
 		return nil, err
 	}
@@ -450,7 +468,12 @@ func (r *Relayer) NewMercuryProvider(ctx context.Context, rargs commontypes.Rela
 		}
 	}
 
-	return NewMercuryProvider(ctx, rargs.JobID, relayConfig, mercuryConfig, r.mercuryCfg.Transmitter(), cp, r.codec, NewMercuryChainReader(r.chain.HeadTracker()), lggr, r.csaKeystore, r.mercuryPool, r.mercuryORM, r.triggerCapability)
+	var archiveORM mercury.ArchiveORM
+	if mercuryConfig.ArchiveReports {
+		archiveORM = mercury.NewArchiveORM(r.ds, rargs.JobID)
+	}
+
+	return NewMercuryProvider(ctx, rargs.JobID, relayConfig, mercuryConfig, r.mercuryCfg.Transmitter(), cp, r.codec, NewMercuryChainReader(r.chain.HeadTracker()), lggr, r.csaKeystore, r.mercuryPool, r.mercuryORM, archiveORM, r.triggerCapability)
 }
 
 func chainToUUID(chainID *big.Int) uuid.UUID {
@@ -527,7 +550,8 @@ func (r *Relayer) NewCCIPCommitProvider(ctx context.Context, rargs commontypes.R
 	subjectID := chainToUUID(configWatcher.chain.ID())
 
 	contractTransmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.evmKeystore, configWatcher, configTransmitterOpts{
-		subjectID: &subjectID,
+		subjectID:   &subjectID,
+		keySelector: r.keySelector,
 	}, OCR2AggregatorTransmissionContractABI, WithReportToEthMetadata(fn), WithRetention(0))
 	if err != nil {
 		return nil, err
@@ -612,7 +636,8 @@ func (r *Relayer) NewCCIPExecProvider(ctx context.Context, rargs commontypes.Rel
 	subjectID := chainToUUID(configWatcher.chain.ID())
 
 	contractTransmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.evmKeystore, configWatcher, configTransmitterOpts{
-		subjectID: &subjectID,
+		subjectID:   &subjectID,
+		keySelector: r.keySelector,
 	}, OCR2AggregatorTransmissionContractABI, WithReportToEthMetadata(fn), WithRetention(0), WithExcludeSignatures())
 	if err != nil {
 		return nil, err
@@ -812,6 +837,8 @@ type configTransmitterOpts struct {
 	pluginGasLimit *uint32
 	// subjectID overrides the queueing subject id (the job external id will be used by default).
 	subjectID *uuid.UUID
+	// keySelector picks the sending key of the transmissions, round-robin is used if it is nil.
+	keySelector *txm.KeySelector
 }
 
 // newOnChainContractTransmitter creates a new contract transmitter.
@@ -886,6 +913,27 @@ type Keystore interface {
 	keys.RawUnhashedSigner
 }
 
+// validateKeySelection returns an error if selection cannot be applied on chain. The stuck transaction detector only
+// flags transactions while auto-purge is enabled, so ExcludeStuck would never exclude any key without it.
+func validateKeySelection(chain legacyevm.Chain, selection txm.KeySelection) error {
+	if selection.ExcludeStuck && !chain.Config().EVM().Transactions().AutoPurge().Enabled() {
+		return errors.New("excluding the sending keys with stuck transactions requires Transactions.AutoPurge.Enabled")
+	}
+	return nil
+}
+
+// keySelectingKeystore picks the sending key of the OCR2, OCR3 and CCIP transmissions with a KeySelector. OCR and keeper
+// jobs transmit from a single key, so there is no key to select. VRF jobs, and the ethtx tasks of the other job types
+// such as Direct Request, pick their sending keys round-robin with keystore.Eth.GetRoundRobinAddress.
+type keySelectingKeystore struct {
+	keys.RoundRobin
+	selector *txm.KeySelector
+}
+
+func (k *keySelectingKeystore) GetNextAddress(ctx context.Context, addresses ...common.Address) (common.Address, error) {
+	return k.selector.SelectAddress(ctx, addresses, k.RoundRobin.GetNextAddress)
+}
+
 func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, ethKeystore Keystore, configWatcher *configWatcher, opts configTransmitterOpts) (Transmitter, error) {
 	var relayConfig types.RelayConfig
 	if err := json.Unmarshal(rargs.RelayConfig, &relayConfig); err != nil {
@@ -926,6 +974,25 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 		checker.CheckerType = txm.TransmitCheckerTypeSimulate
 	}
 
+	var roundRobin keys.RoundRobin = ethKeystore
+	if opts.keySelector != nil {
+		selection := opts.keySelector.Selection()
+		if relayConfig.SendingKeyStrategy != "" {
+			s, err := txm.ParseKeySelectionStrategy(relayConfig.SendingKeyStrategy)
+			if err != nil {
+				return nil, err
+			}
+			selection.Strategy = s
+		}
+		if relayConfig.ExcludeStuckSendingKeys != nil {
+			selection.ExcludeStuck = *relayConfig.ExcludeStuckSendingKeys
+		}
+		if err := validateKeySelection(configWatcher.chain, selection); err != nil {
+			return nil, err
+		}
+		roundRobin = &keySelectingKeystore{RoundRobin: ethKeystore, selector: opts.keySelector.WithSelection(selection)}
+	}
+
 	gasLimit := configWatcher.chain.Config().EVM().GasEstimator().LimitDefault()
 	ocr2Limit := configWatcher.chain.Config().EVM().GasEstimator().LimitJobType().OCR2()
 	if ocr2Limit != nil {
@@ -948,7 +1015,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			effectiveTransmitterAddress,
 			strategy,
 			checker,
-			ethKeystore,
+			roundRobin,
 			relayConfig.DualTransmissionConfig,
 		)
 	case commontypes.CCIPExecution:
@@ -960,7 +1027,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			strategy,
 			checker,
 			configWatcher.chain.ID(),
-			ethKeystore,
+			roundRobin,
 		)
 	default:
 		transmitter, err = ocrcommon.NewTransmitter(
@@ -970,7 +1037,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			effectiveTransmitterAddress,
 			strategy,
 			checker,
-			ethKeystore,
+			roundRobin,
 		)
 	}
 	if err != nil {
@@ -1029,7 +1096,7 @@ func (r *Relayer) NewMedianProvider(ctx context.Context, rargs commontypes.Relay
 
 	reportCodec := evmreportcodec.ReportCodec{}
 
-	ct, err := NewContractTransmitter(ctx, lggr, rargs, r.evmKeystore, configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI, relayConfig.EnableDualTransmission)
+	ct, err := NewContractTransmitter(ctx, lggr, rargs, r.evmKeystore, configWatcher, configTransmitterOpts{keySelector: r.keySelector}, OCR2AggregatorTransmissionContractABI, relayConfig.EnableDualTransmission)
 	if err != nil {
 		return nil, err
 	}
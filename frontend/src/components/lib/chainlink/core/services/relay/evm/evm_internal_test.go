@@ -0,0 +1,33 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/configtest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+)
+
+func Test_validateKeySelection(t *testing.T) {
+	t.Parallel()
+
+	newChain := func(t *testing.T, autoPurge bool) *mocks.Chain {
+		chain := mocks.NewChain(t)
+		chain.On("Config").Return(configtest.NewChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.Transactions.AutoPurge.Enabled = &autoPurge
+		})).Maybe()
+		return chain
+	}
+
+	selection := txmgr.KeySelection{Strategy: txmgr.KeySelectionLeastPending}
+	require.NoError(t, validateKeySelection(newChain(t, false), selection))
+
+	selection.ExcludeStuck = true
+	require.NoError(t, validateKeySelection(newChain(t, true), selection))
+	assert.EqualError(t, validateKeySelection(newChain(t, false), selection), "excluding the sending keys with stuck transactions requires Transactions.AutoPurge.Enabled")
+}
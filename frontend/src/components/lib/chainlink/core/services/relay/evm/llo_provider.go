@@ -25,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/archive"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/bm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/channeldefinitions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/grpc"
@@ -115,6 +116,11 @@ func NewLLOProvider(
 		return nil, err
 	}
 
+	cdc, err := cdcFactory.NewCache(lloCfg)
+	if err != nil {
+		return nil, err
+	}
+
 	var transmitter LLOTransmitter
 	if lloCfg.BenchmarkMode {
 		lggr.Info("Benchmark mode enabled, using dummy transmitter. NOTE: THIS WILL NOT TRANSMIT ANYTHING")
@@ -179,11 +185,9 @@ func NewLLOProvider(
 		if err != nil {
 			return nil, fmt.Errorf("failed to create LLO transmitter: %w", err)
 		}
-	}
-
-	cdc, err := cdcFactory.NewCache(lloCfg)
-	if err != nil {
-		return nil, err
+		if lloCfg.ArchiveReports {
+			transmitter = archive.NewTransmitter(lggr, archive.NewORM(ds, lloCfg.DonID), cdc, transmitter)
+		}
 	}
 
 	p := &lloProvider{
@@ -0,0 +1,222 @@
+package mercury
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/archive"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/utils"
+)
+
+const (
+	// How many records are loaded per query during replay
+	archiveReplayBatchSize = 1000
+)
+
+// ArchiveRecord is a signed report as it was handed to the transmitter
+type ArchiveRecord struct {
+	ID         int64
+	FeedID     utils.FeedID
+	ReportCtx  ocrtypes.ReportContext
+	Report     ocrtypes.Report
+	Sigs       []ocrtypes.AttributedOnchainSignature
+	ArchivedAt time.Time
+}
+
+// ArchiveORM is an append-only store of transmitted reports, scoped to a
+// single job
+type ArchiveORM interface {
+	Insert(ctx context.Context, r *ArchiveRecord) error
+	// Get returns records archived in [from, to) with ID greater than
+	// afterID, in ascending ID order. Passing a zero from or to leaves that
+	// end of the range open.
+	Get(ctx context.Context, from, to time.Time, afterID int64, limit int) ([]*ArchiveRecord, error)
+}
+
+type archiveORM struct {
+	ds    sqlutil.DataSource
+	jobID int32
+}
+
+func NewArchiveORM(ds sqlutil.DataSource, jobID int32) ArchiveORM {
+	return &archiveORM{ds, jobID}
+}
+
+// Insert appends r to the archive and sets its ID and ArchivedAt
+func (o *archiveORM) Insert(ctx context.Context, r *ArchiveRecord) error {
+	signatures := make(pq.ByteaArray, len(r.Sigs))
+	signers := make(pq.Int32Array, len(r.Sigs))
+	for i, sig := range r.Sigs {
+		signatures[i] = sig.Signature
+		signers[i] = int32(sig.Signer)
+	}
+	err := o.ds.QueryRowxContext(ctx, `
+		INSERT INTO mercury_report_archive (job_id, feed_id, config_digest, epoch, round, extra_hash, report, signatures, signers)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, archived_at
+	`, o.jobID, r.FeedID[:], r.ReportCtx.ConfigDigest[:], r.ReportCtx.Epoch, r.ReportCtx.Round, r.ReportCtx.ExtraHash[:], []byte(r.Report), signatures, signers).Scan(&r.ID, &r.ArchivedAt)
+	if err != nil {
+		return fmt.Errorf("mercury archive orm: failed to insert record: %w", err)
+	}
+	return nil
+}
+
+func (o *archiveORM) Get(ctx context.Context, from, to time.Time, afterID int64, limit int) ([]*ArchiveRecord, error) {
+	params := []interface{}{o.jobID, afterID, limit}
+	var rangeClause string
+	if !from.IsZero() {
+		params = append(params, from)
+		rangeClause += fmt.Sprintf("\nAND archived_at >= $%d", len(params))
+	}
+	if !to.IsZero() {
+		params = append(params, to)
+		rangeClause += fmt.Sprintf("\nAND archived_at < $%d", len(params))
+	}
+	q := fmt.Sprintf(`
+		SELECT id, feed_id, config_digest, epoch, round, extra_hash, report, signatures, signers, archived_at
+		FROM mercury_report_archive
+		WHERE job_id = $1 AND id > $2%s
+		ORDER BY id ASC
+		LIMIT $3
+		`, rangeClause)
+	rows, err := o.ds.QueryContext(ctx, q, params...)
+	if err != nil {
+		return nil, fmt.Errorf("mercury archive orm: failed to get records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*ArchiveRecord
+	for rows.Next() {
+		var r ArchiveRecord
+		var feedID, digest, extraHash []byte
+		var epoch int64
+		var round int32
+		var signatures pq.ByteaArray
+		var signers pq.Int32Array
+
+		if err := rows.Scan(&r.ID, &feedID, &digest, &epoch, &round, &extraHash, &r.Report, &signatures, &signers, &r.ArchivedAt); err != nil {
+			return nil, fmt.Errorf("mercury archive orm: failed to scan record: %w", err)
+		}
+		if len(feedID) != len(r.FeedID) || len(extraHash) != len(r.ReportCtx.ExtraHash) {
+			return nil, fmt.Errorf("mercury archive orm: invalid feed ID or extra hash length in record %d", r.ID)
+		}
+		copy(r.FeedID[:], feedID)
+		copy(r.ReportCtx.ExtraHash[:], extraHash)
+		r.ReportCtx.ConfigDigest, err = ocrtypes.BytesToConfigDigest(digest)
+		if err != nil {
+			return nil, fmt.Errorf("mercury archive orm: invalid config digest: %w", err)
+		}
+		if epoch < 0 || epoch > math.MaxUint32 || round < 0 || round > math.MaxUint8 {
+			return nil, fmt.Errorf("mercury archive orm: epoch/round out of range in record %d", r.ID)
+		}
+		r.ReportCtx.Epoch = uint32(epoch)
+		r.ReportCtx.Round = uint8(round)
+		if len(signatures) != len(signers) {
+			return nil, errors.New("signatures and signers must have the same length")
+		}
+		for i, sig := range signatures {
+			if signers[i] < 0 || signers[i] > math.MaxUint8 {
+				return nil, fmt.Errorf("signer out of range: %d", signers[i])
+			}
+			r.Sigs = append(r.Sigs, ocrtypes.AttributedOnchainSignature{
+				Signature: sig,
+				Signer:    commontypes.OracleID(signers[i]),
+			})
+		}
+		records = append(records, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("mercury archive orm: failed to scan records: %w", err)
+	}
+	return records, nil
+}
+
+var _ Transmitter = (*archivingTransmitter)(nil)
+
+// archivingTransmitter queues every report for archiving before passing it
+// on to the wrapped transmitter
+type archivingTransmitter struct {
+	Transmitter
+	feedID utils.FeedID
+	queue  *archive.Queue[*ArchiveRecord]
+
+	once services.StateMachine
+}
+
+// NewArchivingTransmitter wraps t so that every report is written to orm.
+// Reports are archived asynchronously so that archiving never fails
+// transmission, see archive.Queue.
+func NewArchivingTransmitter(lggr logger.Logger, orm ArchiveORM, feedID utils.FeedID, t Transmitter) Transmitter {
+	return &archivingTransmitter{
+		Transmitter: t,
+		feedID:      feedID,
+		queue:       archive.NewQueue(logger.Named(lggr, "ReportArchive"), orm.Insert),
+	}
+}
+
+func (t *archivingTransmitter) Start(ctx context.Context) error {
+	return t.once.StartOnce("MercuryReportArchive", func() error {
+		if err := t.Transmitter.Start(ctx); err != nil {
+			return err
+		}
+		t.queue.Start()
+		return nil
+	})
+}
+
+func (t *archivingTransmitter) Close() error {
+	return t.once.StopOnce("MercuryReportArchive", func() error {
+		t.queue.Close()
+		return t.Transmitter.Close()
+	})
+}
+
+func (t *archivingTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	t.queue.Add(ctx, &ArchiveRecord{
+		FeedID:    t.feedID,
+		ReportCtx: reportCtx,
+		Report:    report,
+		Sigs:      signatures,
+	})
+	return t.Transmitter.Transmit(ctx, reportCtx, report, signatures)
+}
+
+// ReportTransmitter is the subset of Transmitter needed to replay reports
+type ReportTransmitter interface {
+	Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error
+}
+
+// ReplayArchive transmits every record archived in [from, to) to target, in
+// the order they were archived, and returns the number of records replayed.
+// Replay stops at the first transmit error.
+func ReplayArchive(ctx context.Context, orm ArchiveORM, from, to time.Time, target ReportTransmitter) (n int, err error) {
+	var afterID int64
+	for {
+		records, err := orm.Get(ctx, from, to, afterID, archiveReplayBatchSize)
+		if err != nil {
+			return n, err
+		}
+		for _, r := range records {
+			if err := target.Transmit(ctx, r.ReportCtx, r.Report, r.Sigs); err != nil {
+				return n, fmt.Errorf("failed to replay archived report %d (repts: %v): %w", r.ID, r.ReportCtx.ReportTimestamp, err)
+			}
+			n++
+			afterID = r.ID
+		}
+		if len(records) < archiveReplayBatchSize {
+			return n, nil
+		}
+	}
+}
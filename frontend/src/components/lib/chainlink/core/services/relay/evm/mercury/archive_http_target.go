@@ -0,0 +1,83 @@
+package mercury
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/utils"
+)
+
+// ArchiveHTTPTargetReport is the JSON body posted by ArchiveHTTPTarget for
+// every report
+type ArchiveHTTPTargetReport struct {
+	FeedID       string                       `json:"feedID"`
+	ConfigDigest string                       `json:"configDigest"`
+	Epoch        uint32                       `json:"epoch"`
+	Round        uint8                        `json:"round"`
+	ExtraHash    hexutil.Bytes                `json:"extraHash"`
+	Report       hexutil.Bytes                `json:"report"`
+	Signatures   []ArchiveHTTPTargetSignature `json:"signatures"`
+}
+
+type ArchiveHTTPTargetSignature struct {
+	Signer    uint8         `json:"signer"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+var _ ReportTransmitter = (*ArchiveHTTPTarget)(nil)
+
+// ArchiveHTTPTarget replays reports of a feed by posting them one by one as
+// JSON to a URL, e.g. the ingestion endpoint of a data warehouse
+type ArchiveHTTPTarget struct {
+	client *http.Client
+	url    string
+	feedID utils.FeedID
+}
+
+func NewArchiveHTTPTarget(client *http.Client, url string, feedID utils.FeedID) *ArchiveHTTPTarget {
+	return &ArchiveHTTPTarget{client, url, feedID}
+}
+
+func (t *ArchiveHTTPTarget) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	r := ArchiveHTTPTargetReport{
+		FeedID:       t.feedID.Hex(),
+		ConfigDigest: reportCtx.ConfigDigest.Hex(),
+		Epoch:        reportCtx.Epoch,
+		Round:        reportCtx.Round,
+		ExtraHash:    reportCtx.ExtraHash[:],
+		Report:       hexutil.Bytes(report),
+	}
+	for _, sig := range signatures {
+		r.Signatures = append(r.Signatures, ArchiveHTTPTargetSignature{Signer: uint8(sig.Signer), Signature: sig.Signature})
+	}
+	body, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post report: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		// NOTE: Truncate the returned body here as we don't want to spam the
+		// logs with potentially huge messages
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("got error from %s: (status code: %d, response body: %s)", t.url, resp.StatusCode, string(b))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package mercury
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+type archivedTransmission struct {
+	reportCtx ocrtypes.ReportContext
+	report    ocrtypes.Report
+	sigs      []ocrtypes.AttributedOnchainSignature
+}
+
+type recordingTransmitter struct {
+	Transmitter
+	err           error
+	transmissions []archivedTransmission
+}
+
+func (r *recordingTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, sigs []ocrtypes.AttributedOnchainSignature) error {
+	r.transmissions = append(r.transmissions, archivedTransmission{reportCtx, report, sigs})
+	return r.err
+}
+
+type failingArchiveORM struct{ ArchiveORM }
+
+func (failingArchiveORM) Insert(context.Context, *ArchiveRecord) error {
+	return errors.New("insert failed")
+}
+
+func sampleArchiveReportCtx(round uint8) ocrtypes.ReportContext {
+	return ocrtypes.ReportContext{
+		ReportTimestamp: ocrtypes.ReportTimestamp{
+			ConfigDigest: ocrtypes.ConfigDigest{'1'},
+			Epoch:        10,
+			Round:        round,
+		},
+		ExtraHash: [32]byte{'2'},
+	}
+}
+
+func Test_ArchivingTransmitter(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewArchiveORM(db, 1)
+	sigs := []ocrtypes.AttributedOnchainSignature{{Signature: []byte{1, 2, 3}, Signer: 2}}
+
+	inner := &recordingTransmitter{}
+	tr := NewArchivingTransmitter(lggr, orm, sampleFeedID, inner)
+	for i := range sampleReports {
+		require.NoError(t, tr.Transmit(ctx, sampleArchiveReportCtx(uint8(i)), sampleReports[i], sigs))
+	}
+	require.Len(t, inner.transmissions, len(sampleReports))
+	require.NoError(t, tr.(*archivingTransmitter).queue.Flush(ctx))
+
+	// other jobs' records are not visible
+	require.NoError(t, NewArchiveORM(db, 2).Insert(ctx, &ArchiveRecord{FeedID: sampleFeedID, ReportCtx: sampleArchiveReportCtx(0), Report: sampleReports[0]}))
+
+	t.Run("Get returns archived records in order", func(t *testing.T) {
+		records, err := orm.Get(ctx, time.Time{}, time.Time{}, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, records, len(sampleReports))
+		for i, r := range records {
+			assert.Equal(t, sampleFeedID, [32]byte(r.FeedID))
+			assert.Equal(t, sampleArchiveReportCtx(uint8(i)), r.ReportCtx)
+			assert.Equal(t, ocrtypes.Report(sampleReports[i]), r.Report)
+			assert.Equal(t, sigs, r.Sigs)
+		}
+
+		records, err = orm.Get(ctx, time.Time{}, time.Time{}, records[1].ID, 1)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, sampleArchiveReportCtx(2), records[0].ReportCtx)
+
+		records, err = orm.Get(ctx, time.Now().Add(time.Hour), time.Time{}, 0, 100)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("ReplayArchive replays all records in range", func(t *testing.T) {
+		target := &recordingTransmitter{}
+		n, err := ReplayArchive(ctx, orm, time.Time{}, time.Now().Add(time.Hour), target)
+		require.NoError(t, err)
+		assert.Equal(t, len(sampleReports), n)
+		assert.Equal(t, inner.transmissions, target.transmissions)
+	})
+
+	t.Run("ReplayArchive stops at the first transmit error", func(t *testing.T) {
+		target := &recordingTransmitter{err: errors.New("boom")}
+		n, err := ReplayArchive(ctx, orm, time.Time{}, time.Time{}, target)
+		require.ErrorContains(t, err, "boom")
+		assert.Zero(t, n)
+	})
+
+	t.Run("still transmits and keeps the report queued if archiving fails", func(t *testing.T) {
+		inner := &recordingTransmitter{}
+		tr := NewArchivingTransmitter(lggr, failingArchiveORM{orm}, sampleFeedID, inner)
+		require.NoError(t, tr.Transmit(ctx, sampleArchiveReportCtx(0), sampleReports[0], sigs))
+		assert.Len(t, inner.transmissions, 1)
+		queue := tr.(*archivingTransmitter).queue
+		require.ErrorContains(t, queue.Flush(ctx), "insert failed")
+		assert.Equal(t, 1, queue.Len())
+	})
+}
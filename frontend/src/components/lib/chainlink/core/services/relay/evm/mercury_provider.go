@@ -61,6 +61,7 @@ func NewMercuryProvider(
 	csaKeystore coretypes.Keystore,
 	mercuryPool wsrpc.Pool,
 	mercuryORM evmmercury.ORM,
+	archiveORM evmmercury.ArchiveORM,
 	triggerCapability *triggers.MercuryTriggerService,
 ) (*mercuryProvider, error) {
 	reportCodecV1 := reportcodecv1.NewReportCodec(*relayConfig.FeedID, lggr.Named("ReportCodecV1"))
@@ -109,7 +110,10 @@ func NewMercuryProvider(
 	if err != nil {
 		return nil, err
 	}
-	transmitter := evmmercury.NewTransmitter(lggr, transmitterCfg, clients, csaPub, jobID, *relayConfig.FeedID, mercuryORM, transmitterCodec, benchmarkPriceDecoder, triggerCapability)
+	var transmitter evmmercury.Transmitter = evmmercury.NewTransmitter(lggr, transmitterCfg, clients, csaPub, jobID, *relayConfig.FeedID, mercuryORM, transmitterCodec, benchmarkPriceDecoder, triggerCapability)
+	if archiveORM != nil {
+		transmitter = evmmercury.NewArchivingTransmitter(lggr, archiveORM, mercuryutils.FeedID(*relayConfig.FeedID), transmitter)
+	}
 	return &mercuryProvider{
 		cp,
 		codec,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -48,6 +49,16 @@ type Call struct {
 	ContractAddress        common.Address
 	ContractName, ReadName string
 	Params, ReturnVal      any
+
+	// cache holds the results of the read, if caching is enabled
+	cache *resultCache
+	// noMulticall excludes the call from Multicall3 aggregation
+	noMulticall bool
+}
+
+// cached returns true if the result of any call in the batch is cached.
+func (c BatchCall) cached() bool {
+	return slices.ContainsFunc(c, func(call Call) bool { return call.cache != nil })
 }
 
 func (c BatchCall) String() string {
@@ -85,6 +96,16 @@ func NewDynamicLimitedBatchCaller(lggr logger.Logger, codec types.Codec, evmClie
 	}
 }
 
+// NewMulticall3BatchCaller returns a BatchCaller like NewDynamicLimitedBatchCaller that aggregates the calls of each batch
+// into a single eth_call to the Multicall3 contract at multicallAddress. It falls back to batched eth_calls if the
+// contract is not deployed or the aggregated call fails.
+func NewMulticall3BatchCaller(lggr logger.Logger, codec types.Codec, evmClient EVMBatchCaller, multicallAddress common.Address, batchSizeLimit, backOffMultiplier, parallelRpcCallsLimit uint) BatchCaller {
+	bc := newDefaultEvmBatchCaller(lggr, evmClient, codec, batchSizeLimit, backOffMultiplier, parallelRpcCallsLimit)
+	bc.multicall = &multicall3{address: multicallAddress}
+
+	return &dynamicLimitedBatchCaller{bc: bc}
+}
+
 func (c *dynamicLimitedBatchCaller) BatchCall(ctx context.Context, blockNumber uint64, reqs BatchCall) (BatchResult, error) {
 	return c.bc.batchCallDynamicLimitRetries(ctx, blockNumber, reqs)
 }
@@ -96,6 +117,7 @@ type defaultEvmBatchCaller struct {
 	batchSizeLimit        uint
 	parallelRpcCallsLimit uint
 	backOffMultiplier     uint
+	multicall             *multicall3
 }
 
 // NewDefaultEvmBatchCaller returns a new batch caller instance.
@@ -141,12 +163,23 @@ func (c *defaultEvmBatchCaller) batchCall(ctx context.Context, blockNumber uint6
 		blockNumStr = hexutil.EncodeBig(big.NewInt(0).SetUint64(blockNumber))
 	}
 
-	rpcBatchCalls, hexEncodedOutputs, err := c.createBatchCalls(ctx, batchCall, blockNumStr)
+	rpcBatchCalls, hexEncodedOutputs, callData, err := c.createBatchCalls(ctx, batchCall, blockNumStr)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = c.evmClient.BatchCallContext(ctx, rpcBatchCalls); err != nil {
+	// only make the calls without a cached result
+	pending := make([]int, 0, len(batchCall))
+	for idx, call := range batchCall {
+		if output, ok := call.cache.get(batchResultKey(call, callData[idx], blockNumStr)); ok {
+			hexEncodedOutputs[idx] = hexutil.Encode(output)
+			continue
+		}
+
+		pending = append(pending, idx)
+	}
+
+	if err = c.call(ctx, batchCall, rpcBatchCalls, hexEncodedOutputs, callData, pending, blockNumStr); err != nil {
 		// return a basic read error with no detail or result since this is a general client
 		// error instead of an error for a specific batch call.
 		return nil, Error{
@@ -155,6 +188,16 @@ func (c *defaultEvmBatchCaller) batchCall(ctx context.Context, blockNumber uint6
 		}
 	}
 
+	for _, idx := range pending {
+		if rpcBatchCalls[idx].Error != nil {
+			continue
+		}
+
+		if output, decodeErr := hexutil.Decode(hexEncodedOutputs[idx]); decodeErr == nil {
+			batchCall[idx].cache.put(batchResultKey(batchCall[idx], callData[idx], blockNumStr), output)
+		}
+	}
+
 	results, err := c.unpackBatchResults(ctx, batchCall, rpcBatchCalls, hexEncodedOutputs, blockNumStr)
 	if err != nil {
 		return nil, err
@@ -163,18 +206,89 @@ func (c *defaultEvmBatchCaller) batchCall(ctx context.Context, blockNumber uint6
 	return results, nil
 }
 
+// call makes the pending calls of the batch, aggregated into a single call if Multicall3 is enabled.
+func (c *defaultEvmBatchCaller) call(
+	ctx context.Context,
+	batchCall BatchCall,
+	rpcBatchCalls []rpc.BatchElem,
+	hexEncodedOutputs []string,
+	callData [][]byte,
+	pending []int,
+	block string,
+) error {
+	if len(pending) == 0 {
+		return nil
+	}
+
+	individual := pending
+	if c.multicall != nil {
+		var aggregated []int
+		individual = nil
+		for _, idx := range pending {
+			if batchCall[idx].noMulticall {
+				individual = append(individual, idx)
+			} else {
+				aggregated = append(aggregated, idx)
+			}
+		}
+
+		if len(aggregated) < 2 ||
+			!c.multicall.aggregate(ctx, c.lggr, c.evmClient, batchCall, rpcBatchCalls, hexEncodedOutputs, callData, aggregated, block) {
+			individual = pending
+		}
+	}
+
+	if len(individual) == 0 {
+		return nil
+	}
+
+	elems := make([]rpc.BatchElem, len(individual))
+	for i, idx := range individual {
+		elems[i] = rpcBatchCalls[idx]
+	}
+
+	if err := c.evmClient.BatchCallContext(ctx, elems); err != nil {
+		return err
+	}
+
+	for i, idx := range individual {
+		rpcBatchCalls[idx].Error = elems[i].Error
+	}
+
+	return nil
+}
+
+// latestBlock returns the number of the latest block.
+func (c *defaultEvmBatchCaller) latestBlock(ctx context.Context) (uint64, error) {
+	var block hexutil.Uint64
+	elems := []rpc.BatchElem{{Method: "eth_blockNumber", Result: &block}}
+	if err := c.evmClient.BatchCallContext(ctx, elems); err != nil {
+		return 0, err
+	}
+	if elems[0].Error != nil {
+		return 0, elems[0].Error
+	}
+
+	return uint64(block), nil
+}
+
+func batchResultKey(call Call, data []byte, block string) resultKey {
+	return resultKey{address: call.ContractAddress, data: string(data), block: block}
+}
+
 func (c *defaultEvmBatchCaller) createBatchCalls(
 	ctx context.Context,
 	batchCall BatchCall,
 	block string,
-) ([]rpc.BatchElem, []string, error) {
+) ([]rpc.BatchElem, []string, [][]byte, error) {
 	rpcBatchCalls := make([]rpc.BatchElem, len(batchCall))
 	hexEncodedOutputs := make([]string, len(batchCall))
+	callData := make([][]byte, len(batchCall))
 
 	for idx, call := range batchCall {
 		data, err := c.codec.Encode(ctx, call.Params, codec.WrapItemType(call.ContractName, call.ReadName, true))
 		if err != nil {
-			return nil, nil, newErrorFromCall(
+			return nil, nil, nil, newErrorFromCall(
 				fmt.Errorf("%w: encode params: %s", types.ErrInvalidConfig, err.Error()),
 				call,
 				block,
@@ -194,9 +308,10 @@ func (c *defaultEvmBatchCaller) createBatchCalls(
 			},
 			Result: &hexEncodedOutputs[idx],
 		}
+		callData[idx] = data
 	}
 
-	return rpcBatchCalls, hexEncodedOutputs, nil
+	return rpcBatchCalls, hexEncodedOutputs, callData, nil
 }
 
 func (c *defaultEvmBatchCaller) unpackBatchResults(
@@ -282,6 +397,17 @@ func (c *defaultEvmBatchCaller) unpackBatchResults(
 }
 
 func (c *defaultEvmBatchCaller) batchCallDynamicLimitRetries(ctx context.Context, blockNumber uint64, calls BatchCall) (BatchResult, error) {
+	// cached results are keyed by block, so latest reads are pinned to the current block
+	if blockNumber == 0 && calls.cached() {
+		var err error
+		if blockNumber, err = c.latestBlock(ctx); err != nil {
+			return nil, Error{
+				Err:  fmt.Errorf("%w: latest block: %s", types.ErrInternal, err.Error()),
+				Type: batchReadType,
+			}
+		}
+	}
+
 	lim := c.batchSizeLimit
 
 	// Limit the batch size to the number of calls
@@ -0,0 +1,82 @@
+package read
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+)
+
+// DefaultCacheTTL is how long cached method results are kept if the read definition does not set a TTL.
+const DefaultCacheTTL = 30 * time.Second
+
+type resultKey struct {
+	address    common.Address
+	data       string
+	block      string
+	confidence primitives.ConfidenceLevel
+}
+
+type cachedResult struct {
+	output    []byte
+	expiresAt time.Time
+}
+
+// resultCache caches the raw output of contract calls, keyed by the call data
+// and the block and confidence level it was read at. A nil resultCache caches
+// nothing.
+type resultCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	results map[resultKey]cachedResult
+}
+
+func newResultCache(ttl time.Duration) *resultCache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	return &resultCache{
+		ttl:     ttl,
+		now:     time.Now,
+		results: make(map[resultKey]cachedResult),
+	}
+}
+
+func (c *resultCache) get(key resultKey) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result, ok := c.results[key]
+	if !ok || !c.now().Before(result.expiresAt) {
+		return nil, false
+	}
+
+	return result.output, true
+}
+
+func (c *resultCache) put(key resultKey, output []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for k, result := range c.results {
+		if !now.Before(result.expiresAt) {
+			delete(c.results, k)
+		}
+	}
+
+	c.results[key] = cachedResult{output: output, expiresAt: now.Add(c.ttl)}
+}
@@ -15,6 +15,7 @@ type readType string
 const (
 	batchReadType  readType = "BatchGetLatestValue"
 	singleReadType readType = "GetLatestValue"
+	blockReadType  readType = "GetValueAtBlock"
 	eventReadType  readType = "QueryKey"
 )
 
@@ -0,0 +1,94 @@
+package read
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrHistoricalStateUnavailable is returned when the RPC node no longer has the state of the requested block, which
+// usually means that the node is not an archive node and has pruned it.
+var ErrHistoricalStateUnavailable = errors.New("historical state is not available, an archive node is required to read this block")
+
+// archiveProbeBlock is the block read to detect an archive node. Its state is pruned by every non-archive node once
+// the chain has grown past the node's retention window.
+var archiveProbeBlock = big.NewInt(1)
+
+// prunedStateErrors are the messages returned by the common node implementations when the state of a block has been
+// pruned.
+var prunedStateErrors = []string{
+	"missing trie node",
+	"historical state",
+	"pruned",
+	"state not available",
+	"state is not available",
+	"no state available",
+}
+
+// IsHistoricalStateUnavailable returns true if the error returned by the RPC node indicates that the state of the
+// requested block has been pruned.
+func IsHistoricalStateUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, ErrHistoricalStateUnavailable) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, prunedErr := range prunedStateErrors {
+		if strings.Contains(msg, prunedErr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DetectArchiveNode checks whether the RPC node serves the state of old blocks by reading a balance at an early block.
+func DetectArchiveNode(ctx context.Context, client EVMBatchCaller) (bool, error) {
+	var balance hexutil.Big
+	elems := []rpc.BatchElem{{
+		Method: "eth_getBalance",
+		Args:   []any{common.Address{}, hexutil.EncodeBig(archiveProbeBlock)},
+		Result: &balance,
+	}}
+
+	err := client.BatchCallContext(ctx, elems)
+	if err == nil {
+		err = elems[0].Error
+	}
+
+	switch {
+	case err == nil:
+		return true, nil
+	case IsHistoricalStateUnavailable(err):
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to read state at block %s: %w", archiveProbeBlock, err)
+	}
+}
+
+// blockArgs returns the eth_call block argument, and the method and argument to fetch the header of the block.
+func blockArgs(block rpc.BlockNumberOrHash) (callArg any, headMethod string, headArg any, err error) {
+	if hash, ok := block.Hash(); ok {
+		// EIP-1898 block parameter, which makes the node check that the block is canonical
+		return rpc.BlockNumberOrHashWithHash(hash, true), "eth_getBlockByHash", hash, nil
+	}
+
+	number, ok := block.Number()
+	if !ok || number < 0 {
+		return nil, "", nil, fmt.Errorf("invalid block: %s", block.String())
+	}
+
+	encoded := hexutil.EncodeBig(big.NewInt(number.Int64()))
+
+	return encoded, "eth_getBlockByNumber", encoded, nil
+}
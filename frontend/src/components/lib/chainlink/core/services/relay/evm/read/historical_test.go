@@ -0,0 +1,135 @@
+package read
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestMethodBinding_GetValueAtBlock(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0x21")
+	blockHash := common.HexToHash("0xabcd")
+	head := &types.Head{Number: 10, Hash: blockHash, Timestamp: time.Unix(1000, 0)}
+
+	newBinding := func(t *testing.T, callErr error, head *types.Head) (*MethodBinding, *[]rpc.BatchElem) {
+		_, testCodec := newMulticallTestCalls(t, 0, nil)
+
+		var sent []rpc.BatchElem
+		ec := clienttest.NewClient(t)
+		ec.On("CodeAt", mock.Anything, address, mock.Anything).Return([]byte{1}, nil)
+		ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			require.Len(t, elems, 2)
+			sent = elems
+
+			if callErr != nil {
+				elems[0].Error = callErr
+			} else {
+				data := elems[0].Args[0].(map[string]interface{})["data"].(hexutil.Bytes)
+				*elems[0].Result.(*string) = hexutil.Encode(echoOutput(data))
+			}
+			*elems[1].Result.(**types.Head) = head
+		}).Return(nil).Maybe()
+
+		binding := NewMethodBinding("Contract", "Method", ec, nil, nil, logger.Test(t))
+		binding.SetCodec(testCodec)
+		require.NoError(t, binding.Bind(testutils.Context(t), address))
+
+		return binding, &sent
+	}
+
+	t.Run("reads at block number", func(t *testing.T) {
+		t.Parallel()
+
+		binding, sent := newBinding(t, nil, head)
+		var out multicallTestReturn
+		readHead, err := binding.GetValueAtBlock(testutils.Context(t), address, rpc.BlockNumberOrHashWithNumber(10), &multicallTestParams{A: 7}, &out)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(7), out.B)
+		assert.Equal(t, "10", readHead.Height)
+		assert.Equal(t, blockHash.Bytes(), readHead.Hash)
+		assert.Equal(t, "0xa", (*sent)[0].Args[1])
+		assert.Equal(t, "eth_getBlockByNumber", (*sent)[1].Method)
+	})
+
+	t.Run("reads at canonical block hash", func(t *testing.T) {
+		t.Parallel()
+
+		binding, sent := newBinding(t, nil, head)
+		var out multicallTestReturn
+		readHead, err := binding.GetValueAtBlock(testutils.Context(t), address, rpc.BlockNumberOrHashWithHash(blockHash, true), &multicallTestParams{A: 3}, &out)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(3), out.B)
+		assert.Equal(t, "10", readHead.Height)
+		assert.Equal(t, rpc.BlockNumberOrHashWithHash(blockHash, true), (*sent)[0].Args[1])
+		assert.Equal(t, "eth_getBlockByHash", (*sent)[1].Method)
+		assert.Equal(t, blockHash, (*sent)[1].Args[0])
+	})
+
+	t.Run("pruned state", func(t *testing.T) {
+		t.Parallel()
+
+		binding, _ := newBinding(t, errors.New("missing trie node 1a2b (path ) <nil>"), head)
+		_, err := binding.GetValueAtBlock(testutils.Context(t), address, rpc.BlockNumberOrHashWithNumber(10), &multicallTestParams{}, &multicallTestReturn{})
+		require.ErrorIs(t, err, ErrHistoricalStateUnavailable)
+	})
+
+	t.Run("unknown block", func(t *testing.T) {
+		t.Parallel()
+
+		binding, _ := newBinding(t, nil, nil)
+		_, err := binding.GetValueAtBlock(testutils.Context(t), address, rpc.BlockNumberOrHashWithHash(blockHash, true), &multicallTestParams{}, &multicallTestReturn{})
+		require.ErrorIs(t, err, commontypes.ErrNotFound)
+	})
+}
+
+func TestDetectArchiveNode(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		err       error
+		expectErr bool
+	}{
+		{name: "archive node"},
+		{name: "full node", err: errors.New("missing trie node 0d9a (path ) <nil>")},
+		{name: "rpc failure", err: errors.New("connection refused"), expectErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ec := clienttest.NewClient(t)
+			ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				elems := args.Get(1).([]rpc.BatchElem)
+				require.Equal(t, "eth_getBalance", elems[0].Method)
+				require.Equal(t, "0x1", elems[0].Args[1])
+				elems[0].Error = tc.err
+			}).Return(nil).Once()
+
+			isArchive, err := DetectArchiveNode(testutils.Context(t), ec)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.err == nil, isArchive)
+		})
+	}
+}
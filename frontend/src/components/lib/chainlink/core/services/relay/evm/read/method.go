@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -37,10 +39,14 @@ type MethodBinding struct {
 	// internal state properties
 	codec    commontypes.Codec
 	bindings map[common.Address]struct{}
-	mu       sync.RWMutex
+	cache    *resultCache
+	// noMulticall excludes batch calls of the method from Multicall3 aggregation
+	noMulticall bool
+	mu          sync.RWMutex
 }
 
 type EVMMethodClient interface {
+	EVMBatchCaller
 	CodeAt(context.Context, common.Address, *big.Int) ([]byte, error)
 	CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error)
 }
@@ -114,6 +120,24 @@ func (b *MethodBinding) SetCodec(codec commontypes.RemoteCodec) {
 	b.codec = codec
 }
 
+// EnableCache caches the results of the method by block and confidence level
+// for ttl, or DefaultCacheTTL if ttl is 0.
+func (b *MethodBinding) EnableCache(ttl time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.cache = newResultCache(ttl)
+}
+
+// DisableMulticall makes batch calls of the method individually, so that they
+// are not made by the Multicall3 contract.
+func (b *MethodBinding) DisableMulticall() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.noMulticall = true
+}
+
 func (b *MethodBinding) BatchCall(address common.Address, params, retVal any) (Call, error) {
 	if !b.isBound(address) {
 		return Call{}, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, newUnboundAddressErr(address.Hex(), b.contractName, b.method))
@@ -125,6 +149,8 @@ func (b *MethodBinding) BatchCall(address common.Address, params, retVal any) (C
 		ReadName:        b.method,
 		Params:          params,
 		ReturnVal:       retVal,
+		cache:           b.cache,
+		noMulticall:     b.noMulticall,
 	}, nil
 }
 
@@ -138,8 +164,10 @@ func (b *MethodBinding) GetLatestValueWithHeadData(ctx context.Context, addr com
 		return nil, err
 	}
 
+	// cached reads are pinned to the resolved head, so that a cached result is
+	// never served for a later block than the one it was read at
 	var blockNum *big.Int
-	if block != nil && confirmations != types.Unconfirmed {
+	if block != nil && (confirmations != types.Unconfirmed || b.cache != nil) {
 		blockNum = big.NewInt(block.Number)
 	}
 
@@ -158,25 +186,38 @@ func (b *MethodBinding) GetLatestValueWithHeadData(ctx context.Context, addr com
 		return nil, callErr
 	}
 
-	callMsg := ethereum.CallMsg{
-		To:   &addr,
-		From: addr,
-		Data: data,
+	// results are cached by the head the confidence level resolves to
+	var key resultKey
+	if blockNum != nil {
+		key = resultKey{address: addr, data: string(data), block: blockNum.String(), confidence: confidenceLevel}
 	}
 
-	bytes, err := b.client.CallContract(ctx, callMsg, blockNum)
-	if err != nil {
-		callErr := newErrorFromCall(
-			fmt.Errorf("%w: contract call: %s", commontypes.ErrInvalidType, err.Error()),
-			Call{
-				ContractAddress: addr,
-				ContractName:    b.contractName,
-				ReadName:        b.method,
-				Params:          params,
-				ReturnVal:       returnVal,
-			}, blockNum.String(), singleReadType)
+	bytes, cached := b.cache.get(key)
+	if !cached {
+		callMsg := ethereum.CallMsg{
+			To:   &addr,
+			From: addr,
+			Data: data,
+		}
 
-		return nil, callErr
+		bytes, err = b.client.CallContract(ctx, callMsg, blockNum)
+		if err != nil {
+			callErr := newErrorFromCall(
+				fmt.Errorf("%w: contract call: %s", commontypes.ErrInvalidType, err.Error()),
+				Call{
+					ContractAddress: addr,
+					ContractName:    b.contractName,
+					ReadName:        b.method,
+					Params:          params,
+					ReturnVal:       returnVal,
+				}, blockNum.String(), singleReadType)
+
+			return nil, callErr
+		}
+
+		if blockNum != nil {
+			b.cache.put(key, bytes)
+		}
 	}
 
 	// there may be cases where the contract value has not been set and the RPC returns with a value of 0x
@@ -205,6 +246,96 @@ func (b *MethodBinding) GetLatestValueWithHeadData(ctx context.Context, addr com
 	return block.ToChainAgnosticHead(), nil
 }
 
+// GetValueAtBlock reads the method at a specific block number or hash and returns the head it was read at. Reads of
+// blocks whose state was pruned by the RPC node fail with ErrHistoricalStateUnavailable.
+func (b *MethodBinding) GetValueAtBlock(ctx context.Context, addr common.Address, block rpc.BlockNumberOrHash, params, returnVal any) (*commontypes.Head, error) {
+	if !b.isBound(addr) {
+		return nil, fmt.Errorf("%w: %w", commontypes.ErrInvalidConfig, newUnboundAddressErr(addr.Hex(), b.contractName, b.method))
+	}
+
+	call := Call{
+		ContractAddress: addr,
+		ContractName:    b.contractName,
+		ReadName:        b.method,
+		Params:          params,
+		ReturnVal:       returnVal,
+	}
+
+	callBlock, headMethod, headBlock, err := blockArgs(block)
+	if err != nil {
+		return nil, newErrorFromCall(fmt.Errorf("%w: %s", commontypes.ErrInvalidType, err.Error()), call, block.String(), blockReadType)
+	}
+
+	data, err := b.codec.Encode(ctx, params, codec.WrapItemType(b.contractName, b.method, true))
+	if err != nil {
+		return nil, newErrorFromCall(fmt.Errorf("%w: encoding params: %s", commontypes.ErrInvalidType, err.Error()), call, block.String(), blockReadType)
+	}
+
+	var (
+		output string
+		head   *types.Head
+	)
+
+	elems := []rpc.BatchElem{
+		{
+			Method: "eth_call",
+			Args: []any{
+				map[string]interface{}{
+					"from": addr,
+					"to":   addr,
+					"data": hexutil.Bytes(data),
+				},
+				callBlock,
+			},
+			Result: &output,
+		},
+		{
+			Method: headMethod,
+			Args:   []any{headBlock, false},
+			Result: &head,
+		},
+	}
+
+	if err = b.client.BatchCallContext(ctx, elems); err != nil {
+		return nil, newErrorFromCall(fmt.Errorf("%w: batch call context: %s", commontypes.ErrInternal, err.Error()), call, block.String(), blockReadType)
+	}
+
+	if elems[1].Error != nil {
+		return nil, newErrorFromCall(fmt.Errorf("%w: fetching block: %s", commontypes.ErrInternal, elems[1].Error.Error()), call, block.String(), blockReadType)
+	}
+
+	if head == nil {
+		return nil, newErrorFromCall(fmt.Errorf("%w: block not found", commontypes.ErrNotFound), call, block.String(), blockReadType)
+	}
+
+	if err = elems[0].Error; err != nil {
+		if IsHistoricalStateUnavailable(err) {
+			return nil, newErrorFromCall(fmt.Errorf("%w: %s", ErrHistoricalStateUnavailable, err.Error()), call, block.String(), blockReadType)
+		}
+
+		return nil, newErrorFromCall(fmt.Errorf("%w: contract call: %s", commontypes.ErrInvalidType, err.Error()), call, block.String(), blockReadType)
+	}
+
+	bytes, err := hexutil.Decode(output)
+	if err != nil {
+		return nil, newErrorFromCall(fmt.Errorf("%w: decode hex result: %s", commontypes.ErrInvalidType, err.Error()), call, block.String(), blockReadType)
+	}
+
+	// as with latest reads, an empty result means the value was not set and there is nothing to decode
+	if len(bytes) == 0 {
+		return head.ToChainAgnosticHead(), nil
+	}
+
+	if err = b.codec.Decode(ctx, bytes, returnVal, codec.WrapItemType(b.contractName, b.method, false)); err != nil {
+		callErr := newErrorFromCall(fmt.Errorf("%w: decode return data: %s", commontypes.ErrInvalidType, err.Error()), call, block.String(), blockReadType)
+		callErr.Result = &output
+
+		return nil, callErr
+	}
+
+	return head.ToChainAgnosticHead(), nil
+}
+
 func (b *MethodBinding) QueryKey(
 	_ context.Context,
 	_ common.Address,
@@ -0,0 +1,137 @@
+package read
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// DefaultMulticall3Address is the address of Multicall3 on most chains, as it is deployed with a pre-signed transaction.
+var DefaultMulticall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const multicall3ABIJSON = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicall3ABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(multicall3ABIJSON))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+var errMulticall3CallFailed = errors.New("call failed in multicall3 aggregate")
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// multicall3 aggregates eth_calls into a single call to the aggregate3 method of a Multicall3 contract.
+type multicall3 struct {
+	address common.Address
+	// unavailable is set once the contract is found not to be deployed at the latest block
+	unavailable atomic.Bool
+}
+
+// aggregate makes the pending calls in a single eth_call, setting their outputs and errors as if they were made
+// individually. It returns false if the calls have to be made individually instead.
+func (m *multicall3) aggregate(
+	ctx context.Context,
+	lggr logger.Logger,
+	client EVMBatchCaller,
+	batchCall BatchCall,
+	rpcBatchCalls []rpc.BatchElem,
+	hexEncodedOutputs []string,
+	callData [][]byte,
+	pending []int,
+	block string,
+) bool {
+	if m.unavailable.Load() {
+		return false
+	}
+
+	calls := make([]multicall3Call, len(pending))
+	for i, idx := range pending {
+		calls[i] = multicall3Call{Target: batchCall[idx].ContractAddress, AllowFailure: true, CallData: callData[idx]}
+	}
+
+	data, err := multicall3ABI.Pack("aggregate3", calls)
+	if err != nil {
+		lggr.Warnw("Failed to pack multicall3 calls, falling back to batched calls", "err", err)
+		return false
+	}
+
+	var output string
+	elems := []rpc.BatchElem{{
+		Method: "eth_call",
+		Args: []any{
+			map[string]interface{}{
+				"from": common.Address{},
+				"to":   m.address,
+				"data": hexutil.Bytes(data),
+			},
+			block,
+		},
+		Result: &output,
+	}}
+	if err = client.BatchCallContext(ctx, elems); err == nil {
+		err = elems[0].Error
+	}
+	if err != nil {
+		lggr.Debugw("Multicall3 call failed, falling back to batched calls", "address", m.address, "err", err)
+		return false
+	}
+
+	if output == "" || output == "0x" {
+		// calling an address without code succeeds with an empty output
+		if block == "latest" {
+			m.unavailable.Store(true)
+		}
+		lggr.Warnw("Multicall3 contract not found, falling back to batched calls", "address", m.address, "block", block)
+		return false
+	}
+
+	packed, err := hexutil.Decode(output)
+	if err != nil {
+		lggr.Warnw("Failed to decode multicall3 output, falling back to batched calls", "err", err)
+		return false
+	}
+
+	unpacked, err := multicall3ABI.Unpack("aggregate3", packed)
+	if err != nil || len(unpacked) != 1 {
+		lggr.Warnw("Failed to unpack multicall3 output, falling back to batched calls", "err", err)
+		return false
+	}
+
+	results := *abi.ConvertType(unpacked[0], new([]multicall3Result)).(*[]multicall3Result)
+	if len(results) != len(pending) {
+		lggr.Warnw("Unexpected number of multicall3 results, falling back to batched calls", "expected", len(pending), "got", len(results))
+		return false
+	}
+
+	for i, idx := range pending {
+		if !results[i].Success {
+			rpcBatchCalls[idx].Error = fmt.Errorf("%w: revert data: %s", errMulticall3CallFailed, hexutil.Encode(results[i].ReturnData))
+			continue
+		}
+
+		hexEncodedOutputs[idx] = hexutil.Encode(results[i].ReturnData)
+	}
+
+	return true
+}
@@ -0,0 +1,247 @@
+package read
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/codec"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+type multicallTestParams struct {
+	A uint64
+}
+
+type multicallTestReturn struct {
+	B uint64
+}
+
+// echoOutput returns the output of a test method, which returns its parameter.
+func echoOutput(data []byte) []byte {
+	return common.LeftPadBytes(data[24:32], 32)
+}
+
+func newMulticallTestCalls(t *testing.T, n int, cache *resultCache) (BatchCall, commontypes.RemoteCodec) {
+	codecConfig := evmtypes.CodecConfig{Configs: map[string]evmtypes.ChainCodecConfig{
+		"params.Contract.Method": {TypeABI: `[{"type":"uint64","name":"A"}]`},
+		"return.Contract.Method": {TypeABI: `[{"type":"uint64","name":"B"}]`},
+	}}
+	testCodec, err := codec.NewCodec(codecConfig)
+	require.NoError(t, err)
+
+	calls := make(BatchCall, n)
+	for i := range calls {
+		calls[i] = Call{
+			ContractAddress: common.BigToAddress(big.NewInt(int64(i + 1))),
+			ContractName:    "Contract",
+			ReadName:        "Method",
+			Params:          &multicallTestParams{A: uint64(i)},
+			ReturnVal:       &multicallTestReturn{},
+			cache:           cache,
+		}
+	}
+
+	return calls, testCodec
+}
+
+func TestMulticall3BatchCaller(t *testing.T) {
+	t.Parallel()
+
+	multicallAddress := common.HexToAddress("0x1234")
+	failing := common.BigToAddress(big.NewInt(2))
+
+	t.Run("aggregates calls", func(t *testing.T) {
+		t.Parallel()
+
+		calls, testCodec := newMulticallTestCalls(t, 3, nil)
+		ec := clienttest.NewClient(t)
+		ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			require.Len(t, elems, 1)
+			callArgs := elems[0].Args[0].(map[string]interface{})
+			require.Equal(t, multicallAddress, callArgs["to"])
+
+			data := callArgs["data"].(hexutil.Bytes)
+			inputs, err := multicall3ABI.Methods["aggregate3"].Inputs.Unpack(data[4:])
+			require.NoError(t, err)
+			aggregated := *abi.ConvertType(inputs[0], new([]multicall3Call)).(*[]multicall3Call)
+
+			results := make([]multicall3Result, len(aggregated))
+			for i, call := range aggregated {
+				results[i] = multicall3Result{Success: call.Target != failing, ReturnData: echoOutput(call.CallData)}
+			}
+			output, err := multicall3ABI.Methods["aggregate3"].Outputs.Pack(results)
+			require.NoError(t, err)
+			*elems[0].Result.(*string) = hexutil.Encode(output)
+		}).Return(nil).Once()
+
+		bc := NewMulticall3BatchCaller(logger.Test(t), testCodec, ec, multicallAddress, 0, 0, 1)
+		results, err := bc.BatchCall(testutils.Context(t), 0, calls)
+		require.NoError(t, err)
+
+		contractResults := results["Contract"]
+		require.Len(t, contractResults, 3)
+		require.NoError(t, contractResults[0].Err)
+		assert.Equal(t, uint64(0), contractResults[0].ReturnValue.(*multicallTestReturn).B)
+		require.ErrorIs(t, contractResults[1].Err, errMulticall3CallFailed)
+		require.NoError(t, contractResults[2].Err)
+		assert.Equal(t, uint64(2), contractResults[2].ReturnValue.(*multicallTestReturn).B)
+	})
+
+	t.Run("falls back when multicall3 is not deployed", func(t *testing.T) {
+		t.Parallel()
+
+		calls, testCodec := newMulticallTestCalls(t, 3, nil)
+		var batchSizes []int
+		ec := clienttest.NewClient(t)
+		ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			batchSizes = append(batchSizes, len(elems))
+			for i := range elems {
+				callArgs := elems[i].Args[0].(map[string]interface{})
+				if callArgs["to"] == multicallAddress {
+					*elems[i].Result.(*string) = "0x"
+					continue
+				}
+				*elems[i].Result.(*string) = hexutil.Encode(echoOutput(callArgs["data"].(hexutil.Bytes)))
+			}
+		}).Return(nil)
+
+		bc := NewMulticall3BatchCaller(logger.Test(t), testCodec, ec, multicallAddress, 0, 0, 1)
+		for range 2 {
+			results, err := bc.BatchCall(testutils.Context(t), 0, calls)
+			require.NoError(t, err)
+			for i, result := range results["Contract"] {
+				require.NoError(t, result.Err)
+				assert.Equal(t, uint64(i), result.ReturnValue.(*multicallTestReturn).B)
+			}
+		}
+
+		// multicall3 is only tried once
+		assert.Equal(t, []int{1, 3, 3}, batchSizes)
+	})
+
+	t.Run("makes calls with multicall disabled individually", func(t *testing.T) {
+		t.Parallel()
+
+		calls, testCodec := newMulticallTestCalls(t, 3, nil)
+		calls[0].noMulticall = true
+		var targets [][]common.Address
+		ec := clienttest.NewClient(t)
+		ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			var batch []common.Address
+			for i := range elems {
+				callArgs := elems[i].Args[0].(map[string]interface{})
+				data := callArgs["data"].(hexutil.Bytes)
+				if callArgs["to"] != multicallAddress {
+					batch = append(batch, callArgs["to"].(common.Address))
+					*elems[i].Result.(*string) = hexutil.Encode(echoOutput(data))
+					continue
+				}
+
+				inputs, err := multicall3ABI.Methods["aggregate3"].Inputs.Unpack(data[4:])
+				require.NoError(t, err)
+				aggregated := *abi.ConvertType(inputs[0], new([]multicall3Call)).(*[]multicall3Call)
+				results := make([]multicall3Result, len(aggregated))
+				for j, call := range aggregated {
+					batch = append(batch, call.Target)
+					results[j] = multicall3Result{Success: true, ReturnData: echoOutput(call.CallData)}
+				}
+				output, err := multicall3ABI.Methods["aggregate3"].Outputs.Pack(results)
+				require.NoError(t, err)
+				*elems[i].Result.(*string) = hexutil.Encode(output)
+			}
+			targets = append(targets, batch)
+		}).Return(nil)
+
+		bc := NewMulticall3BatchCaller(logger.Test(t), testCodec, ec, multicallAddress, 0, 0, 1)
+		results, err := bc.BatchCall(testutils.Context(t), 0, calls)
+		require.NoError(t, err)
+		for i, result := range results["Contract"] {
+			require.NoError(t, result.Err)
+			assert.Equal(t, uint64(i), result.ReturnValue.(*multicallTestReturn).B)
+		}
+
+		assert.Equal(t, [][]common.Address{
+			{calls[1].ContractAddress, calls[2].ContractAddress},
+			{calls[0].ContractAddress},
+		}, targets)
+	})
+}
+
+func TestBatchCaller_cache(t *testing.T) {
+	t.Parallel()
+
+	calls, testCodec := newMulticallTestCalls(t, 2, newResultCache(0))
+	var batchSizes []int
+	ec := clienttest.NewClient(t)
+	ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		batchSizes = append(batchSizes, len(elems))
+		for i := range elems {
+			data := elems[i].Args[0].(map[string]interface{})["data"].(hexutil.Bytes)
+			*elems[i].Result.(*string) = hexutil.Encode(echoOutput(data))
+		}
+	}).Return(nil)
+
+	bc := NewDynamicLimitedBatchCaller(logger.Test(t), testCodec, ec, 0, 0, 1)
+	for _, blockNumber := range []uint64{10, 10, 11} {
+		results, err := bc.BatchCall(testutils.Context(t), blockNumber, calls)
+		require.NoError(t, err)
+		for i, result := range results["Contract"] {
+			require.NoError(t, result.Err, "call %d", i)
+			assert.Equal(t, uint64(i), result.ReturnValue.(*multicallTestReturn).B)
+		}
+	}
+
+	// the second read of block 10 is cached
+	assert.Equal(t, []int{2, 2}, batchSizes)
+}
+
+func TestBatchCaller_cacheLatest(t *testing.T) {
+	t.Parallel()
+
+	calls, testCodec := newMulticallTestCalls(t, 2, newResultCache(0))
+	latest := []uint64{10, 10, 11}
+	var blocks []string
+	ec := clienttest.NewClient(t)
+	ec.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		if elems[0].Method == "eth_blockNumber" {
+			*elems[0].Result.(*hexutil.Uint64) = hexutil.Uint64(latest[0])
+			latest = latest[1:]
+			return
+		}
+		for i := range elems {
+			blocks = append(blocks, elems[i].Args[1].(string))
+			data := elems[i].Args[0].(map[string]interface{})["data"].(hexutil.Bytes)
+			*elems[i].Result.(*string) = hexutil.Encode(echoOutput(data))
+		}
+	}).Return(nil)
+
+	bc := NewDynamicLimitedBatchCaller(logger.Test(t), testCodec, ec, 0, 0, 1)
+	for range 3 {
+		results, err := bc.BatchCall(testutils.Context(t), 0, calls)
+		require.NoError(t, err)
+		for i, result := range results["Contract"] {
+			require.NoError(t, result.Err, "call %d", i)
+			assert.Equal(t, uint64(i), result.ReturnValue.(*multicallTestReturn).B)
+		}
+	}
+
+	// latest reads are pinned to the latest block and only cached for it
+	assert.Equal(t, []string{"0xa", "0xa", "0xb", "0xb"}, blocks)
+}
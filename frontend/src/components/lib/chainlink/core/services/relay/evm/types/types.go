@@ -26,6 +26,10 @@ import (
 type ChainWriterConfig struct {
 	Contracts   map[string]*ContractConfig
 	MaxGasPrice *assets.Wei
+	// Multicall3 sends each bundle atomically as a single Multicall3 transaction, if set. Otherwise, the transactions
+	// of a bundle are sent with consecutive nonces. The calls of a Multicall3 bundle are made by the Multicall3
+	// contract, so contracts that check msg.sender reject them.
+	Multicall3 *Multicall3Config
 }
 
 type ContractConfig struct {
@@ -46,6 +50,15 @@ type ChainWriterDefinition struct {
 type ChainReaderConfig struct {
 	// Contracts key is contract name
 	Contracts map[string]ChainContractReader `json:"contracts" toml:"contracts"`
+	// Multicall3 aggregates the calls of each batch read into a single eth_call, if set. Aggregated calls are made by
+	// the Multicall3 contract, so msg.sender is its address instead of the zero address. Set MulticallDisabled on the
+	// reads that depend on msg.sender.
+	Multicall3 *Multicall3Config `json:"multicall3,omitempty" toml:"multicall3,omitempty"`
+}
+
+type Multicall3Config struct {
+	// Address of the Multicall3 contract, defaults to its deterministic deployment address which is the same on most chains.
+	Address *common.Address `json:"address,omitempty" toml:"address,omitempty"`
 }
 
 type CodecConfig struct {
@@ -118,7 +131,12 @@ type EventDefinitions struct {
 // This is necessary because package json recognizes the text encoding methods used for TOML,
 // and would infinitely recurse on itself.
 type chainReaderDefinitionFields struct {
+	// CacheEnabled caches method results by block and confidence level, so repeated reads in the same block make a single call.
 	CacheEnabled bool `json:"cacheEnabled,omitempty"`
+	// CacheTTL is how long cached results are kept, defaults to 30s.
+	CacheTTL models.Interval `json:"cacheTTL,omitempty"`
+	// MulticallDisabled excludes the method from Multicall3 aggregation, e.g. if its result depends on msg.sender.
+	MulticallDisabled bool `json:"multicallDisabled,omitempty"`
 	// chain specific contract method name or event type.
 	ChainSpecificName   string                `json:"chainSpecificName"`
 	ReadType            ReadType              `json:"readType,omitempty"`
@@ -208,6 +226,9 @@ type RelayConfig struct {
 
 	DefaultTransactionQueueDepth uint32 `json:"defaultTransactionQueueDepth"`
 	SimulateTransactions         bool   `json:"simulateTransactions"`
+	// SendingKeyStrategy and ExcludeStuckSendingKeys override the key selection of the chain.
+	SendingKeyStrategy      string `json:"sendingKeyStrategy"`
+	ExcludeStuckSendingKeys *bool  `json:"excludeStuckSendingKeys"`
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
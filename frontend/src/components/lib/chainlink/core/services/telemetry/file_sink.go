@@ -0,0 +1,137 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+)
+
+var _ Sink = &fileSink{}
+
+// fileSink writes telemetry to a local file, rotated once it reaches its max size.
+type fileSink struct {
+	services.Service
+	eng *services.Engine
+
+	w      io.WriteCloser
+	encode func(io.Writer, Record) error
+
+	dropMessageCount atomic.Uint32
+	chRecords        chan Record
+}
+
+// NewFileSink returns a Sink writing telemetry to cfg.Path(), either as JSON
+// lines or as length-delimited TelemRequest protobufs.
+func NewFileSink(cfg config.TelemetryIngressFileSink, lggr logger.Logger, bufferSize uint) Sink {
+	s := &fileSink{
+		w: &lumberjack.Logger{
+			Filename:   cfg.Path(),
+			MaxSize:    int(cfg.MaxSize() / utils.MB),
+			MaxBackups: int(cfg.MaxBackups()),
+		},
+		encode:    encodeJSONRecord,
+		chRecords: make(chan Record, bufferSize),
+	}
+	if cfg.Format() == toml.TelemetrySinkFormatProtobuf {
+		s.encode = encodeProtobufRecord
+	}
+	s.Service, s.eng = services.Config{
+		Name:  "TelemetryFileSink",
+		Start: s.start,
+		Close: s.close,
+	}.NewServiceEngine(logger.Named(lggr, cfg.Path()))
+	return s
+}
+
+func (s *fileSink) start(context.Context) error {
+	s.eng.Go(func(ctx context.Context) {
+		for {
+			select {
+			case r := <-s.chRecords:
+				s.write(r)
+			case <-ctx.Done():
+				// write the records queued before closing
+				for {
+					select {
+					case r := <-s.chRecords:
+						s.write(r)
+					default:
+						return
+					}
+				}
+			}
+		}
+	})
+	return nil
+}
+
+func (s *fileSink) write(r Record) {
+	if err := s.encode(s.w, r); err != nil {
+		s.eng.Errorw("Could not write telemetry", "err", err)
+	}
+}
+
+func (s *fileSink) close() error {
+	return s.w.Close()
+}
+
+// Send queues r to be written, dropping it if the buffer is full.
+func (s *fileSink) Send(ctx context.Context, r Record) {
+	select {
+	case s.chRecords <- r:
+		s.dropMessageCount.Store(0)
+	case <-ctx.Done():
+	default:
+		count := s.dropMessageCount.Add(1)
+		if count%100 == 0 || count&(count-1) == 0 {
+			s.eng.Warnw("telemetry file sink buffer full, dropping message", "droppedCount", count)
+		}
+	}
+}
+
+type jsonRecord struct {
+	Network       string    `json:"network"`
+	ChainID       string    `json:"chainID"`
+	ContractID    string    `json:"contractID"`
+	TelemetryType string    `json:"telemetryType"`
+	Telemetry     []byte    `json:"telemetry"`
+	SentAt        time.Time `json:"sentAt"`
+}
+
+func encodeJSONRecord(w io.Writer, r Record) error {
+	// Encode appends a newline to each record
+	return json.NewEncoder(w).Encode(jsonRecord{
+		Network:       r.Network,
+		ChainID:       r.ChainID,
+		ContractID:    r.ContractID,
+		TelemetryType: string(r.TelemetryType),
+		Telemetry:     r.Telemetry,
+		SentAt:        r.SentAt,
+	})
+}
+
+// encodeProtobufRecord writes r as sent to the ingress server. The network and
+// chain are not included, so use a sink per network to tell them apart.
+func encodeProtobufRecord(w io.Writer, r Record) error {
+	_, err := protodelim.MarshalTo(w, &telemPb.TelemRequest{
+		Telemetry:     r.Telemetry,
+		Address:       r.ContractID,
+		TelemetryType: string(r.TelemetryType),
+		SentAt:        r.SentAt.UnixNano(),
+	})
+	return err
+}
@@ -1,12 +1,16 @@
 package telemetry
 
 import (
+	"context"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/libocr/commontypes"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	common "github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -22,9 +26,15 @@ type Manager struct {
 	eng *services.Engine
 
 	bufferSize uint
-	endpoints  []*telemetryEndpoint
 	ks         keystore.CSA
 
+	endpointsMu sync.RWMutex
+	endpoints   []*telemetryEndpoint
+	// added are the clients of the endpoints added by AddEndpoints
+	added []services.Service
+	// sinks receive the telemetry of the networks they match, with or without an endpoint
+	sinks []filteredSink
+
 	logging                     bool
 	maxBatchSize                uint
 	sendInterval                time.Duration
@@ -64,16 +74,66 @@ func NewManager(cfg config.TelemetryIngress, csaKeyStore keystore.CSA, lggr logg
 					subs = append(subs, sub)
 				}
 			}
+			for _, s := range cfg.FileSinks() {
+				sink := NewFileSink(s, logger.Named(lggr, "FileSink"), cfg.BufferSize())
+				m.sinks = append(m.sinks, filteredSink{sink, newSinkFilter(s)})
+				subs = append(subs, sink)
+			}
+			for _, s := range cfg.OTLPSinks() {
+				sink := NewOTLPSink(s, logger.Named(lggr, "OTLPSink"))
+				m.sinks = append(m.sinks, filteredSink{sink, newSinkFilter(s)})
+				subs = append(subs, sink)
+			}
 			return
 		},
+		Close: m.close,
 	}.NewServiceEngine(lggr)
 
 	return m
 }
 
-// GenMonitoringEndpoint creates a new monitoring endpoints based on the existing available endpoints defined in the core config TOML, if no endpoint for the network and chainID exists, a NOOP agent will be used and the telemetry will not be sent
+// AddEndpoints starts the endpoints of cfg that the manager does not have yet,
+// e.g. after the config is reloaded. Existing endpoints are left unchanged.
+func (m *Manager) AddEndpoints(ctx context.Context, cfg config.TelemetryIngress) (err error) {
+	for _, e := range cfg.Endpoints() {
+		if _, found := m.getEndpoint(e.Network(), e.ChainID()); found {
+			continue
+		}
+		sub, err2 := m.newEndpoint(e, m.eng, cfg)
+		if err2 != nil {
+			err = multierr.Append(err, err2)
+			continue
+		}
+		if err2 = sub.Start(ctx); err2 != nil {
+			m.removeEndpoint(e.Network(), e.ChainID())
+			err = multierr.Append(err, errors.Wrapf(err2, "failed to start telemetry endpoint for network %q and chainID %q", e.Network(), e.ChainID()))
+			continue
+		}
+		m.endpointsMu.Lock()
+		m.added = append(m.added, sub)
+		m.endpointsMu.Unlock()
+		m.eng.Infow("Added telemetry endpoint", "network", e.Network(), "chainID", e.ChainID(), "url", e.URL())
+	}
+	return err
+}
+
+func (m *Manager) removeEndpoint(network string, chainID string) {
+	m.endpointsMu.Lock()
+	defer m.endpointsMu.Unlock()
+	m.endpoints = slices.DeleteFunc(m.endpoints, func(e *telemetryEndpoint) bool {
+		return e.Network == strings.ToUpper(network) && e.ChainID == strings.ToUpper(chainID)
+	})
+}
+
+func (m *Manager) close() error {
+	m.endpointsMu.Lock()
+	defer m.endpointsMu.Unlock()
+	return services.CloseAll(m.added...)
+}
+
+// GenMonitoringEndpoint creates a new monitoring endpoints based on the existing available endpoints and sinks defined in the core config TOML, if no endpoint or sink for the network and chainID exists, a NOOP agent will be used and the telemetry will not be sent
 func (m *Manager) GenMonitoringEndpoint(network string, chainID string, contractID string, telemType synchronization.TelemetryType) commontypes.MonitoringEndpoint {
-	e, found := m.getEndpoint(network, chainID)
+	client, found := m.getClient(network, chainID)
 
 	if !found {
 		m.eng.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry %q for contractID %q will NOT be sent", network, chainID, telemType, contractID)
@@ -81,14 +141,14 @@ func (m *Manager) GenMonitoringEndpoint(network string, chainID string, contract
 	}
 
 	if m.useBatchSend {
-		return NewTypedIngressAgentBatch(e.client, network, chainID, contractID, telemType)
+		return NewTypedIngressAgentBatch(client, network, chainID, contractID, telemType)
 	}
 
-	return NewTypedIngressAgent(e.client, network, chainID, contractID, telemType)
+	return NewTypedIngressAgent(client, network, chainID, contractID, telemType)
 }
 
 func (m *Manager) GenMultitypeMonitoringEndpoint(network string, chainID string, contractID string) MultitypeMonitoringEndpoint {
-	e, found := m.getEndpoint(network, chainID)
+	client, found := m.getClient(network, chainID)
 
 	if !found {
 		m.eng.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry for contractID %q will NOT be sent", network, chainID, contractID)
@@ -96,10 +156,33 @@ func (m *Manager) GenMultitypeMonitoringEndpoint(network string, chainID string,
 	}
 
 	if m.useBatchSend {
-		return NewMultiIngressAgentBatch(e.client, network, chainID, contractID)
+		return NewMultiIngressAgentBatch(client, network, chainID, contractID)
 	}
 
-	return NewMultiIngressAgent(e.client, network, chainID, contractID)
+	return NewMultiIngressAgent(client, network, chainID, contractID)
+}
+
+// getClient returns the client sending the telemetry of network and chainID to
+// their endpoint, if any, and to the sinks matching the network.
+func (m *Manager) getClient(network string, chainID string) (synchronization.TelemetryService, bool) {
+	var sinks []filteredSink
+	for _, s := range m.sinks {
+		if s.matchesNetwork(network) {
+			sinks = append(sinks, s)
+		}
+	}
+	e, found := m.getEndpoint(network, chainID)
+	if len(sinks) == 0 {
+		if !found {
+			return nil, false
+		}
+		return e.client, true
+	}
+	c := &sinkClient{sinks: sinks, network: network, chainID: chainID}
+	if found {
+		c.ingress = e.client
+	}
+	return c, true
 }
 
 func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Logger, cfg config.TelemetryIngress) (services.Service, error) {
@@ -139,11 +222,15 @@ func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Log
 		client:  tClient,
 	}
 
+	m.endpointsMu.Lock()
 	m.endpoints = append(m.endpoints, &te)
+	m.endpointsMu.Unlock()
 	return te.client, nil
 }
 
 func (m *Manager) getEndpoint(network string, chainID string) (*telemetryEndpoint, bool) {
+	m.endpointsMu.RLock()
+	defer m.endpointsMu.RUnlock()
 	for _, e := range m.endpoints {
 		if e.Network == strings.ToUpper(network) && e.ChainID == strings.ToUpper(chainID) {
 			return e, true
@@ -32,6 +32,8 @@ func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
 	tic.On("SendTimeout").Return(time.Second * 7)
 	tic.On("UniConn").Return(true)
 	tic.On("UseBatchSend").Return(useBatchSend)
+	tic.On("FileSinks").Return(nil)
+	tic.On("OTLPSinks").Return(nil)
 
 	return tic
 }
@@ -195,6 +197,48 @@ func TestNewManager(t *testing.T) {
 	require.Len(t, hr, 4)
 }
 
+func TestManager_AddEndpoints(t *testing.T) {
+	newEndpoint := func(network, chainID string) *mocks.TelemetryIngressEndpoint {
+		te := mocks.NewTelemetryIngressEndpoint(t)
+		te.On("Network").Return(network)
+		te.On("ChainID").Return(chainID)
+		te.On("ServerPubKey").Maybe().Return(GetDummyKeyStringWithPrefix(chainID + "-pub-key"))
+		u, _ := url.Parse("http://" + chainID + ".test")
+		te.On("URL").Maybe().Return(u)
+		return te
+	}
+	te1 := newEndpoint("network-1", "chainID-1")
+	te2 := newEndpoint("network-1", "chainID-2")
+
+	tic := setupMockConfig(t, false)
+	tic.On("Endpoints").Return([]config.TelemetryIngressEndpoint{te1}).Once()
+
+	lggr, _ := logger.TestLoggerObserved(t, zapcore.InfoLevel)
+	ks := keymocks.NewCSA(t)
+	ks.On("EnsureKey", mock.Anything).Maybe().Return(nil)
+	key := csakey.MustNewV2XXXTestingOnly(big.NewInt(0))
+	ks.On("GetAll").Maybe().Return([]csakey.KeyV2{key}, nil)
+	ks.On("Get", key.ID()).Maybe().Return(key, nil)
+
+	m := NewManager(tic, ks, lggr)
+	require.NoError(t, m.Start(testutils.Context(t)))
+	t.Cleanup(func() {
+		require.NoError(t, m.Close())
+	})
+	assert.IsType(t, &NoopAgent{}, m.GenMonitoringEndpoint("network-1", "chainID-2", "", ""))
+
+	tic.On("Endpoints").Return([]config.TelemetryIngressEndpoint{te1, te2})
+	require.NoError(t, m.AddEndpoints(testutils.Context(t), tic))
+
+	require.Len(t, m.endpoints, 2)
+	assert.Equal(t, "CHAINID-2", m.endpoints[1].ChainID)
+	assert.IsType(t, &TypedIngressAgent{}, m.GenMonitoringEndpoint("network-1", "chainID-2", "", ""))
+
+	// existing endpoints are left unchanged
+	require.NoError(t, m.AddEndpoints(testutils.Context(t), tic))
+	require.Len(t, m.endpoints, 2)
+}
+
 func TestCorrectEndpointRouting(t *testing.T) {
 	tic := setupMockConfig(t, true)
 	tic.On("Endpoints").Return(nil)
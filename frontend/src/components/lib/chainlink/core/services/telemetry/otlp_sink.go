@@ -0,0 +1,88 @@
+package telemetry
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+var _ Sink = &otlpSink{}
+
+// otlpShutdownTimeout bounds flushing the queued records on close.
+const otlpShutdownTimeout = 5 * time.Second
+
+// otlpSink exports telemetry as OTLP log records, with the telemetry as the
+// body and its metadata as attributes.
+type otlpSink struct {
+	services.Service
+	eng *services.Engine
+
+	endpoint string
+	insecure bool
+
+	provider *sdklog.LoggerProvider
+	logger   otellog.Logger
+}
+
+// NewOTLPSink returns a Sink exporting telemetry to the OTLP gRPC endpoint of cfg.
+func NewOTLPSink(cfg config.TelemetryIngressOTLPSink, lggr logger.Logger) Sink {
+	s := &otlpSink{
+		endpoint: cfg.Endpoint(),
+		insecure: cfg.InsecureConnection(),
+	}
+	s.Service, s.eng = services.Config{
+		Name:  "TelemetryOTLPSink",
+		Start: s.start,
+		Close: s.close,
+	}.NewServiceEngine(logger.Named(lggr, cfg.Endpoint()))
+	return s
+}
+
+func (s *otlpSink) start(ctx context.Context) error {
+	opts := []otlploggrpc.Option{otlploggrpc.WithEndpoint(s.endpoint)}
+	if strings.Contains(s.endpoint, "://") {
+		opts = []otlploggrpc.Option{otlploggrpc.WithEndpointURL(s.endpoint)}
+	}
+	if s.insecure {
+		opts = append(opts, otlploggrpc.WithInsecure())
+	}
+	exporter, err := otlploggrpc.New(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	// the batch processor exports in the background, so Emit does not block on the endpoint
+	s.provider = sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)))
+	s.logger = s.provider.Logger("chainlink/telemetry")
+	return nil
+}
+
+func (s *otlpSink) close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), otlpShutdownTimeout)
+	defer cancel()
+	return s.provider.Shutdown(ctx)
+}
+
+func (s *otlpSink) Send(ctx context.Context, r Record) {
+	if s.Ready() != nil {
+		return
+	}
+	var rec otellog.Record
+	rec.SetTimestamp(r.SentAt)
+	rec.SetBody(otellog.BytesValue(r.Telemetry))
+	rec.AddAttributes(
+		otellog.String("network", r.Network),
+		otellog.String("chain_id", r.ChainID),
+		otellog.String("contract_id", r.ContractID),
+		otellog.String("telemetry_type", string(r.TelemetryType)),
+	)
+	s.logger.Emit(ctx, rec)
+}
@@ -0,0 +1,94 @@
+package telemetry
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+)
+
+// Record is a telemetry message sent to a Sink.
+type Record struct {
+	Network       string
+	ChainID       string
+	ContractID    string
+	TelemetryType synchronization.TelemetryType
+	Telemetry     []byte
+	SentAt        time.Time
+}
+
+// Sink receives telemetry in addition to, or instead of, the telemetry ingress server.
+type Sink interface {
+	services.Service
+	// Send queues r to be written. It must not block, so records may be dropped.
+	Send(ctx context.Context, r Record)
+}
+
+// sinkFilter limits the telemetry sent to a sink. Empty lists match everything.
+type sinkFilter struct {
+	networks       []string
+	telemetryTypes []string
+}
+
+func newSinkFilter(cfg config.TelemetryIngressSinkFilter) sinkFilter {
+	f := sinkFilter{telemetryTypes: cfg.TelemetryTypes()}
+	for _, n := range cfg.Networks() {
+		f.networks = append(f.networks, strings.ToUpper(n))
+	}
+	return f
+}
+
+func (f sinkFilter) matchesNetwork(network string) bool {
+	return len(f.networks) == 0 || slices.Contains(f.networks, strings.ToUpper(network))
+}
+
+func (f sinkFilter) matchesType(telemType synchronization.TelemetryType) bool {
+	return len(f.telemetryTypes) == 0 || slices.Contains(f.telemetryTypes, string(telemType))
+}
+
+type filteredSink struct {
+	Sink
+	sinkFilter
+}
+
+var _ synchronization.TelemetryService = &sinkClient{}
+
+// sinkClient sends telemetry of a network and chain to its ingress client, if
+// any, and to the sinks matching the network. It is created per monitoring
+// endpoint, so its clients and sinks are started and closed by the Manager.
+type sinkClient struct {
+	ingress synchronization.TelemetryService
+	sinks   []filteredSink
+	network string
+	chainID string
+}
+
+func (c *sinkClient) Start(context.Context) error    { return nil }
+func (c *sinkClient) Close() error                   { return nil }
+func (c *sinkClient) Ready() error                   { return nil }
+func (c *sinkClient) HealthReport() map[string]error { return map[string]error{} }
+func (c *sinkClient) Name() string                   { return "TelemetrySinkClient" }
+
+func (c *sinkClient) Send(ctx context.Context, telemetry []byte, contractID string, telemType synchronization.TelemetryType) {
+	if c.ingress != nil {
+		c.ingress.Send(ctx, telemetry, contractID, telemType)
+	}
+	r := Record{
+		Network:       c.network,
+		ChainID:       c.chainID,
+		ContractID:    contractID,
+		TelemetryType: telemType,
+		Telemetry:     telemetry,
+		SentAt:        time.Now(),
+	}
+	for _, s := range c.sinks {
+		if s.matchesType(telemType) {
+			s.Send(ctx, r)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package telemetry
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	keymocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	mocks2 "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var _ config.TelemetryIngressFileSink = fileSinkConfig{}
+
+type fileSinkConfig struct {
+	path           string
+	format         string
+	networks       []string
+	telemetryTypes []string
+}
+
+func (c fileSinkConfig) Path() string             { return c.path }
+func (c fileSinkConfig) Format() string           { return c.format }
+func (c fileSinkConfig) MaxSize() utils.FileSize  { return 0 }
+func (c fileSinkConfig) MaxBackups() int64        { return 0 }
+func (c fileSinkConfig) Networks() []string       { return c.networks }
+func (c fileSinkConfig) TelemetryTypes() []string { return c.telemetryTypes }
+
+func readJSONRecords(t *testing.T, path string) (records []jsonRecord) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r jsonRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.NoError(t, scanner.Err())
+	return
+}
+
+func TestManager_FileSinks(t *testing.T) {
+	dir := t.TempDir()
+	allPath := filepath.Join(dir, "all.jsonl")
+	ocrPath := filepath.Join(dir, "ocr.pb")
+
+	tic := mocks.NewTelemetryIngress(t)
+	tic.On("BufferSize").Return(uint(10))
+	tic.On("Logging").Return(false)
+	tic.On("MaxBatchSize").Return(uint(10))
+	tic.On("SendInterval").Return(time.Second)
+	tic.On("SendTimeout").Return(time.Second)
+	tic.On("UniConn").Return(false)
+	tic.On("UseBatchSend").Return(false)
+	tic.On("Endpoints").Return(nil)
+	tic.On("FileSinks").Return([]config.TelemetryIngressFileSink{
+		fileSinkConfig{path: allPath, format: "jsonl"},
+		fileSinkConfig{path: ocrPath, format: "protobuf", networks: []string{"evm"}, telemetryTypes: []string{string(synchronization.OCR)}},
+	})
+	tic.On("OTLPSinks").Return(nil)
+
+	m := NewManager(tic, keymocks.NewCSA(t), logger.TestLogger(t))
+	require.NoError(t, m.Start(testutils.Context(t)))
+
+	// without an endpoint, telemetry is only sent to the sinks
+	m.GenMonitoringEndpoint("EVM", "1", "0xa", synchronization.OCR).SendLog([]byte("ocr"))
+	m.GenMonitoringEndpoint("EVM", "1", "0xa", synchronization.HeadReport).SendLog([]byte("head"))
+	m.GenMultitypeMonitoringEndpoint("solana", "mainnet", "0xb").SendTypedLog(synchronization.OCR, []byte("solana"))
+	require.NoError(t, m.Close())
+
+	records := readJSONRecords(t, allPath)
+	require.Len(t, records, 3)
+	assert.Equal(t, jsonRecord{Network: "EVM", ChainID: "1", ContractID: "0xa", TelemetryType: "ocr", Telemetry: []byte("ocr"), SentAt: records[0].SentAt}, records[0])
+	assert.Equal(t, "head-report", records[1].TelemetryType)
+	assert.Equal(t, "solana", records[2].Network)
+	assert.Equal(t, []byte("solana"), records[2].Telemetry)
+
+	f, err := os.Open(ocrPath)
+	require.NoError(t, err)
+	defer f.Close()
+	var req telemPb.TelemRequest
+	require.NoError(t, protodelim.UnmarshalFrom(bufio.NewReader(f), &req))
+	assert.Equal(t, []byte("ocr"), req.Telemetry)
+	assert.Equal(t, "0xa", req.Address)
+	assert.Equal(t, "ocr", req.TelemetryType)
+	assert.Equal(t, records[0].SentAt.UnixNano(), req.SentAt)
+	assert.Error(t, protodelim.UnmarshalFrom(bufio.NewReader(f), &req), "only OCR telemetry of EVM is written")
+}
+
+func TestSinkClient_Send(t *testing.T) {
+	ingress := mocks2.NewTelemetryService(t)
+	ingress.On("Send", mock.Anything, []byte("ocr"), "0xa", synchronization.OCR).Once()
+	ingress.On("Send", mock.Anything, []byte("head"), "0xa", synchronization.HeadReport).Once()
+
+	path := filepath.Join(t.TempDir(), "telemetry.jsonl")
+	cfg := fileSinkConfig{path: path, telemetryTypes: []string{string(synchronization.OCR)}}
+	sink := NewFileSink(cfg, logger.TestLogger(t), 10)
+	require.NoError(t, sink.Start(testutils.Context(t)))
+
+	c := &sinkClient{ingress: ingress, sinks: []filteredSink{{sink, newSinkFilter(cfg)}}, network: "EVM", chainID: "1"}
+	c.Send(testutils.Context(t), []byte("ocr"), "0xa", synchronization.OCR)
+	c.Send(testutils.Context(t), []byte("head"), "0xa", synchronization.HeadReport)
+	require.NoError(t, sink.Close())
+
+	records := readJSONRecords(t, path)
+	require.Len(t, records, 1)
+	assert.Equal(t, []byte("ocr"), records[0].Telemetry)
+}
@@ -40,6 +40,7 @@ type Delegate struct {
 	legacyChains legacyevm.LegacyChainContainer
 	lggr         logger.Logger
 	mailMon      *mailbox.Monitor
+	backlog      *vrfcommon.BacklogRegistry
 }
 
 func NewDelegate(
@@ -49,7 +50,8 @@ func NewDelegate(
 	porm pipeline.ORM,
 	legacyChains legacyevm.LegacyChainContainer,
 	lggr logger.Logger,
-	mailMon *mailbox.Monitor) *Delegate {
+	mailMon *mailbox.Monitor,
+	backlog *vrfcommon.BacklogRegistry) *Delegate {
 	return &Delegate{
 		ds:           ds,
 		ks:           ks,
@@ -58,6 +60,7 @@ func NewDelegate(
 		legacyChains: legacyChains,
 		lggr:         lggr.Named("VRF"),
 		mailMon:      mailMon,
+		backlog:      backlog,
 	}
 }
 
@@ -70,6 +73,16 @@ func (d *Delegate) AfterJobCreated(job.Job)                    {}
 func (d *Delegate) BeforeJobDeleted(job.Job)                   {}
 func (d *Delegate) OnDeleteJob(context.Context, job.Job) error { return nil }
 
+// withBacklog registers the pending requests of a v2 or v2plus listener
+// with the backlog registry while the job is running.
+func (d *Delegate) withBacklog(jb job.Job, listener job.ServiceCtx) []job.ServiceCtx {
+	srvs := []job.ServiceCtx{listener}
+	if inspector, ok := listener.(vrfcommon.BacklogInspector); ok && d.backlog != nil {
+		srvs = append(srvs, &vrfcommon.BacklogRegistration{Registry: d.backlog, JobID: jb.ID, Inspector: inspector})
+	}
+	return srvs
+}
+
 // ServicesForSpec satisfies the job.Delegate interface.
 func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.ServiceCtx, error) {
 	if jb.VRFSpec == nil || jb.PipelineSpec == nil {
@@ -175,28 +188,26 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				return nil, errors.Wrap(err2, "NewAggregatorV3Interface")
 			}
 
-			return []job.ServiceCtx{
-				v2.New(
-					chain.Config().EVM(),
-					chain.Config().EVM().GasEstimator(),
-					lV2Plus,
-					chain,
-					chain.ID(),
-					d.ds,
-					v2.NewCoordinatorV2_5(coordinatorV2Plus),
-					batchCoordinatorV2,
-					vrfOwner,
-					aggregator,
-					d.pr,
-					d.ks.Eth(),
-					jb,
-					func() {},
-					// the lookback in the deduper must be >= the lookback specified for the log poller
-					// otherwise we will end up re-delivering logs that were already delivered.
-					vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
-					vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
-				),
-			}, nil
+			return d.withBacklog(jb, v2.New(
+				chain.Config().EVM(),
+				chain.Config().EVM().GasEstimator(),
+				lV2Plus,
+				chain,
+				chain.ID(),
+				d.ds,
+				v2.NewCoordinatorV2_5(coordinatorV2Plus),
+				batchCoordinatorV2,
+				vrfOwner,
+				aggregator,
+				d.pr,
+				d.ks.Eth(),
+				jb,
+				func() {},
+				// the lookback in the deduper must be >= the lookback specified for the log poller
+				// otherwise we will end up re-delivering logs that were already delivered.
+				vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
+				vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
+			)), nil
 		}
 		if _, ok := task.(*pipeline.VRFTaskV2); ok {
 			if err2 := CheckFromAddressesExist(ctx, jb, d.ks.Eth()); err != nil {
@@ -230,7 +241,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				lV2.Infow("Running without VRFOwnerAddress set on the spec")
 			}
 
-			return []job.ServiceCtx{v2.New(
+			return d.withBacklog(jb, v2.New(
 				chain.Config().EVM(),
 				chain.Config().EVM().GasEstimator(),
 				lV2,
@@ -249,8 +260,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				// otherwise we will end up re-delivering logs that were already delivered.
 				vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
 				vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
-			),
-			}, nil
+			)), nil
 		}
 		if _, ok := task.(*pipeline.VRFTask); ok {
 			return []job.ServiceCtx{&v1.Listener{
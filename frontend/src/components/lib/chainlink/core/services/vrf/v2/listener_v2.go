@@ -104,6 +104,7 @@ func New(
 		aggregator:            aggregator,
 		inflightCache:         inflightCache,
 		fulfillmentLogDeduper: fulfillmentDeduper,
+		backlog:               newBacklog(),
 	}
 }
 
@@ -153,6 +154,9 @@ type listenerV2 struct {
 	// inflightCache is a cache of in-flight requests, used to prevent
 	// re-processing of requests that are in-flight or already fulfilled.
 	inflightCache vrfcommon.InflightCache
+
+	// backlog tracks the status of pending requests for operators to inspect.
+	backlog *backlog
 }
 
 func (lsn *listenerV2) HealthReport() map[string]error {
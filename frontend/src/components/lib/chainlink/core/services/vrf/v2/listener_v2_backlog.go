@@ -0,0 +1,260 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+var _ vrfcommon.BacklogInspector = &listenerV2{}
+
+type backlogEntry struct {
+	req    pendingRequest
+	status vrfcommon.PendingRequestStatus
+}
+
+// backlog tracks why the pending requests of a listener have not been
+// fulfilled yet, for operators to inspect
+type backlog struct {
+	mu      sync.RWMutex
+	entries map[string]*backlogEntry
+	now     func() time.Time
+}
+
+func newBacklog() *backlog {
+	return &backlog{
+		entries: make(map[string]*backlogEntry),
+		now:     time.Now,
+	}
+}
+
+// update replaces the backlog with the pending requests found by the latest
+// poll. Requests with a fulfillment in flight are no longer returned by the
+// poll, so they are kept until they are fulfilled or time out.
+func (b *backlog) update(pending []pendingRequest, latestHead uint64, requestTimeout time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	entries := make(map[string]*backlogEntry, len(pending))
+	for _, req := range pending {
+		reqID := req.req.RequestID().String()
+		e, ok := b.entries[reqID]
+		if !ok {
+			e = &backlogEntry{status: vrfcommon.PendingRequestStatus{
+				RequestID:          req.req.RequestID(),
+				SubID:              req.req.SubID(),
+				Sender:             req.req.Sender(),
+				RequestTxHash:      req.req.Raw().TxHash,
+				RequestBlockNumber: req.req.Raw().BlockNumber,
+				CallbackGasLimit:   req.req.CallbackGasLimit(),
+				NativePayment:      req.req.NativePayment(),
+				SkipReason:         vrfcommon.SkipReasonQueued,
+				UpdatedAt:          now,
+			}}
+		}
+		e.req = req
+		e.status.ConfirmedAtBlock = req.confirmedAtBlock
+		switch {
+		case req.confirmedAtBlock > latestHead:
+			e.setReason(vrfcommon.SkipReasonAwaitingConfirmations, "", now)
+		case e.status.SkipReason == vrfcommon.SkipReasonAwaitingConfirmations, e.status.SkipReason == vrfcommon.SkipReasonInflight:
+			// confirmed, or the inflight cache no longer holds the request
+			e.setReason(vrfcommon.SkipReasonQueued, "", now)
+		}
+		entries[reqID] = e
+	}
+	for reqID, e := range b.entries {
+		if _, ok := entries[reqID]; ok || e.status.SkipReason != vrfcommon.SkipReasonInflight {
+			continue
+		}
+		if now.Sub(e.req.utcTimestamp) < requestTimeout {
+			entries[reqID] = e
+		}
+	}
+	b.entries = entries
+}
+
+func (e *backlogEntry) setReason(reason vrfcommon.SkipReason, detail string, now time.Time) {
+	e.status.SkipReason = reason
+	e.status.SkipDetail = detail
+	e.status.UpdatedAt = now
+}
+
+// fulfilled removes a request that was fulfilled onchain
+func (b *backlog) fulfilled(reqID *big.Int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.entries, reqID.String())
+}
+
+// skip records why the given requests were not fulfilled
+func (b *backlog) skip(reqs []pendingRequest, reason vrfcommon.SkipReason, detail string) {
+	b.skipIDs(requestIDs(reqs), reason, detail)
+}
+
+// skipIDs records why the requests with the given IDs were not fulfilled
+func (b *backlog) skipIDs(reqIDs []*big.Int, reason vrfcommon.SkipReason, detail string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	for _, reqID := range reqIDs {
+		if e, ok := b.entries[reqID.String()]; ok {
+			e.setReason(reason, detail, now)
+		}
+	}
+}
+
+// skipResults records why the requests of the given pipeline results were
+// not fulfilled
+func (b *backlog) skipResults(results []vrfPipelineResult, reason vrfcommon.SkipReason, detail string) {
+	reqs := make([]pendingRequest, len(results))
+	for i, p := range results {
+		reqs[i] = p.req
+	}
+	b.skip(reqs, reason, detail)
+}
+
+// simulated records the estimated cost of a simulated fulfillment
+func (b *backlog) simulated(p vrfPipelineResult, maxGasPrice *assets.Wei) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e, ok := b.entries[p.req.req.RequestID().String()]
+	if !ok {
+		return
+	}
+	now := b.now()
+	e.status.Attempts++
+	e.status.LastTry = now
+	e.status.UpdatedAt = now
+	e.status.MaxGasPrice = maxGasPrice
+	e.status.GasLimit = p.gasLimit
+	// maxFee is only known when the simulation succeeded
+	e.status.MaxFee = p.maxFee
+	if e.status.MaxFee == nil {
+		e.status.MaxFee = p.fundsNeeded
+	}
+}
+
+// inflight records the fulfillment transaction of the given requests
+func (b *backlog) inflight(reqIDs []*big.Int, txID int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	for _, reqID := range reqIDs {
+		if e, ok := b.entries[reqID.String()]; ok {
+			e.setReason(vrfcommon.SkipReasonInflight, "", now)
+			e.status.TxID = &txID
+		}
+	}
+}
+
+func (b *backlog) get(reqID *big.Int) (pendingRequest, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	e, ok := b.entries[reqID.String()]
+	if !ok {
+		return pendingRequest{}, false
+	}
+	return e.req, true
+}
+
+func (b *backlog) statuses() []vrfcommon.PendingRequestStatus {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	statuses := make([]vrfcommon.PendingRequestStatus, 0, len(b.entries))
+	for _, e := range b.entries {
+		statuses = append(statuses, e.status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].RequestBlockNumber != statuses[j].RequestBlockNumber {
+			return statuses[i].RequestBlockNumber < statuses[j].RequestBlockNumber
+		}
+		return statuses[i].RequestID.Cmp(statuses[j].RequestID) < 0
+	})
+	return statuses
+}
+
+// PendingRequests satisfies the vrfcommon.BacklogInspector interface.
+func (lsn *listenerV2) PendingRequests() []vrfcommon.PendingRequestStatus {
+	return lsn.backlog.statuses()
+}
+
+// ForceFulfill satisfies the vrfcommon.BacklogInspector interface. The
+// fulfillment is simulated first, and not enqueued if the simulation fails,
+// which includes the coordinator reverting on an underfunded subscription.
+// An overridden max gas price also caps the transaction's gas price.
+// The request is added to the inflight cache so that the log listener does
+// not fulfill it again.
+func (lsn *listenerV2) ForceFulfill(ctx context.Context, requestID *big.Int, opts vrfcommon.ForceFulfillOptions) (res vrfcommon.ForceFulfillResult, err error) {
+	req, ok := lsn.backlog.get(requestID)
+	if !ok {
+		return res, vrfcommon.ErrRequestNotPending
+	}
+
+	fromAddresses := lsn.fromAddresses()
+	if opts.FromAddress != nil {
+		if !slices.Contains(fromAddresses, *opts.FromAddress) {
+			return res, fmt.Errorf("address %s is not a sending key of the job", opts.FromAddress.Hex())
+		}
+		res.FromAddress = *opts.FromAddress
+	} else {
+		res.FromAddress, err = lsn.gethks.GetRoundRobinAddress(ctx, lsn.chainID, fromAddresses...)
+		if err != nil {
+			return res, errors.Wrap(err, "couldn't get next from address")
+		}
+	}
+
+	maxGasPriceWei := lsn.feeCfg.PriceMaxKey(res.FromAddress)
+	if opts.MaxGasPrice != nil {
+		maxGasPriceWei = opts.MaxGasPrice
+	}
+
+	l := lsn.l.With("reqID", requestID.String(), "fromAddress", res.FromAddress, "maxGasPrice", maxGasPriceWei.String())
+	l.Infow("Force-fulfilling request")
+	p := lsn.simulateFulfillment(ctx, maxGasPriceWei, req, l)
+	lsn.backlog.simulated(p, maxGasPriceWei)
+	if p.err != nil {
+		lsn.backlog.skip([]pendingRequest{req}, vrfcommon.SkipReasonSimulationFailed, p.err.Error())
+		return res, errors.Wrap(p.err, "fulfillment simulation failed")
+	}
+
+	res.GasLimit = p.gasLimit
+	if opts.GasLimit != 0 {
+		res.GasLimit = opts.GasLimit
+	}
+	res.MaxFee = p.maxFee
+
+	tx, err := lsn.enqueueFulfillment(ctx, p, res.FromAddress, res.GasLimit, opts.MaxGasPrice)
+	if err != nil {
+		lsn.backlog.skip([]pendingRequest{req}, vrfcommon.SkipReasonEnqueueFailed, err.Error())
+		return res, errors.Wrap(err, "error enqueuing fulfillment")
+	}
+	lsn.inflightCache.Add(req.req.Raw())
+	lsn.backlog.inflight([]*big.Int{requestID}, tx.ID)
+	vrfcommon.IncProcessedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, lsn.coordinator.Version())
+	l.Infow("Enqueued force-fulfillment", "ethTxID", tx.ID, "gasLimit", res.GasLimit)
+	res.TxID = tx.ID
+	return res, nil
+}
+
+func requestIDs(reqs []pendingRequest) []*big.Int {
+	ids := make([]*big.Int, len(reqs))
+	for i, req := range reqs {
+		ids[i] = req.req.RequestID()
+	}
+	return ids
+}
+
+// balanceDetail describes an insufficient balance for the backlog
+func balanceDetail(balance, needed *big.Int) string {
+	return fmt.Sprintf("balance %s is less than %s needed", balance, needed)
+}
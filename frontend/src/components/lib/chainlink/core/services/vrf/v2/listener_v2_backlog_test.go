@@ -0,0 +1,131 @@
+package v2
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+func newBacklogTestRequest(reqID int64, blockNumber uint64, confirmedAt uint64, ts time.Time) pendingRequest {
+	return pendingRequest{
+		confirmedAtBlock: confirmedAt,
+		utcTimestamp:     ts,
+		req: NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+			RequestId:        big.NewInt(reqID),
+			SubId:            1,
+			CallbackGasLimit: 100_000,
+			Raw:              types.Log{BlockNumber: blockNumber},
+		}),
+	}
+}
+
+func TestBacklog_Update(t *testing.T) {
+	now := time.Now()
+	b := newBacklog()
+	b.now = func() time.Time { return now }
+
+	req1 := newBacklogTestRequest(1, 10, 13, now)
+	req2 := newBacklogTestRequest(2, 8, 20, now)
+	b.update([]pendingRequest{req1, req2}, 15, time.Hour)
+
+	statuses := b.statuses()
+	require.Len(t, statuses, 2)
+	// oldest first
+	assert.Equal(t, int64(2), statuses[0].RequestID.Int64())
+	assert.Equal(t, vrfcommon.SkipReasonAwaitingConfirmations, statuses[0].SkipReason)
+	assert.Equal(t, uint64(20), statuses[0].ConfirmedAtBlock)
+	assert.Equal(t, int64(1), statuses[1].RequestID.Int64())
+	assert.Equal(t, vrfcommon.SkipReasonQueued, statuses[1].SkipReason)
+	assert.Equal(t, uint32(100_000), statuses[1].CallbackGasLimit)
+
+	// once confirmed, the request is queued
+	b.update([]pendingRequest{req1, req2}, 20, time.Hour)
+	for _, s := range b.statuses() {
+		assert.Equal(t, vrfcommon.SkipReasonQueued, s.SkipReason)
+	}
+
+	// requests missing from the poll are dropped
+	b.update([]pendingRequest{req2}, 20, time.Hour)
+	statuses = b.statuses()
+	require.Len(t, statuses, 1)
+	assert.Equal(t, int64(2), statuses[0].RequestID.Int64())
+
+	b.fulfilled(big.NewInt(2))
+	assert.Empty(t, b.statuses())
+}
+
+func TestBacklog_SkipReasons(t *testing.T) {
+	now := time.Now()
+	b := newBacklog()
+	b.now = func() time.Time { return now }
+
+	req := newBacklogTestRequest(1, 10, 10, now)
+	b.update([]pendingRequest{req}, 15, time.Hour)
+
+	b.simulated(vrfPipelineResult{req: req, gasLimit: 150_000, fundsNeeded: big.NewInt(1000)}, assets.GWei(10))
+	b.skip([]pendingRequest{req}, vrfcommon.SkipReasonInsufficientBalance, balanceDetail(big.NewInt(10), big.NewInt(1000)))
+
+	statuses := b.statuses()
+	require.Len(t, statuses, 1)
+	s := statuses[0]
+	assert.Equal(t, vrfcommon.SkipReasonInsufficientBalance, s.SkipReason)
+	assert.Equal(t, "balance 10 is less than 1000 needed", s.SkipDetail)
+	assert.Equal(t, 1, s.Attempts)
+	assert.Equal(t, now, s.LastTry)
+	assert.Equal(t, uint64(150_000), s.GasLimit)
+	assert.Equal(t, assets.GWei(10), s.MaxGasPrice)
+	assert.Equal(t, big.NewInt(1000), s.MaxFee)
+
+	// the skip reason is kept across polls
+	b.update([]pendingRequest{req}, 16, time.Hour)
+	assert.Equal(t, vrfcommon.SkipReasonInsufficientBalance, b.statuses()[0].SkipReason)
+
+	b.skipResults([]vrfPipelineResult{{req: req, err: errors.New("reverted")}}, vrfcommon.SkipReasonSimulationFailed, "reverted")
+	assert.Equal(t, vrfcommon.SkipReasonSimulationFailed, b.statuses()[0].SkipReason)
+	assert.Equal(t, "reverted", b.statuses()[0].SkipDetail)
+}
+
+func TestBacklog_Inflight(t *testing.T) {
+	now := time.Now()
+	b := newBacklog()
+	b.now = func() time.Time { return now }
+
+	req := newBacklogTestRequest(1, 10, 10, now)
+	b.update([]pendingRequest{req}, 15, time.Hour)
+	b.inflight([]*big.Int{big.NewInt(1)}, 42)
+
+	statuses := b.statuses()
+	require.Len(t, statuses, 1)
+	assert.Equal(t, vrfcommon.SkipReasonInflight, statuses[0].SkipReason)
+	require.NotNil(t, statuses[0].TxID)
+	assert.Equal(t, int64(42), *statuses[0].TxID)
+
+	// inflight requests are no longer returned by the poll but are kept
+	// until they time out
+	b.update(nil, 16, time.Hour)
+	require.Len(t, b.statuses(), 1)
+	_, ok := b.get(big.NewInt(1))
+	assert.True(t, ok)
+
+	now = now.Add(2 * time.Hour)
+	b.update(nil, 17, time.Hour)
+	assert.Empty(t, b.statuses())
+	_, ok = b.get(big.NewInt(1))
+	assert.False(t, ok)
+}
+
+func TestListenerV2_ForceFulfill_NotPending(t *testing.T) {
+	lsn := &listenerV2{backlog: newBacklog()}
+	_, err := lsn.ForceFulfill(testutils.Context(t), big.NewInt(1), vrfcommon.ForceFulfillOptions{})
+	require.ErrorIs(t, err, vrfcommon.ErrRequestNotPending)
+}
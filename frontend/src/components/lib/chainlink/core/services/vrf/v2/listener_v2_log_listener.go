@@ -83,6 +83,8 @@ func (lsn *listenerV2) runLogListener(
 				continue
 			}
 
+			lsn.backlog.update(pending, lsn.getLatestHead(), lsn.job.VRFSpec.RequestTimeout)
+
 			// process pending requests and insert any fulfillments into the inflight cache
 			lsn.processPendingVRFRequests(ctx, pending)
 
@@ -375,6 +377,7 @@ func (lsn *listenerV2) handleFulfilled(fulfilled map[string]RandomWordsFulfilled
 			continue
 		}
 		lsn.l.Debugw("Received fulfilled log", "reqID", v.RequestID(), "success", v.Success())
+		lsn.backlog.fulfilled(v.RequestID())
 		lsn.respCount[v.RequestID().String()]++
 		lsn.blockNumberToReqID.Insert(fulfilledReqV2{
 			blockNumber: v.Raw().BlockNumber,
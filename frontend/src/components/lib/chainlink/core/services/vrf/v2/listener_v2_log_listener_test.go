@@ -146,6 +146,7 @@ func setupVRFLogPollerListenerTH(t *testing.T) *vrfLogPollerListenerTH {
 		coordinator:   coordinator,
 		inflightCache: vrfcommon.NewInflightCache(10),
 		chStop:        make(chan struct{}),
+		backlog:       newBacklog(),
 	}
 
 	// Filter registration is idempotent, so we can just call it every time
@@ -158,6 +158,7 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 			if !strings.Contains(err.Error(), "execution reverted") {
 				// Most likely this is an RPC error, so we re-try later.
 				l.Errorw("Unable to read subscription balance", "err", err)
+				lsn.backlog.skip(reqs, vrfcommon.SkipReasonSubscriptionUnavailable, err.Error())
 				return
 			}
 			// "execution reverted" indicates that the subscription no longer exists.
@@ -373,7 +374,8 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 		pipelines := lsn.runPipelines(ctx, l, maxGasPriceWei, unfulfilled)
 		batches := newBatchFulfillments(batchMaxGas, lsn.coordinator.Version())
 		outOfBalance := false
-		for _, p := range pipelines {
+		for i, p := range pipelines {
+			lsn.backlog.simulated(p, maxGasPriceWei)
 			ll := l.With("reqID", p.req.req.RequestID().String(),
 				"txHash", p.req.req.Raw().TxHash,
 				"maxGasPrice", maxGasPriceWei.String(),
@@ -399,6 +401,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 					// Running the blockhash store feeder in backwards mode will be required to
 					// resolve this.
 					ll.Criticalw("Pipeline error", "err", p.err)
+					lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonBlockhashNotInStore, p.err.Error())
 				} else if errors.Is(p.err, errProofVerificationFailed{}) {
 					// This occurs when the proof reverts in the simulation
 					// This is almost always (if not always) due to a proof generated with an out-of-date
@@ -406,6 +409,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 					// we can simply mark as processed and move on, since we will eventually
 					// process the request with the right blockhash
 					ll.Infow("proof reverted in simulation, likely stale blockhash")
+					lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonStaleBlockhash, p.err.Error())
 					processed[p.req.req.RequestID().String()] = struct{}{}
 				} else {
 					ll.Errorw("Pipeline error", "err", p.err)
@@ -414,9 +418,11 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 						etx, err := lsn.enqueueForceFulfillment(ctx, p, fromAddress)
 						if err != nil {
 							ll.Errorw("Error enqueuing force-fulfillment, re-queueing request", "err", err)
+							lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonEnqueueFailed, err.Error())
 							continue
 						}
 						ll.Infow("Successfully enqueued force-fulfillment", "ethTxID", etx.ID)
+						lsn.backlog.inflight([]*big.Int{p.req.req.RequestID()}, etx.ID)
 						processed[p.req.req.RequestID().String()] = struct{}{}
 
 						// Need to put a continue here, otherwise the next if statement will be hit
@@ -428,6 +434,8 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 
 					if startBalanceNoReserved.Cmp(p.fundsNeeded) < 0 && errors.Is(p.err, errPossiblyInsufficientFunds{}) {
 						ll.Infow("Insufficient balance to fulfill a request based on estimate, breaking", "err", p.err)
+						lsn.backlog.skipResults(pipelines[i:], vrfcommon.SkipReasonInsufficientBalance, balanceDetail(startBalanceNoReserved, p.fundsNeeded))
+						lsn.backlog.skip(ready[chunkEnd:], vrfcommon.SkipReasonInsufficientBalance, balanceDetail(startBalanceNoReserved, p.fundsNeeded))
 						outOfBalance = true
 
 						// break out of this inner loop to process the currently constructed batch
@@ -446,6 +454,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 						processed[p.req.req.RequestID().String()] = struct{}{}
 						continue
 					}
+					lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonSimulationFailed, p.err.Error())
 				}
 				continue
 			}
@@ -455,6 +464,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 				// Break out of the loop now and process what we are able to process
 				// in the constructed batches.
 				ll.Infow("Insufficient balance to fulfill a request, breaking")
+				lsn.backlog.skipResults(pipelines[i:], vrfcommon.SkipReasonInsufficientBalance, balanceDetail(startBalanceNoReserved, p.maxFee))
 				break
 			}
 
@@ -702,7 +712,8 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 		maxGasPriceWei := lsn.feeCfg.PriceMaxKey(fromAddresses[0])
 		observeRequestSimDuration(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, lsn.coordinator.Version(), unfulfilled)
 		pipelines := lsn.runPipelines(ctx, l, maxGasPriceWei, unfulfilled)
-		for _, p := range pipelines {
+		for i, p := range pipelines {
+			lsn.backlog.simulated(p, maxGasPriceWei)
 			ll := l.With("reqID", p.req.req.RequestID().String(),
 				"txHash", p.req.req.Raw().TxHash,
 				"maxGasPrice", maxGasPriceWei.String(),
@@ -727,6 +738,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 					// Running the blockhash store feeder in backwards mode will be required to
 					// resolve this.
 					ll.Criticalw("Pipeline error", "err", p.err)
+					lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonBlockhashNotInStore, p.err.Error())
 				} else if errors.Is(p.err, errProofVerificationFailed{}) {
 					// This occurs when the proof reverts in the simulation
 					// This is almost always (if not always) due to a proof generated with an out-of-date
@@ -734,6 +746,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 					// we can simply mark as processed and move on, since we will eventually
 					// process the request with the right blockhash
 					ll.Infow("proof reverted in simulation, likely stale blockhash")
+					lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonStaleBlockhash, p.err.Error())
 					processed[p.req.req.RequestID().String()] = struct{}{}
 				} else {
 					ll.Errorw("Pipeline error", "err", p.err)
@@ -743,9 +756,11 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 						etx, err2 := lsn.enqueueForceFulfillment(ctx, p, fromAddress)
 						if err2 != nil {
 							ll.Errorw("Error enqueuing force-fulfillment, re-queueing request", "err", err2)
+							lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonEnqueueFailed, err2.Error())
 							continue
 						}
 						ll.Infow("Enqueued force-fulfillment", "ethTxID", etx.ID)
+						lsn.backlog.inflight([]*big.Int{p.req.req.RequestID()}, etx.ID)
 						processed[p.req.req.RequestID().String()] = struct{}{}
 
 						// Need to put a continue here, otherwise the next if statement will be hit
@@ -757,6 +772,8 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 
 					if startBalanceNoReserved.Cmp(p.fundsNeeded) < 0 {
 						ll.Infow("Insufficient balance to fulfill a request based on estimate, returning", "err", p.err)
+						lsn.backlog.skipResults(pipelines[i:], vrfcommon.SkipReasonInsufficientBalance, balanceDetail(startBalanceNoReserved, p.fundsNeeded))
+						lsn.backlog.skip(ready[chunkEnd:], vrfcommon.SkipReasonInsufficientBalance, balanceDetail(startBalanceNoReserved, p.fundsNeeded))
 						return processed
 					}
 
@@ -772,6 +789,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 						processed[p.req.req.RequestID().String()] = struct{}{}
 						continue
 					}
+					lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonSimulationFailed, p.err.Error())
 				}
 				continue
 			}
@@ -779,62 +797,20 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 			if startBalanceNoReserved.Cmp(p.maxFee) < 0 {
 				// Insufficient funds, have to wait for a user top up. Leave it unprocessed for now
 				ll.Infow("Insufficient balance to fulfill a request, returning")
+				lsn.backlog.skipResults(pipelines[i:], vrfcommon.SkipReasonInsufficientBalance, balanceDetail(startBalanceNoReserved, p.maxFee))
+				lsn.backlog.skip(ready[chunkEnd:], vrfcommon.SkipReasonInsufficientBalance, balanceDetail(startBalanceNoReserved, p.maxFee))
 				return processed
 			}
 
 			ll.Infow("Enqueuing fulfillment")
-			var transaction txmgr.Tx
-			err = sqlutil.TransactDataSource(ctx, lsn.ds, nil, func(tx sqlutil.DataSource) error {
-				if err = lsn.pipelineRunner.InsertFinishedRun(ctx, tx, p.run, true); err != nil {
-					return err
-				}
-
-				var maxLink, maxEth *string
-				tmp := p.maxFee.String()
-				if p.reqCommitment.NativePayment() {
-					maxEth = &tmp
-				} else {
-					maxLink = &tmp
-				}
-				var (
-					txMetaSubID       *uint64
-					txMetaGlobalSubID *string
-				)
-				if lsn.coordinator.Version() == vrfcommon.V2Plus {
-					txMetaGlobalSubID = ptr(p.req.req.SubID().String())
-				} else if lsn.coordinator.Version() == vrfcommon.V2 {
-					txMetaSubID = ptr(p.req.req.SubID().Uint64())
-				}
-				requestID := common.BytesToHash(p.req.req.RequestID().Bytes())
-				coordinatorAddress := lsn.coordinator.Address()
-				requestTxHash := p.req.req.Raw().TxHash
-				transaction, err = lsn.chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
-					FromAddress:    fromAddress,
-					ToAddress:      lsn.coordinator.Address(),
-					EncodedPayload: hexutil.MustDecode(p.payload),
-					FeeLimit:       p.gasLimit,
-					Meta: &txmgr.TxMeta{
-						RequestID:     &requestID,
-						MaxLink:       maxLink,
-						MaxEth:        maxEth,
-						SubID:         txMetaSubID,
-						GlobalSubID:   txMetaGlobalSubID,
-						RequestTxHash: &requestTxHash,
-					},
-					Strategy: txmgrcommon.NewSendEveryStrategy(),
-					Checker: txmgr.TransmitCheckerSpec{
-						CheckerType:           lsn.transmitCheckerType(),
-						VRFCoordinatorAddress: &coordinatorAddress,
-						VRFRequestBlockNumber: new(big.Int).SetUint64(p.req.req.Raw().BlockNumber),
-					},
-				})
-				return err
-			})
+			transaction, err := lsn.enqueueFulfillment(ctx, p, fromAddress, p.gasLimit, nil)
 			if err != nil {
 				ll.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
+				lsn.backlog.skip([]pendingRequest{p.req}, vrfcommon.SkipReasonEnqueueFailed, err.Error())
 				continue
 			}
 			ll.Infow("Enqueued fulfillment", "ethTxID", transaction.GetID())
+			lsn.backlog.inflight([]*big.Int{p.req.req.RequestID()}, transaction.ID)
 
 			// If we successfully enqueued for the txm, subtract that balance
 			// And loop to attempt to enqueue another fulfillment
@@ -847,6 +823,69 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 	return
 }
 
+// enqueueFulfillment stores the pipeline run of a simulated fulfillment and
+// creates its transaction. A non-nil maxGasPrice caps the gas price of the
+// transaction below the sending key's maximum.
+func (lsn *listenerV2) enqueueFulfillment(
+	ctx context.Context,
+	p vrfPipelineResult,
+	fromAddress common.Address,
+	gasLimit uint64,
+	maxGasPrice *assets.Wei,
+) (transaction txmgr.Tx, err error) {
+	strategy := txmgrcommon.NewSendEveryStrategy()
+	if maxGasPrice != nil {
+		strategy = txmgr.NewMaxGasPriceStrategy(strategy, maxGasPrice)
+	}
+	err = sqlutil.TransactDataSource(ctx, lsn.ds, nil, func(tx sqlutil.DataSource) error {
+		if err = lsn.pipelineRunner.InsertFinishedRun(ctx, tx, p.run, true); err != nil {
+			return err
+		}
+
+		var maxLink, maxEth *string
+		tmp := p.maxFee.String()
+		if p.reqCommitment.NativePayment() {
+			maxEth = &tmp
+		} else {
+			maxLink = &tmp
+		}
+		var (
+			txMetaSubID       *uint64
+			txMetaGlobalSubID *string
+		)
+		if lsn.coordinator.Version() == vrfcommon.V2Plus {
+			txMetaGlobalSubID = ptr(p.req.req.SubID().String())
+		} else if lsn.coordinator.Version() == vrfcommon.V2 {
+			txMetaSubID = ptr(p.req.req.SubID().Uint64())
+		}
+		requestID := common.BytesToHash(p.req.req.RequestID().Bytes())
+		coordinatorAddress := lsn.coordinator.Address()
+		requestTxHash := p.req.req.Raw().TxHash
+		transaction, err = lsn.chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      lsn.coordinator.Address(),
+			EncodedPayload: hexutil.MustDecode(p.payload),
+			FeeLimit:       gasLimit,
+			Meta: &txmgr.TxMeta{
+				RequestID:     &requestID,
+				MaxLink:       maxLink,
+				MaxEth:        maxEth,
+				SubID:         txMetaSubID,
+				GlobalSubID:   txMetaGlobalSubID,
+				RequestTxHash: &requestTxHash,
+			},
+			Strategy: strategy,
+			Checker: txmgr.TransmitCheckerSpec{
+				CheckerType:           lsn.transmitCheckerType(),
+				VRFCoordinatorAddress: &coordinatorAddress,
+				VRFRequestBlockNumber: new(big.Int).SetUint64(p.req.req.Raw().BlockNumber),
+			},
+		})
+		return err
+	})
+	return transaction, err
+}
+
 func (lsn *listenerV2) transmitCheckerType() txmgrtypes.TransmitCheckerType {
 	if lsn.coordinator.Version() == vrfcommon.V2 {
 		return txmgr.TransmitCheckerTypeVRFV2
@@ -259,9 +259,11 @@ func (lsn *listenerV2) processBatch(
 	})
 	if err != nil {
 		ll.Errorw("Error enqueuing batch fulfillments, requeuing requests", "err", err)
+		lsn.backlog.skipIDs(batch.reqIDs, vrfcommon.SkipReasonBatchFailed, err.Error())
 		return
 	}
 	ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.GetID())
+	lsn.backlog.inflight(batch.reqIDs, ethTX.ID)
 
 	// mark requests as processed since the fulfillment has been successfully enqueued
 	// to the txm.
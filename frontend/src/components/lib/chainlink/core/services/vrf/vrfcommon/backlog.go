@@ -0,0 +1,179 @@
+package vrfcommon
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+)
+
+// SkipReason describes why a pending VRF request has not been fulfilled yet
+type SkipReason string
+
+const (
+	// SkipReasonAwaitingConfirmations means the request does not have enough confirmations yet
+	SkipReasonAwaitingConfirmations SkipReason = "awaiting_confirmations"
+	// SkipReasonQueued means the request is ready and waiting to be processed
+	SkipReasonQueued SkipReason = "queued"
+	// SkipReasonSubscriptionUnavailable means the subscription could not be read
+	SkipReasonSubscriptionUnavailable SkipReason = "subscription_unavailable"
+	// SkipReasonInsufficientBalance means the subscription balance does not cover the
+	// estimated cost of the request at the maximum gas price
+	SkipReasonInsufficientBalance SkipReason = "insufficient_balance"
+	// SkipReasonBlockhashNotInStore means the request is too old to be fulfilled
+	// without its blockhash being stored in the blockhash store
+	SkipReasonBlockhashNotInStore SkipReason = "blockhash_not_in_store"
+	// SkipReasonStaleBlockhash means the proof failed verification in simulation,
+	// usually because it was generated with an out of date blockhash
+	SkipReasonStaleBlockhash SkipReason = "stale_blockhash"
+	// SkipReasonSimulationFailed means the fulfillment reverted in simulation
+	SkipReasonSimulationFailed SkipReason = "simulation_failed"
+	// SkipReasonEnqueueFailed means the fulfillment transaction could not be created
+	SkipReasonEnqueueFailed SkipReason = "enqueue_failed"
+	// SkipReasonBatchFailed means the batch fulfillment the request was part of
+	// could not be created
+	SkipReasonBatchFailed SkipReason = "batch_failed"
+	// SkipReasonInflight means a fulfillment transaction was created and the
+	// request is waiting for it to be confirmed. Requests that stay inflight
+	// are usually held up by the gas price exceeding the key's maximum.
+	SkipReasonInflight SkipReason = "inflight"
+)
+
+// PendingRequestStatus is the status of a VRF request that has not been
+// fulfilled yet
+type PendingRequestStatus struct {
+	RequestID          *big.Int
+	SubID              *big.Int
+	Sender             common.Address
+	RequestTxHash      common.Hash
+	RequestBlockNumber uint64
+	ConfirmedAtBlock   uint64
+	CallbackGasLimit   uint32
+	NativePayment      bool
+	Attempts           int
+	LastTry            time.Time
+	SkipReason         SkipReason
+	SkipDetail         string
+	// MaxFee is the estimated cost of the fulfillment in juels or wei at
+	// MaxGasPrice, if the request has been simulated
+	MaxFee      *big.Int
+	GasLimit    uint64
+	MaxGasPrice *assets.Wei
+	// TxID is the ID of the fulfillment transaction, if one was created
+	TxID      *int64
+	UpdatedAt time.Time
+}
+
+// ForceFulfillOptions are the gas settings of a manual fulfillment
+type ForceFulfillOptions struct {
+	// GasLimit overrides the gas limit computed from the request's callback
+	// gas limit, if non-zero
+	GasLimit uint64
+	// MaxGasPrice overrides the maximum gas price of the sending key used to
+	// simulate the fulfillment and estimate its cost, if non-nil. The
+	// transaction manager also caps the fulfillment's gas price, including
+	// bumps, at this value.
+	MaxGasPrice *assets.Wei
+	// FromAddress selects one of the job's sending keys, instead of picking
+	// one round robin
+	FromAddress *common.Address
+}
+
+// ForceFulfillResult is the outcome of a manual fulfillment
+type ForceFulfillResult struct {
+	TxID        int64
+	FromAddress common.Address
+	GasLimit    uint64
+	MaxFee      *big.Int
+}
+
+// BacklogInspector exposes the pending requests of a running VRF job
+type BacklogInspector interface {
+	// PendingRequests returns the requests of the job that have not been
+	// fulfilled yet, oldest first
+	PendingRequests() []PendingRequestStatus
+	// ForceFulfill enqueues a fulfillment of the pending request with the
+	// given ID, skipping the node's own subscription balance check. The
+	// coordinator still charges the subscription, so a fulfillment it cannot
+	// pay for fails simulation and is not enqueued.
+	ForceFulfill(ctx context.Context, requestID *big.Int, opts ForceFulfillOptions) (ForceFulfillResult, error)
+}
+
+var (
+	// ErrJobNotRunning is returned for jobs without a running VRF listener
+	ErrJobNotRunning = errors.New("no running VRF listener for job")
+	// ErrRequestNotPending is returned when force-fulfilling a request that
+	// is not in the backlog
+	ErrRequestNotPending = errors.New("request is not pending")
+)
+
+// BacklogRegistry holds the backlog inspectors of the running VRF jobs
+type BacklogRegistry struct {
+	mu         sync.RWMutex
+	inspectors map[int32]BacklogInspector
+}
+
+func NewBacklogRegistry() *BacklogRegistry {
+	return &BacklogRegistry{inspectors: make(map[int32]BacklogInspector)}
+}
+
+// Register registers the inspector of a running job
+func (r *BacklogRegistry) Register(jobID int32, inspector BacklogInspector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.inspectors[jobID] = inspector
+}
+
+// Unregister removes the inspector of a stopped job
+func (r *BacklogRegistry) Unregister(jobID int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.inspectors, jobID)
+}
+
+// Get returns the inspector of a running job
+func (r *BacklogRegistry) Get(jobID int32) (BacklogInspector, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	inspector, ok := r.inspectors[jobID]
+	if !ok {
+		return nil, ErrJobNotRunning
+	}
+	return inspector, nil
+}
+
+// JobIDs returns the IDs of the running jobs, in ascending order
+func (r *BacklogRegistry) JobIDs() []int32 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]int32, 0, len(r.inspectors))
+	for id := range r.inspectors {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// BacklogRegistration is a job service that registers a backlog inspector
+// while the job is running
+type BacklogRegistration struct {
+	Registry  *BacklogRegistry
+	JobID     int32
+	Inspector BacklogInspector
+}
+
+func (b *BacklogRegistration) Start(context.Context) error {
+	b.Registry.Register(b.JobID, b.Inspector)
+	return nil
+}
+
+func (b *BacklogRegistration) Close() error {
+	b.Registry.Unregister(b.JobID)
+	return nil
+}
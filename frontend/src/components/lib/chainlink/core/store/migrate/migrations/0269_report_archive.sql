@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE llo_report_archive (
+    id BIGSERIAL PRIMARY KEY,
+    don_id BIGINT NOT NULL,
+    channel_id BIGINT,
+    config_digest BYTEA NOT NULL,
+    seq_nr BIGINT NOT NULL,
+    report BYTEA NOT NULL,
+    lifecycle_stage TEXT NOT NULL,
+    report_format BIGINT NOT NULL,
+    signatures BYTEA[] NOT NULL,
+    signers SMALLINT[] NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_llo_report_archive_don_id_id ON llo_report_archive (don_id, id);
+
+CREATE TABLE mercury_report_archive (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INT NOT NULL,
+    feed_id BYTEA NOT NULL,
+    config_digest BYTEA NOT NULL,
+    epoch BIGINT NOT NULL,
+    round INT NOT NULL,
+    extra_hash BYTEA NOT NULL,
+    report BYTEA NOT NULL,
+    signatures BYTEA[] NOT NULL,
+    signers SMALLINT[] NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_mercury_report_archive_job_id_id ON mercury_report_archive (job_id, id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE mercury_report_archive;
+DROP TABLE llo_report_archive;
+-- +goose StatementEnd
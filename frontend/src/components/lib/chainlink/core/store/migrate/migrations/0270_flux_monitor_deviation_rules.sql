@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE flux_monitor_specs
+    ADD COLUMN threshold_up real NOT NULL DEFAULT 0,
+    ADD COLUMN threshold_down real NOT NULL DEFAULT 0,
+    ADD COLUMN deviation_mode text NOT NULL DEFAULT '',
+    ADD COLUMN volatility_window bigint NOT NULL DEFAULT 0,
+    ADD COLUMN volatility_multiplier real NOT NULL DEFAULT 0,
+    ADD COLUMN submission_budget bigint NOT NULL DEFAULT 0,
+    ADD COLUMN submission_budget_period bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE flux_monitor_specs
+    DROP COLUMN threshold_up,
+    DROP COLUMN threshold_down,
+    DROP COLUMN deviation_mode,
+    DROP COLUMN volatility_window,
+    DROP COLUMN volatility_multiplier,
+    DROP COLUMN submission_budget,
+    DROP COLUMN submission_budget_period;
@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE keeper_specs ADD COLUMN dry_run boolean NOT NULL DEFAULT FALSE;
+
+CREATE TABLE keeper_dry_run_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    upkeep_id NUMERIC(78, 0) NOT NULL,
+    contract_address bytea NOT NULL,
+    from_address bytea NOT NULL,
+    block_number BIGINT NOT NULL,
+    calldata bytea NOT NULL,
+    gas_limit BIGINT NOT NULL,
+    gas_estimate BIGINT,
+    simulation_success boolean NOT NULL,
+    simulation_error text,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_keeper_dry_run_transactions_job_id_created_at ON keeper_dry_run_transactions (job_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE keeper_dry_run_transactions;
+ALTER TABLE keeper_specs DROP COLUMN dry_run;
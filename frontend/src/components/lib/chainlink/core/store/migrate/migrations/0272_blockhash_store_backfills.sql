@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE blockhash_store_backfills (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    batch_bhs_address bytea NOT NULL,
+    from_address bytea NOT NULL,
+    from_block BIGINT NOT NULL,
+    to_block BIGINT NOT NULL,
+    batch_size INTEGER NOT NULL,
+    gas_limit BIGINT NOT NULL,
+    next_block BIGINT NOT NULL,
+    stored_blocks BIGINT NOT NULL DEFAULT 0,
+    skipped_blocks BIGINT NOT NULL DEFAULT 0,
+    state text NOT NULL,
+    error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT chk_blockhash_store_backfills_range CHECK (from_block <= to_block),
+    CONSTRAINT chk_blockhash_store_backfills_batch_size CHECK (batch_size > 0)
+);
+
+CREATE UNIQUE INDEX idx_blockhash_store_backfills_range ON blockhash_store_backfills (evm_chain_id, batch_bhs_address, from_block, to_block);
+
+-- +goose Down
+DROP TABLE blockhash_store_backfills;
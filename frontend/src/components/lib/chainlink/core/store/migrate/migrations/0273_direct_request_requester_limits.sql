@@ -0,0 +1,60 @@
+-- +goose Up
+ALTER TABLE direct_request_specs
+    ADD COLUMN requester_allowlist_address bytea CHECK (requester_allowlist_address IS NULL OR octet_length(requester_allowlist_address) = 20),
+    ADD COLUMN requester_rate_limit bigint NOT NULL DEFAULT 0,
+    ADD COLUMN requester_rate_limit_period bigint NOT NULL DEFAULT 0,
+    ADD COLUMN requester_daily_spend_cap NUMERIC(78, 0) CHECK (requester_daily_spend_cap IS NULL OR requester_daily_spend_cap > 0);
+
+CREATE TABLE direct_request_rejections (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    request_id bytea NOT NULL,
+    requester bytea NOT NULL,
+    payment NUMERIC(78, 0),
+    reason text NOT NULL,
+    detail text NOT NULL DEFAULT '',
+    block_number BIGINT NOT NULL,
+    tx_hash bytea NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_direct_request_rejections_job_id_created_at ON direct_request_rejections (job_id, created_at DESC);
+
+CREATE TABLE direct_request_requester_spend (
+    job_id INTEGER NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    requester bytea NOT NULL,
+    day date NOT NULL,
+    spent NUMERIC(78, 0) NOT NULL,
+    PRIMARY KEY (job_id, requester, day)
+);
+
+-- the estimated callback cost of an accepted request, until it is replaced by
+-- the cost of the mined transaction
+CREATE TABLE direct_request_spend_reservations (
+    job_id INTEGER NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    request_id bytea NOT NULL,
+    requester bytea NOT NULL,
+    day date NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (job_id, request_id)
+);
+
+CREATE TABLE direct_request_requester_requests (
+    job_id INTEGER NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    requester bytea NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_direct_request_requester_requests ON direct_request_requester_requests (job_id, requester, created_at);
+
+-- +goose Down
+DROP TABLE direct_request_requester_requests;
+DROP TABLE direct_request_spend_reservations;
+DROP TABLE direct_request_requester_spend;
+DROP TABLE direct_request_rejections;
+ALTER TABLE direct_request_specs
+    DROP COLUMN requester_allowlist_address,
+    DROP COLUMN requester_rate_limit,
+    DROP COLUMN requester_rate_limit_period,
+    DROP COLUMN requester_daily_spend_cap;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE feeds_managers ADD COLUMN approval_policies jsonb NOT NULL DEFAULT '[]';
+
+ALTER TABLE job_proposal_specs
+    ADD COLUMN approval_decision text,
+    ADD COLUMN approval_policy text;
+
+-- +goose Down
+ALTER TABLE job_proposal_specs
+    DROP COLUMN approval_decision,
+    DROP COLUMN approval_policy;
+
+ALTER TABLE feeds_managers DROP COLUMN approval_policies;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE evm.tx_bundles (
+    eth_tx_id BIGINT PRIMARY KEY REFERENCES evm.txes (id) ON DELETE CASCADE,
+    bundle_id text NOT NULL,
+    bundle_index INTEGER NOT NULL,
+    bundle_size INTEGER NOT NULL,
+    CONSTRAINT chk_tx_bundles_bundle_index CHECK (bundle_index >= 0 AND bundle_index < bundle_size)
+);
+
+CREATE INDEX idx_tx_bundles_bundle_id ON evm.tx_bundles (bundle_id, bundle_index);
+-- only the later transactions of a bundle wait for the previous one to start
+CREATE INDEX idx_tx_bundles_later_txs ON evm.tx_bundles (eth_tx_id) WHERE bundle_index > 0;
+
+-- +goose Down
+DROP TABLE evm.tx_bundles;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE key_top_ups (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    treasury_address bytea NOT NULL,
+    to_address bytea NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    balance NUMERIC(78, 0) NOT NULL,
+    eth_tx_id BIGINT REFERENCES evm.txes (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL,
+    CONSTRAINT chk_key_top_ups_amount CHECK (amount > 0)
+);
+
+CREATE INDEX idx_key_top_ups_chain_to_address ON key_top_ups (evm_chain_id, to_address, created_at);
+CREATE INDEX idx_key_top_ups_chain_created_at ON key_top_ups (evm_chain_id, created_at);
+
+-- +goose Down
+DROP TABLE key_top_ups;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE llo_report_archive ADD COLUMN feed_id BYTEA;
+
+CREATE INDEX idx_llo_report_archive_don_id_archived_at ON llo_report_archive (don_id, archived_at);
+CREATE INDEX idx_mercury_report_archive_job_id_archived_at ON mercury_report_archive (job_id, archived_at);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_mercury_report_archive_job_id_archived_at;
+DROP INDEX idx_llo_report_archive_don_id_archived_at;
+
+ALTER TABLE llo_report_archive DROP COLUMN feed_id;
+-- +goose StatementEnd
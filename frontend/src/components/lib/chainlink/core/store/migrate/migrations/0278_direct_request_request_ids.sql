@@ -0,0 +1,10 @@
+-- +goose Up
+-- requests are counted once per request ID, so that a log delivered again is
+-- not counted twice against the rate limit of its requester. Requests counted
+-- before this migration have no request ID.
+ALTER TABLE direct_request_requester_requests ADD COLUMN request_id bytea;
+CREATE UNIQUE INDEX idx_direct_request_requester_requests_request_id ON direct_request_requester_requests (job_id, request_id);
+
+-- +goose Down
+DROP INDEX idx_direct_request_requester_requests_request_id;
+ALTER TABLE direct_request_requester_requests DROP COLUMN request_id;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- buckets are pruned once they are full again, and expired request cache
+-- entries once they have expired. Buckets created before this migration are
+-- treated as full once their last update is an hour old.
+ALTER TABLE gateway_rate_limit_buckets ADD COLUMN full_at TIMESTAMPTZ;
+UPDATE gateway_rate_limit_buckets SET full_at = updated_at + INTERVAL '1 hour';
+ALTER TABLE gateway_rate_limit_buckets ALTER COLUMN full_at SET NOT NULL;
+CREATE INDEX idx_gateway_rate_limit_buckets_full_at ON gateway_rate_limit_buckets (full_at);
+CREATE INDEX idx_gateway_request_cache_entries_expires_at ON gateway_request_cache_entries (expires_at);
+
+-- +goose Down
+DROP INDEX idx_gateway_request_cache_entries_expires_at;
+DROP INDEX idx_gateway_rate_limit_buckets_full_at;
+ALTER TABLE gateway_rate_limit_buckets DROP COLUMN full_at;
@@ -0,0 +1,71 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// BHSBackfillsController manages blockhash store backfills.
+type BHSBackfillsController struct {
+	App chainlink.Application
+}
+
+// CreateBHSBackfillRequest is a JSONAPI request for backfilling the
+// blockhashes of a block range.
+type CreateBHSBackfillRequest struct {
+	EVMChainID                 *ubig.Big       `json:"evmChainID"`
+	BatchBlockhashStoreAddress common.Address  `json:"batchBlockhashStoreAddress"`
+	FromBlock                  int64           `json:"fromBlock"`
+	ToBlock                    int64           `json:"toBlock"`
+	FromAddress                *common.Address `json:"fromAddress"`
+	BatchSize                  uint32          `json:"batchSize"`
+	GasLimit                   uint64          `json:"gasLimit"`
+}
+
+// Index lists blockhash store backfills, most recent first.
+// Example:
+//
+//	"GET <application>/bhs_backfills"
+func (bc *BHSBackfillsController) Index(c *gin.Context) {
+	backfills, err := bc.App.BlockhashStoreBackfills().Backfills(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewBHSBackfillResources(backfills), "bhsBackfills")
+}
+
+// Create starts backfilling the blockhashes of a block range, or resumes the
+// backfill of the same range.
+// Example:
+//
+//	"POST <application>/bhs_backfills"
+func (bc *BHSBackfillsController) Create(c *gin.Context) {
+	request := CreateBHSBackfillRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	backfill, err := bc.App.BlockhashStoreBackfills().Backfill(c.Request.Context(), blockheaderfeeder.BackfillRequest{
+		EVMChainID:      request.EVMChainID.ToInt(),
+		BatchBHSAddress: request.BatchBlockhashStoreAddress,
+		FromBlock:       request.FromBlock,
+		ToBlock:         request.ToBlock,
+		FromAddress:     request.FromAddress,
+		BatchSize:       request.BatchSize,
+		GasLimit:        request.GasLimit,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewBHSBackfillResource(backfill), "bhsBackfills", http.StatusAccepted)
+}
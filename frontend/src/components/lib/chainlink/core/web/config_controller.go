@@ -41,6 +41,24 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, ConfigV2Resource{toml}, "config")
 }
 
+// Reload reloads the config and secrets files, applying the changes that do
+// not require a restart
+// Example:
+//
+//	"<application>/config/reload"
+func (cc *ConfigController) Reload(c *gin.Context) {
+	reload, err := cc.App.ReloadConfig(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIResponse(c, ConfigReloadResource{
+		Applied:         reload.Applied,
+		RequiresRestart: reload.RequiresRestart,
+		Notes:           reload.Notes,
+	}, "configReload")
+}
+
 type ConfigV2Resource struct {
 	Config string `json:"config"`
 }
@@ -52,3 +70,18 @@ func (c ConfigV2Resource) GetID() string {
 func (c *ConfigV2Resource) SetID(string) error {
 	return nil
 }
+
+// ConfigReloadResource lists the changed settings found by a config reload
+type ConfigReloadResource struct {
+	Applied         []string `json:"applied"`
+	RequiresRestart []string `json:"requiresRestart"`
+	Notes           []string `json:"notes"`
+}
+
+func (c ConfigReloadResource) GetID() string {
+	return utils.NewBytes32ID()
+}
+
+func (c *ConfigReloadResource) SetID(string) error {
+	return nil
+}
@@ -0,0 +1,54 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const (
+	defaultDirectRequestRejectionsLimit = 100
+	maxDirectRequestRejectionsLimit     = 1000
+)
+
+// DirectRequestRejectionsController lists the oracle requests that Direct
+// Request jobs did not run.
+type DirectRequestRejectionsController struct {
+	App chainlink.Application
+}
+
+// Index lists the most recent rejected requests of a Direct Request job,
+// newest first
+// Example:
+//
+//	"GET <application>/jobs/:ID/direct_request/rejections"
+func (drc *DirectRequestRejectionsController) Index(c *gin.Context) {
+	var jb job.Job
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	limit := defaultDirectRequestRejectionsLimit
+	if s := c.Query("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 || limit > maxDirectRequestRejectionsLimit {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("limit must be between 1 and 1000"))
+			return
+		}
+	}
+
+	orm := directrequest.NewORM(drc.App.GetDB())
+	rejections, err := orm.FindRejections(c.Request.Context(), jb.ID, limit)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewDirectRequestRejectionResources(rejections), "directRequestRejections")
+}
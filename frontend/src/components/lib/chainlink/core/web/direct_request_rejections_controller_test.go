@@ -0,0 +1,70 @@
+package web_test
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestDirectRequestRejectionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	// any job will do, the rejections only reference its ID
+	jb, err := webhook.ValidatedWebhookSpec(ctx, testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{}).Toml(), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+
+	orm := directrequest.NewORM(app.GetDB())
+	requester := testutils.NewAddress()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, orm.CreateRejection(ctx, &directrequest.Rejection{
+			JobID:       jb.ID,
+			RequestID:   common.BigToHash(big.NewInt(int64(i))),
+			Requester:   requester,
+			Payment:     assets.NewLinkFromJuels(100),
+			Reason:      directrequest.RejectionReasonRateLimited,
+			Detail:      "more than 1 requests in 1h0m0s",
+			BlockNumber: int64(i),
+			TxHash:      common.Hash{1},
+		}))
+	}
+
+	client := app.NewHTTPClient(nil)
+
+	t.Run("lists the most recent rejections", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/jobs/" + strconv.Itoa(int(jb.ID)) + "/direct_request/rejections?limit=2")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var resources []presenters.DirectRequestRejectionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 2)
+		assert.Equal(t, int64(2), resources[0].BlockNumber)
+		assert.Equal(t, requester.Hex(), resources[0].Requester)
+		assert.Equal(t, string(directrequest.RejectionReasonRateLimited), resources[0].Reason)
+		assert.Equal(t, "100", resources[0].Payment.ToInt().String())
+	})
+
+	t.Run("rejects invalid limits", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/jobs/" + strconv.Itoa(int(jb.ID)) + "/direct_request/rejections?limit=0")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+}
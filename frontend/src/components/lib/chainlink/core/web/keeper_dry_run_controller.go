@@ -0,0 +1,54 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const (
+	defaultKeeperDryRunLimit = 100
+	maxKeeperDryRunLimit     = 1000
+)
+
+// KeeperDryRunController lists the perform transactions recorded by keeper
+// jobs in dry-run mode.
+type KeeperDryRunController struct {
+	App chainlink.Application
+}
+
+// Index lists the most recent dry run transactions of a keeper job, newest
+// first
+// Example:
+//
+//	"GET <application>/jobs/:ID/keeper/dry_run_transactions"
+func (kdc *KeeperDryRunController) Index(c *gin.Context) {
+	var jb job.Job
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	limit := defaultKeeperDryRunLimit
+	if s := c.Query("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit <= 0 || limit > maxKeeperDryRunLimit {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("limit must be between 1 and 1000"))
+			return
+		}
+	}
+
+	orm := keeper.NewORM(kdc.App.GetDB(), kdc.App.GetLogger())
+	txs, err := orm.DryRunTransactionsForJob(c.Request.Context(), jb.ID, limit)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewKeeperDryRunTransactionResources(txs), "keeperDryRunTransactions")
+}
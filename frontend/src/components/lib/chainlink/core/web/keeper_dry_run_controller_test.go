@@ -0,0 +1,68 @@
+package web_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeeperDryRunController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	// any job will do, the dry run transactions only reference its ID
+	jb, err := webhook.ValidatedWebhookSpec(ctx, testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{}).Toml(), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+
+	orm := keeper.NewORM(app.GetDB(), logger.TestLogger(t))
+	upkeepID := big.NewI(1)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, orm.InsertDryRunTransaction(ctx, &keeper.DryRunTransaction{
+			JobID:             jb.ID,
+			UpkeepID:          upkeepID,
+			ContractAddress:   cltest.NewEIP55Address(),
+			FromAddress:       cltest.NewEIP55Address(),
+			BlockNumber:       int64(i),
+			Calldata:          []byte{1, 2, 3},
+			GasLimit:          5_000_000,
+			SimulationSuccess: true,
+		}))
+	}
+
+	client := app.NewHTTPClient(nil)
+
+	t.Run("lists the most recent transactions", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/jobs/" + strconv.Itoa(int(jb.ID)) + "/keeper/dry_run_transactions?limit=2")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var resources []presenters.KeeperDryRunTransactionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 2)
+		assert.Equal(t, int64(2), resources[0].BlockNumber)
+		assert.Equal(t, "1", resources[0].UpkeepID)
+		assert.True(t, resources[0].SimulationSuccess)
+	})
+
+	t.Run("rejects invalid limits", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/jobs/" + strconv.Itoa(int(jb.ID)) + "/keeper/dry_run_transactions?limit=0")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+}
@@ -0,0 +1,35 @@
+package web
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeyTopUpsController reports the top-ups of sending keys from treasury keys.
+type KeyTopUpsController struct {
+	App chainlink.Application
+}
+
+// Index lists the top-ups of sending keys, most recent first, optionally filtered by chain
+// Example:
+//
+//	"GET <application>/key_top_ups?evmChainID=1"
+func (kc *KeyTopUpsController) Index(c *gin.Context, size, page, offset int) {
+	var chainID *big.Int
+	if s := c.Query("evmChainID"); s != "" {
+		var ok bool
+		if chainID, ok = new(big.Int).SetString(s, 10); !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("%w: %q", ErrInvalidChainID, s))
+			return
+		}
+	}
+
+	topUps, count, err := kc.App.KeyTopUps().TopUps(c.Request.Context(), chainID, offset, size)
+	paginatedResponse(c, "keyTopUps", size, page, presenters.NewKeyTopUpResources(topUps), count, err)
+}
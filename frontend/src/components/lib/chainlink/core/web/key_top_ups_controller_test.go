@@ -0,0 +1,65 @@
+package web_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keytopup"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeyTopUpsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	orm := keytopup.NewORM(app.GetDB())
+	treasury, to := testutils.NewAddress(), testutils.NewAddress()
+	var last keytopup.TopUp
+	for _, chainID := range []int64{1, 2, 2} {
+		last = keytopup.TopUp{
+			EVMChainID:      *ubig.NewI(chainID),
+			TreasuryAddress: treasury,
+			ToAddress:       to,
+			Amount:          assets.NewWeiI(300),
+			Balance:         assets.NewWeiI(40),
+		}
+		require.NoError(t, orm.InsertTopUp(ctx, &last))
+	}
+
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/key_top_ups?evmChainID=2")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	count, err := cltest.ParseJSONAPIResponseMetaCount(cltest.ParseResponseBody(t, resp))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	resp, cleanup = client.Get("/v2/key_top_ups?evmChainID=2&size=1")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var resources []presenters.KeyTopUpResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, strconv.FormatInt(last.ID, 10), resources[0].ID)
+	assert.Equal(t, to, resources[0].ToAddress)
+	assert.Equal(t, assets.NewWeiI(300).String(), resources[0].Amount.String())
+	assert.Nil(t, resources[0].EthTxID)
+
+	resp, cleanup = client.Get("/v2/key_top_ups?evmChainID=abc")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
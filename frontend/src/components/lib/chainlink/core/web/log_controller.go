@@ -2,12 +2,15 @@ package web
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -21,6 +24,43 @@ type LogController struct {
 type LogPatchRequest struct {
 	Level      string `json:"level"`
 	SqlEnabled *bool  `json:"sqlEnabled"`
+	// Overrides sets the levels of named loggers
+	Overrides []LogLevelOverrideRequest `json:"overrides,omitempty"`
+	// RemoveOverrides removes the level overrides of these logger names
+	RemoveOverrides []string `json:"removeOverrides,omitempty"`
+}
+
+// LogLevelOverrideRequest overrides the level of the loggers matching Name,
+// e.g. EVM.1.Txm or OCR2.*, optionally reverting after ExpiresIn, e.g. 30m.
+type LogLevelOverrideRequest struct {
+	Name      string `json:"name"`
+	Level     string `json:"level"`
+	ExpiresIn string `json:"expiresIn,omitempty"`
+}
+
+type logLevelOverride struct {
+	name string
+	lvl  zapcore.Level
+	ttl  time.Duration
+}
+
+func (r LogLevelOverrideRequest) parse() (o logLevelOverride, err error) {
+	if err = logger.ValidateLevelOverrideName(r.Name); err != nil {
+		return
+	}
+	if err = o.lvl.UnmarshalText([]byte(r.Level)); err != nil {
+		return o, fmt.Errorf("logger %s: %w", r.Name, err)
+	}
+	if r.ExpiresIn != "" {
+		if o.ttl, err = time.ParseDuration(r.ExpiresIn); err != nil {
+			return o, fmt.Errorf("logger %s: invalid expiresIn: %w", r.Name, err)
+		}
+		if o.ttl <= 0 {
+			return o, fmt.Errorf("logger %s: expiresIn must be positive", r.Name)
+		}
+	}
+	o.name = r.Name
+	return
 }
 
 // Get retrieves the current log config settings
@@ -39,6 +79,7 @@ func (cc *LogController) Get(c *gin.Context) {
 		ServiceName:     svcs,
 		LogLevel:        lvls,
 		DefaultLogLevel: cc.App.GetConfig().Log().DefaultLevel().String(),
+		Overrides:       presenters.NewLogLevelOverrideResources(cc.App.GetConfig().LogLevelOverrides().List()),
 	}
 
 	jsonAPIResponse(c, response, "log")
@@ -56,11 +97,21 @@ func (cc *LogController) Patch(c *gin.Context) {
 	var svcs, lvls []string
 
 	// Validate request params
-	if request.Level == "" && request.SqlEnabled == nil {
+	if request.Level == "" && request.SqlEnabled == nil && len(request.Overrides) == 0 && len(request.RemoveOverrides) == 0 {
 		jsonAPIError(c, http.StatusBadRequest, errors.New("please check request params, no params configured"))
 		return
 	}
 
+	overrides := make([]logLevelOverride, 0, len(request.Overrides))
+	for _, r := range request.Overrides {
+		o, err := r.parse()
+		if err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		overrides = append(overrides, o)
+	}
+
 	if request.Level != "" {
 		var ll zapcore.Level
 		err := ll.UnmarshalText([]byte(request.Level))
@@ -80,6 +131,25 @@ func (cc *LogController) Patch(c *gin.Context) {
 		cc.App.GetConfig().SetLogSQL(*request.SqlEnabled)
 	}
 
+	levelOverrides := cc.App.GetConfig().LogLevelOverrides()
+	for _, name := range request.RemoveOverrides {
+		if levelOverrides.Remove(name) {
+			cc.App.GetAuditLogger().Audit(audit.LogLevelOverrideRemoved, map[string]interface{}{"name": name})
+		}
+	}
+	for _, o := range overrides {
+		override, err := levelOverrides.Set(o.name, o.lvl, o.ttl)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		cc.App.GetAuditLogger().Audit(audit.LogLevelOverrideSet, map[string]interface{}{
+			"name":      override.Name,
+			"logLevel":  override.Level.String(),
+			"expiresAt": override.ExpiresAt,
+		})
+	}
+
 	svcs = append(svcs, "IsSqlEnabled")
 	lvls = append(lvls, strconv.FormatBool(cc.App.GetConfig().Database().LogSQL()))
 
@@ -89,9 +159,12 @@ func (cc *LogController) Patch(c *gin.Context) {
 		},
 		ServiceName: svcs,
 		LogLevel:    lvls,
+		Overrides:   presenters.NewLogLevelOverrideResources(levelOverrides.List()),
 	}
 
-	cc.App.GetAuditLogger().Audit(audit.GlobalLogLevelSet, map[string]interface{}{"logLevel": request.Level})
+	if request.Level != "" {
+		cc.App.GetAuditLogger().Audit(audit.GlobalLogLevelSet, map[string]interface{}{"logLevel": request.Level})
+	}
 
 	if request.Level == "debug" {
 		if request.SqlEnabled != nil && *request.SqlEnabled {
@@ -141,3 +141,54 @@ func TestLogController_PatchLogConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLogController_PatchLogLevelOverrides(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Log.Overrides = map[string]toml.LogLevel{"OCR2.*": toml.LogLevel(zapcore.WarnLevel)}
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	patch := func(t *testing.T, request web.LogPatchRequest, expectedCode int) presenters.ServiceLogConfigResource {
+		requestData, err := json.Marshal(request)
+		require.NoError(t, err)
+		resp, cleanup := client.Patch("/v2/log", bytes.NewBuffer(requestData))
+		t.Cleanup(cleanup)
+
+		var svcLogConfig presenters.ServiceLogConfigResource
+		cltest.AssertServerResponse(t, resp, expectedCode)
+		if expectedCode == http.StatusOK {
+			require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &svcLogConfig))
+		}
+		return svcLogConfig
+	}
+
+	svcLogConfig := patch(t, web.LogPatchRequest{
+		Overrides: []web.LogLevelOverrideRequest{{Name: "EVM.1.Txm", Level: "debug", ExpiresIn: "30m"}},
+	}, http.StatusOK)
+	require.Len(t, svcLogConfig.Overrides, 2)
+	assert.Equal(t, "EVM.1.Txm", svcLogConfig.Overrides[0].Name)
+	assert.Equal(t, "debug", svcLogConfig.Overrides[0].Level)
+	assert.NotNil(t, svcLogConfig.Overrides[0].ExpiresAt)
+	assert.Equal(t, "OCR2.*", svcLogConfig.Overrides[1].Name)
+	assert.Equal(t, "warn", svcLogConfig.Overrides[1].Level)
+	assert.Nil(t, svcLogConfig.Overrides[1].ExpiresAt)
+
+	svcLogConfig = patch(t, web.LogPatchRequest{RemoveOverrides: []string{"OCR2.*"}}, http.StatusOK)
+	require.Len(t, svcLogConfig.Overrides, 1)
+	assert.Equal(t, "EVM.1.Txm", svcLogConfig.Overrides[0].Name)
+
+	patch(t, web.LogPatchRequest{
+		Overrides: []web.LogLevelOverrideRequest{{Name: "OCR2.*.Mercury", Level: "debug"}},
+	}, http.StatusBadRequest)
+	patch(t, web.LogPatchRequest{
+		Overrides: []web.LogLevelOverrideRequest{{Name: "OCR2", Level: "loud"}},
+	}, http.StatusBadRequest)
+	patch(t, web.LogPatchRequest{
+		Overrides: []web.LogLevelOverrideRequest{{Name: "OCR2", Level: "debug", ExpiresIn: "-1m"}},
+	}, http.StatusBadRequest)
+	assert.Len(t, app.GetConfig().LogLevelOverrides().List(), 1)
+}
@@ -0,0 +1,64 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
+)
+
+// BHSBackfillResource represents a blockhash store backfill JSONAPI resource.
+type BHSBackfillResource struct {
+	JAID
+	EVMChainID      big.Big        `json:"evmChainID"`
+	BatchBHSAddress common.Address `json:"batchBlockhashStoreAddress"`
+	FromAddress     common.Address `json:"fromAddress"`
+	FromBlock       int64          `json:"fromBlock"`
+	ToBlock         int64          `json:"toBlock"`
+	BatchSize       uint32         `json:"batchSize"`
+	GasLimit        uint64         `json:"gasLimit"`
+	NextBlock       int64          `json:"nextBlock"`
+	StoredBlocks    int64          `json:"storedBlocks"`
+	SkippedBlocks   int64          `json:"skippedBlocks"`
+	State           string         `json:"state"`
+	Error           *string        `json:"error"`
+	CreatedAt       time.Time      `json:"createdAt"`
+	UpdatedAt       time.Time      `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (BHSBackfillResource) GetName() string {
+	return "bhsBackfills"
+}
+
+// NewBHSBackfillResource constructs a new BHSBackfillResource
+func NewBHSBackfillResource(b blockheaderfeeder.Backfill) *BHSBackfillResource {
+	return &BHSBackfillResource{
+		JAID:            NewJAIDInt64(b.ID),
+		EVMChainID:      b.EVMChainID,
+		BatchBHSAddress: b.BatchBHSAddress,
+		FromAddress:     b.FromAddress,
+		FromBlock:       b.FromBlock,
+		ToBlock:         b.ToBlock,
+		BatchSize:       b.BatchSize,
+		GasLimit:        b.GasLimit,
+		NextBlock:       b.NextBlock,
+		StoredBlocks:    b.StoredBlocks,
+		SkippedBlocks:   b.SkippedBlocks,
+		State:           string(b.State),
+		Error:           b.Error,
+		CreatedAt:       b.CreatedAt,
+		UpdatedAt:       b.UpdatedAt,
+	}
+}
+
+// NewBHSBackfillResources constructs a list of BHSBackfillResources
+func NewBHSBackfillResources(backfills []blockheaderfeeder.Backfill) []BHSBackfillResource {
+	rs := []BHSBackfillResource{}
+	for _, b := range backfills {
+		rs = append(rs, *NewBHSBackfillResource(b))
+	}
+	return rs
+}
@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+)
+
+// DirectRequestRejectionResource represents an oracle request that a Direct
+// Request job did not run
+type DirectRequestRejectionResource struct {
+	JAID
+	JobID       int32        `json:"jobID"`
+	RequestID   string       `json:"requestID"`
+	Requester   string       `json:"requester"`
+	Payment     *assets.Link `json:"payment"`
+	Reason      string       `json:"reason"`
+	Detail      string       `json:"detail"`
+	BlockNumber int64        `json:"blockNumber"`
+	TxHash      string       `json:"txHash"`
+	CreatedAt   time.Time    `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (DirectRequestRejectionResource) GetName() string {
+	return "directRequestRejections"
+}
+
+// NewDirectRequestRejectionResource constructs a new DirectRequestRejectionResource
+func NewDirectRequestRejectionResource(r directrequest.Rejection) DirectRequestRejectionResource {
+	return DirectRequestRejectionResource{
+		JAID:        NewJAIDInt64(r.ID),
+		JobID:       r.JobID,
+		RequestID:   r.RequestID.Hex(),
+		Requester:   r.Requester.Hex(),
+		Payment:     r.Payment,
+		Reason:      string(r.Reason),
+		Detail:      r.Detail,
+		BlockNumber: r.BlockNumber,
+		TxHash:      r.TxHash.Hex(),
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// NewDirectRequestRejectionResources constructs a list of DirectRequestRejectionResources
+func NewDirectRequestRejectionResources(rejections []directrequest.Rejection) []DirectRequestRejectionResource {
+	rs := make([]DirectRequestRejectionResource, len(rejections))
+	for i, r := range rejections {
+		rs[i] = NewDirectRequestRejectionResource(r)
+	}
+	return rs
+}
@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+// GasSpendResource represents the spend of a gas spend budget JSONAPI resource.
+type GasSpendResource struct {
+	JAID
+	EVMChainID ubig.Big        `json:"evmChainID"`
+	JobID      *int32          `json:"jobID"`
+	Key        *common.Address `json:"key"`
+	Window     string          `json:"window"`
+	Action     string          `json:"action"`
+	Limit      *assets.Wei     `json:"limit"`
+	Spent      *assets.Wei     `json:"spent"`
+	Remaining  *assets.Wei     `json:"remaining"`
+	Exceeded   bool            `json:"exceeded"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (GasSpendResource) GetName() string {
+	return "gasSpend"
+}
+
+// NewGasSpendResource constructs a new GasSpendResource for the budget at index i of the config
+func NewGasSpendResource(i int, budget txmgr.SpendBudget, spent *big.Int) *GasSpendResource {
+	remaining := new(big.Int).Sub(budget.Limit, spent)
+	if remaining.Sign() < 0 {
+		remaining.SetInt64(0)
+	}
+
+	return &GasSpendResource{
+		JAID:       NewJAID(strconv.Itoa(i)),
+		EVMChainID: *ubig.New(budget.ChainID),
+		JobID:      budget.JobID,
+		Key:        budget.Key,
+		Window:     budget.Window.String(),
+		Action:     string(budget.Action),
+		Limit:      assets.NewWei(budget.Limit),
+		Spent:      assets.NewWei(spent),
+		Remaining:  assets.NewWei(remaining),
+		Exceeded:   spent.Cmp(budget.Limit) >= 0,
+	}
+}
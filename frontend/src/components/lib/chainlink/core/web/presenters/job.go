@@ -75,20 +75,27 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 
 // FluxMonitorSpec defines the spec details of a FluxMonitor Job
 type FluxMonitorSpec struct {
-	ContractAddress     types.EIP55Address `json:"contractAddress"`
-	Threshold           float32            `json:"threshold"`
-	AbsoluteThreshold   float32            `json:"absoluteThreshold"`
-	PollTimerPeriod     string             `json:"pollTimerPeriod"`
-	PollTimerDisabled   bool               `json:"pollTimerDisabled"`
-	IdleTimerPeriod     string             `json:"idleTimerPeriod"`
-	IdleTimerDisabled   bool               `json:"idleTimerDisabled"`
-	DrumbeatEnabled     bool               `json:"drumbeatEnabled"`
-	DrumbeatSchedule    *string            `json:"drumbeatSchedule"`
-	DrumbeatRandomDelay *string            `json:"drumbeatRandomDelay"`
-	MinPayment          *commonassets.Link `json:"minPayment"`
-	CreatedAt           time.Time          `json:"createdAt"`
-	UpdatedAt           time.Time          `json:"updatedAt"`
-	EVMChainID          *big.Big           `json:"evmChainID"`
+	ContractAddress        types.EIP55Address `json:"contractAddress"`
+	Threshold              float32            `json:"threshold"`
+	AbsoluteThreshold      float32            `json:"absoluteThreshold"`
+	ThresholdUp            float32            `json:"thresholdUp"`
+	ThresholdDown          float32            `json:"thresholdDown"`
+	DeviationMode          string             `json:"deviationMode"`
+	VolatilityWindow       uint32             `json:"volatilityWindow"`
+	VolatilityMultiplier   float32            `json:"volatilityMultiplier"`
+	SubmissionBudget       uint32             `json:"submissionBudget"`
+	SubmissionBudgetPeriod string             `json:"submissionBudgetPeriod"`
+	PollTimerPeriod        string             `json:"pollTimerPeriod"`
+	PollTimerDisabled      bool               `json:"pollTimerDisabled"`
+	IdleTimerPeriod        string             `json:"idleTimerPeriod"`
+	IdleTimerDisabled      bool               `json:"idleTimerDisabled"`
+	DrumbeatEnabled        bool               `json:"drumbeatEnabled"`
+	DrumbeatSchedule       *string            `json:"drumbeatSchedule"`
+	DrumbeatRandomDelay    *string            `json:"drumbeatRandomDelay"`
+	MinPayment             *commonassets.Link `json:"minPayment"`
+	CreatedAt              time.Time          `json:"createdAt"`
+	UpdatedAt              time.Time          `json:"updatedAt"`
+	EVMChainID             *big.Big           `json:"evmChainID"`
 }
 
 // NewFluxMonitorSpec initializes a new DirectFluxMonitorSpec from a
@@ -104,20 +111,27 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		drumbeatRandomDelayPtr = &drumbeatRandomDelay
 	}
 	return &FluxMonitorSpec{
-		ContractAddress:     spec.ContractAddress,
-		Threshold:           float32(spec.Threshold),
-		AbsoluteThreshold:   float32(spec.AbsoluteThreshold),
-		PollTimerPeriod:     spec.PollTimerPeriod.String(),
-		PollTimerDisabled:   spec.PollTimerDisabled,
-		IdleTimerPeriod:     spec.IdleTimerPeriod.String(),
-		IdleTimerDisabled:   spec.IdleTimerDisabled,
-		DrumbeatEnabled:     spec.DrumbeatEnabled,
-		DrumbeatSchedule:    drumbeatSchedulePtr,
-		DrumbeatRandomDelay: drumbeatRandomDelayPtr,
-		MinPayment:          spec.MinPayment,
-		CreatedAt:           spec.CreatedAt,
-		UpdatedAt:           spec.UpdatedAt,
-		EVMChainID:          spec.EVMChainID,
+		ContractAddress:        spec.ContractAddress,
+		Threshold:              float32(spec.Threshold),
+		AbsoluteThreshold:      float32(spec.AbsoluteThreshold),
+		ThresholdUp:            float32(spec.ThresholdUp),
+		ThresholdDown:          float32(spec.ThresholdDown),
+		DeviationMode:          spec.DeviationMode,
+		VolatilityWindow:       spec.VolatilityWindow,
+		VolatilityMultiplier:   float32(spec.VolatilityMultiplier),
+		SubmissionBudget:       spec.SubmissionBudget,
+		SubmissionBudgetPeriod: spec.SubmissionBudgetPeriod.String(),
+		PollTimerPeriod:        spec.PollTimerPeriod.String(),
+		PollTimerDisabled:      spec.PollTimerDisabled,
+		IdleTimerPeriod:        spec.IdleTimerPeriod.String(),
+		IdleTimerDisabled:      spec.IdleTimerDisabled,
+		DrumbeatEnabled:        spec.DrumbeatEnabled,
+		DrumbeatSchedule:       drumbeatSchedulePtr,
+		DrumbeatRandomDelay:    drumbeatRandomDelayPtr,
+		MinPayment:             spec.MinPayment,
+		CreatedAt:              spec.CreatedAt,
+		UpdatedAt:              spec.UpdatedAt,
+		EVMChainID:             spec.EVMChainID,
 	}
 }
 
@@ -227,6 +241,7 @@ type KeeperSpec struct {
 	CreatedAt       time.Time          `json:"createdAt"`
 	UpdatedAt       time.Time          `json:"updatedAt"`
 	EVMChainID      *big.Big           `json:"evmChainID"`
+	DryRun          bool               `json:"dryRun"`
 }
 
 // NewKeeperSpec generates a new KeeperSpec from a job.KeeperSpec
@@ -237,6 +252,7 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 		CreatedAt:       spec.CreatedAt,
 		UpdatedAt:       spec.UpdatedAt,
 		EVMChainID:      spec.EVMChainID,
+		DryRun:          spec.DryRun,
 	}
 }
 
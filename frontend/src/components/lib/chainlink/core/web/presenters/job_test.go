@@ -182,6 +182,13 @@ func TestJob(t *testing.T) {
 							"contractAddress": "%s",
 							"threshold": 0.5,
 							"absoluteThreshold": 0,
+							"thresholdUp": 0,
+							"thresholdDown": 0,
+							"deviationMode": "",
+							"volatilityWindow": 0,
+							"volatilityMultiplier": 0,
+							"submissionBudget": 0,
+							"submissionBudgetPeriod": "0s",
 							"idleTimerPeriod": "1m0s",
 							"idleTimerDisabled": false,
 							"pollTimerPeriod": "1s",
@@ -346,7 +353,8 @@ func TestJob(t *testing.T) {
 							"fromAddress": "%s",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
-							"evmChainID": "42"
+							"evmChainID": "42",
+							"dryRun": false
 						},
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -1120,7 +1128,8 @@ func TestJob(t *testing.T) {
 							"fromAddress": "%s",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
-							"evmChainID": "42"
+							"evmChainID": "42",
+							"dryRun": false
 						},
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
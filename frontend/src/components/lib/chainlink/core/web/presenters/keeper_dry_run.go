@@ -0,0 +1,64 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+)
+
+// KeeperDryRunTransactionResource represents a perform transaction that a
+// dry-run keeper job would have broadcast
+type KeeperDryRunTransactionResource struct {
+	JAID
+	JobID             int32              `json:"jobID"`
+	UpkeepID          string             `json:"upkeepID"`
+	ContractAddress   types.EIP55Address `json:"contractAddress"`
+	FromAddress       types.EIP55Address `json:"fromAddress"`
+	BlockNumber       int64              `json:"blockNumber"`
+	Calldata          hexutil.Bytes      `json:"calldata"`
+	GasLimit          uint64             `json:"gasLimit"`
+	GasEstimate       *int64             `json:"gasEstimate"`
+	SimulationSuccess bool               `json:"simulationSuccess"`
+	SimulationError   *string            `json:"simulationError"`
+	CreatedAt         time.Time          `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeeperDryRunTransactionResource) GetName() string {
+	return "keeperDryRunTransactions"
+}
+
+// NewKeeperDryRunTransactionResource constructs a new KeeperDryRunTransactionResource
+func NewKeeperDryRunTransactionResource(tx keeper.DryRunTransaction) KeeperDryRunTransactionResource {
+	r := KeeperDryRunTransactionResource{
+		JAID:              NewJAIDInt64(tx.ID),
+		JobID:             tx.JobID,
+		ContractAddress:   tx.ContractAddress,
+		FromAddress:       tx.FromAddress,
+		BlockNumber:       tx.BlockNumber,
+		Calldata:          tx.Calldata,
+		GasLimit:          tx.GasLimit,
+		SimulationSuccess: tx.SimulationSuccess,
+		SimulationError:   tx.SimulationError,
+		CreatedAt:         tx.CreatedAt,
+	}
+	if tx.UpkeepID != nil {
+		r.UpkeepID = tx.UpkeepID.String()
+	}
+	if tx.GasEstimate.Valid {
+		r.GasEstimate = &tx.GasEstimate.Int64
+	}
+	return r
+}
+
+// NewKeeperDryRunTransactionResources constructs a list of KeeperDryRunTransactionResources
+func NewKeeperDryRunTransactionResources(txs []keeper.DryRunTransaction) []KeeperDryRunTransactionResource {
+	rs := make([]KeeperDryRunTransactionResource, len(txs))
+	for i, tx := range txs {
+		rs[i] = NewKeeperDryRunTransactionResource(tx)
+	}
+	return rs
+}
@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keytopup"
+)
+
+// KeyTopUpResource represents a sending key top-up JSONAPI resource.
+type KeyTopUpResource struct {
+	JAID
+	EVMChainID      big.Big        `json:"evmChainID"`
+	TreasuryAddress common.Address `json:"treasuryAddress"`
+	ToAddress       common.Address `json:"toAddress"`
+	Amount          *assets.Wei    `json:"amount"`
+	Balance         *assets.Wei    `json:"balance"`
+	EthTxID         *int64         `json:"ethTxID"`
+	CreatedAt       time.Time      `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeyTopUpResource) GetName() string {
+	return "keyTopUps"
+}
+
+// NewKeyTopUpResource constructs a new KeyTopUpResource
+func NewKeyTopUpResource(t keytopup.TopUp) *KeyTopUpResource {
+	return &KeyTopUpResource{
+		JAID:            NewJAIDInt64(t.ID),
+		EVMChainID:      t.EVMChainID,
+		TreasuryAddress: t.TreasuryAddress,
+		ToAddress:       t.ToAddress,
+		Amount:          t.Amount,
+		Balance:         t.Balance,
+		EthTxID:         t.EthTxID,
+		CreatedAt:       t.CreatedAt,
+	}
+}
+
+// NewKeyTopUpResources constructs a list of KeyTopUpResources
+func NewKeyTopUpResources(topUps []keytopup.TopUp) []KeyTopUpResource {
+	rs := []KeyTopUpResource{}
+	for _, t := range topUps {
+		rs = append(rs, *NewKeyTopUpResource(t))
+	}
+	return rs
+}
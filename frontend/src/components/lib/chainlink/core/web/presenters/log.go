@@ -1,13 +1,40 @@
 package presenters
 
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
 type ServiceLogConfigResource struct {
 	JAID
-	ServiceName     []string `json:"serviceName"`
-	LogLevel        []string `json:"logLevel"`
-	DefaultLogLevel string   `json:"defaultLogLevel"`
+	ServiceName     []string                   `json:"serviceName"`
+	LogLevel        []string                   `json:"logLevel"`
+	DefaultLogLevel string                     `json:"defaultLogLevel"`
+	Overrides       []LogLevelOverrideResource `json:"overrides"`
 }
 
 // GetName implements the api2go EntityNamer interface
 func (r ServiceLogConfigResource) GetName() string {
 	return "serviceLevelLogs"
 }
+
+// LogLevelOverrideResource represents the log level override of named loggers
+type LogLevelOverrideResource struct {
+	Name      string     `json:"name"`
+	Level     string     `json:"level"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// NewLogLevelOverrideResources constructs the resources of log level overrides
+func NewLogLevelOverrideResources(overrides []logger.LevelOverride) []LogLevelOverrideResource {
+	rs := make([]LogLevelOverrideResource, 0, len(overrides))
+	for _, o := range overrides {
+		rs = append(rs, LogLevelOverrideResource{
+			Name:      o.Name,
+			Level:     o.Level.String(),
+			ExpiresAt: o.ExpiresAt,
+		})
+	}
+	return rs
+}
@@ -0,0 +1,147 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/archive"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
+)
+
+// ArchivedReportSignature is the signature of an oracle over an archived report
+type ArchivedReportSignature struct {
+	Signer    uint8         `json:"signer"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// LLOArchivedReportResource represents an archived LLO report JSONAPI resource.
+type LLOArchivedReportResource struct {
+	JAID
+	ChannelID      *uint32                   `json:"channelID"`
+	FeedID         *string                   `json:"feedID"`
+	ConfigDigest   string                    `json:"configDigest"`
+	SeqNr          uint64                    `json:"seqNr"`
+	ReportFormat   uint32                    `json:"reportFormat"`
+	LifeCycleStage string                    `json:"lifeCycleStage"`
+	Report         hexutil.Bytes             `json:"report"`
+	Signatures     []ArchivedReportSignature `json:"signatures"`
+	ArchivedAt     time.Time                 `json:"archivedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (LLOArchivedReportResource) GetName() string {
+	return "lloArchivedReports"
+}
+
+// NewLLOArchivedReportResources constructs a list of LLOArchivedReportResources
+func NewLLOArchivedReportResources(records []*archive.Record) []LLOArchivedReportResource {
+	rs := []LLOArchivedReportResource{}
+	for _, r := range records {
+		resource := LLOArchivedReportResource{
+			JAID:           NewJAIDInt64(r.ID),
+			ConfigDigest:   r.ConfigDigest.Hex(),
+			SeqNr:          r.SeqNr,
+			ReportFormat:   uint32(r.Report.Info.ReportFormat),
+			LifeCycleStage: string(r.Report.Info.LifeCycleStage),
+			Report:         hexutil.Bytes(r.Report.Report),
+			ArchivedAt:     r.ArchivedAt,
+		}
+		if r.ChannelID != nil {
+			cid := uint32(*r.ChannelID)
+			resource.ChannelID = &cid
+		}
+		if r.FeedID != nil {
+			fid := r.FeedID.Hex()
+			resource.FeedID = &fid
+		}
+		for _, sig := range r.Sigs {
+			resource.Signatures = append(resource.Signatures, ArchivedReportSignature{Signer: uint8(sig.Signer), Signature: sig.Signature})
+		}
+		rs = append(rs, resource)
+	}
+	return rs
+}
+
+// MercuryArchivedReportResource represents an archived Mercury report JSONAPI resource.
+type MercuryArchivedReportResource struct {
+	JAID
+	FeedID       string                    `json:"feedID"`
+	ConfigDigest string                    `json:"configDigest"`
+	Epoch        uint32                    `json:"epoch"`
+	Round        uint8                     `json:"round"`
+	ExtraHash    hexutil.Bytes             `json:"extraHash"`
+	Report       hexutil.Bytes             `json:"report"`
+	Signatures   []ArchivedReportSignature `json:"signatures"`
+	ArchivedAt   time.Time                 `json:"archivedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (MercuryArchivedReportResource) GetName() string {
+	return "mercuryArchivedReports"
+}
+
+// NewMercuryArchivedReportResources constructs a list of MercuryArchivedReportResources
+func NewMercuryArchivedReportResources(records []*mercury.ArchiveRecord) []MercuryArchivedReportResource {
+	rs := []MercuryArchivedReportResource{}
+	for _, r := range records {
+		resource := MercuryArchivedReportResource{
+			JAID:         NewJAIDInt64(r.ID),
+			FeedID:       r.FeedID.Hex(),
+			ConfigDigest: r.ReportCtx.ConfigDigest.Hex(),
+			Epoch:        r.ReportCtx.Epoch,
+			Round:        r.ReportCtx.Round,
+			ExtraHash:    r.ReportCtx.ExtraHash[:],
+			Report:       hexutil.Bytes(r.Report),
+			ArchivedAt:   r.ArchivedAt,
+		}
+		for _, sig := range r.Sigs {
+			resource.Signatures = append(resource.Signatures, ArchivedReportSignature{Signer: uint8(sig.Signer), Signature: sig.Signature})
+		}
+		rs = append(rs, resource)
+	}
+	return rs
+}
+
+// ReportArchiveReplayResource represents a replay of a range of archived
+// reports.
+type ReportArchiveReplayResource struct {
+	JAID
+	Archive    string              `json:"archive"`
+	From       time.Time           `json:"from"`
+	To         time.Time           `json:"to"`
+	State      archive.ReplayState `json:"state"`
+	Replayed   int                 `json:"replayed"`
+	Error      string              `json:"error,omitempty"`
+	StartedAt  time.Time           `json:"startedAt"`
+	FinishedAt *time.Time          `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (ReportArchiveReplayResource) GetName() string {
+	return "reportArchiveReplays"
+}
+
+// NewReportArchiveReplayResource constructs a new ReportArchiveReplayResource
+func NewReportArchiveReplayResource(r archive.ReplayStatus) ReportArchiveReplayResource {
+	return ReportArchiveReplayResource{
+		JAID:       NewJAIDInt64(r.ID),
+		Archive:    r.Archive,
+		From:       r.From,
+		To:         r.To,
+		State:      r.State,
+		Replayed:   r.Replayed,
+		Error:      r.Error,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+	}
+}
+
+// NewReportArchiveReplayResources constructs a list of ReportArchiveReplayResources
+func NewReportArchiveReplayResources(replays []archive.ReplayStatus) []ReportArchiveReplayResource {
+	rs := []ReportArchiveReplayResource{}
+	for _, r := range replays {
+		rs = append(rs, NewReportArchiveReplayResource(r))
+	}
+	return rs
+}
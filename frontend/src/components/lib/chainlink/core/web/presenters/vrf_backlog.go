@@ -0,0 +1,105 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+// VRFPendingRequestResource represents a VRF request that has not been
+// fulfilled yet
+type VRFPendingRequestResource struct {
+	JAID
+	JobID              int32          `json:"jobID"`
+	SubID              string         `json:"subID"`
+	Sender             common.Address `json:"sender"`
+	RequestTxHash      common.Hash    `json:"requestTxHash"`
+	RequestBlockNumber uint64         `json:"requestBlockNumber"`
+	ConfirmedAtBlock   uint64         `json:"confirmedAtBlock"`
+	CallbackGasLimit   uint32         `json:"callbackGasLimit"`
+	NativePayment      bool           `json:"nativePayment"`
+	Attempts           int            `json:"attempts"`
+	LastTry            *time.Time     `json:"lastTry"`
+	SkipReason         string         `json:"skipReason"`
+	SkipDetail         string         `json:"skipDetail,omitempty"`
+	MaxFee             string         `json:"maxFee,omitempty"`
+	GasLimit           uint64         `json:"gasLimit,omitempty"`
+	MaxGasPrice        string         `json:"maxGasPrice,omitempty"`
+	TxID               *int64         `json:"txID"`
+	UpdatedAt          time.Time      `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (VRFPendingRequestResource) GetName() string {
+	return "vrfPendingRequests"
+}
+
+// NewVRFPendingRequestResource constructs a new VRFPendingRequestResource
+func NewVRFPendingRequestResource(jobID int32, s vrfcommon.PendingRequestStatus) VRFPendingRequestResource {
+	r := VRFPendingRequestResource{
+		JAID:               NewJAID(s.RequestID.String()),
+		JobID:              jobID,
+		SubID:              s.SubID.String(),
+		Sender:             s.Sender,
+		RequestTxHash:      s.RequestTxHash,
+		RequestBlockNumber: s.RequestBlockNumber,
+		ConfirmedAtBlock:   s.ConfirmedAtBlock,
+		CallbackGasLimit:   s.CallbackGasLimit,
+		NativePayment:      s.NativePayment,
+		Attempts:           s.Attempts,
+		SkipReason:         string(s.SkipReason),
+		SkipDetail:         s.SkipDetail,
+		GasLimit:           s.GasLimit,
+		TxID:               s.TxID,
+		UpdatedAt:          s.UpdatedAt,
+	}
+	if !s.LastTry.IsZero() {
+		r.LastTry = &s.LastTry
+	}
+	if s.MaxFee != nil {
+		r.MaxFee = s.MaxFee.String()
+	}
+	if s.MaxGasPrice != nil {
+		r.MaxGasPrice = s.MaxGasPrice.String()
+	}
+	return r
+}
+
+// NewVRFPendingRequestResources constructs a list of VRFPendingRequestResources
+func NewVRFPendingRequestResources(jobID int32, statuses []vrfcommon.PendingRequestStatus) []VRFPendingRequestResource {
+	rs := make([]VRFPendingRequestResource, len(statuses))
+	for i, s := range statuses {
+		rs[i] = NewVRFPendingRequestResource(jobID, s)
+	}
+	return rs
+}
+
+// VRFFulfillmentResource represents a manual fulfillment of a VRF request
+type VRFFulfillmentResource struct {
+	JAID
+	TxID        int64          `json:"txID"`
+	FromAddress common.Address `json:"fromAddress"`
+	GasLimit    uint64         `json:"gasLimit"`
+	MaxFee      string         `json:"maxFee,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (VRFFulfillmentResource) GetName() string {
+	return "vrfFulfillments"
+}
+
+// NewVRFFulfillmentResource constructs a new VRFFulfillmentResource
+func NewVRFFulfillmentResource(requestID string, res vrfcommon.ForceFulfillResult) *VRFFulfillmentResource {
+	r := &VRFFulfillmentResource{
+		JAID:        NewJAID(requestID),
+		TxID:        res.TxID,
+		FromAddress: res.FromAddress,
+		GasLimit:    res.GasLimit,
+	}
+	if res.MaxFee != nil {
+		r.MaxFee = res.MaxFee.String()
+	}
+	return r
+}
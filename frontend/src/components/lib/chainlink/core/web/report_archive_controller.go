@@ -0,0 +1,236 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/archive"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const (
+	defaultReportArchiveLimit = 100
+	maxReportArchiveLimit     = 1000
+)
+
+// ReportArchiveController queries and replays archived LLO and Mercury
+// reports.
+type ReportArchiveController struct {
+	App chainlink.Application
+}
+
+// ReplayReportArchiveRequest is a JSONAPI request for replaying the reports
+// archived in a time range to an HTTP endpoint.
+type ReplayReportArchiveRequest struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	URL  string    `json:"url"`
+}
+
+// reportArchiveQuery is the time range and cursor of an archive query.
+type reportArchiveQuery struct {
+	from, to time.Time
+	afterID  int64
+	limit    int
+}
+
+func parseReportArchiveQuery(c *gin.Context) (q reportArchiveQuery, err error) {
+	q.to = time.Now()
+	q.limit = defaultReportArchiveLimit
+	if s := c.Query("from"); s != "" {
+		if q.from, err = time.Parse(time.RFC3339, s); err != nil {
+			return q, errors.New("from must be an RFC3339 timestamp")
+		}
+	}
+	if s := c.Query("to"); s != "" {
+		if q.to, err = time.Parse(time.RFC3339, s); err != nil {
+			return q, errors.New("to must be an RFC3339 timestamp")
+		}
+	}
+	if s := c.Query("afterID"); s != "" {
+		if q.afterID, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return q, errors.New("afterID must be an integer")
+		}
+	}
+	if s := c.Query("limit"); s != "" {
+		if q.limit, err = strconv.Atoi(s); err != nil || q.limit <= 0 {
+			return q, errors.New("limit must be a positive integer")
+		}
+		if q.limit > maxReportArchiveLimit {
+			q.limit = maxReportArchiveLimit
+		}
+	}
+	return q, nil
+}
+
+func parseReplayReportArchiveRequest(c *gin.Context) (request ReplayReportArchiveRequest, err error) {
+	if err = c.ShouldBindJSON(&request); err != nil {
+		return request, err
+	}
+	if request.URL == "" {
+		return request, errors.New("url is required")
+	}
+	if request.To.IsZero() {
+		request.To = time.Now()
+	}
+	if request.To.Before(request.From) {
+		return request, errors.New("to must not be before from")
+	}
+	return request, nil
+}
+
+// LLOIndex lists the reports archived for a DON, oldest first. Use the ID of
+// the last report as afterID to fetch the next page.
+// Example:
+//
+//	"GET <application>/report_archive/llo/:donID?from=<RFC3339>&to=<RFC3339>&afterID=<id>&limit=<n>"
+func (rac *ReportArchiveController) LLOIndex(c *gin.Context) {
+	donID, err := strconv.ParseUint(c.Param("donID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	q, err := parseReportArchiveQuery(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	orm := archive.NewORM(rac.App.GetDB(), uint32(donID))
+	records, err := orm.Get(c.Request.Context(), q.from, q.to, q.afterID, q.limit)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewLLOArchivedReportResources(records), "lloArchivedReports")
+}
+
+// LLOReplay starts POSTing every report archived for a DON in a time range
+// to the given URL, oldest first. The replay runs in the background, see
+// ShowReplay for its progress.
+// Example:
+//
+//	"POST <application>/report_archive/llo/:donID/replay"
+func (rac *ReportArchiveController) LLOReplay(c *gin.Context) {
+	donID, err := strconv.ParseUint(c.Param("donID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request, err := parseReplayReportArchiveRequest(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	orm := archive.NewORM(rac.App.GetDB(), uint32(donID))
+	target := archive.NewHTTPTarget(clhttp.NewUnrestrictedHTTPClient(), request.URL)
+	rac.startReplay(c, "llo/"+c.Param("donID"), request, func(ctx context.Context) (int, error) {
+		return archive.Replay(ctx, orm, request.From, request.To, target)
+	})
+}
+
+// MercuryIndex lists the reports archived for a Mercury job, oldest first.
+// Use the ID of the last report as afterID to fetch the next page.
+// Example:
+//
+//	"GET <application>/report_archive/mercury/:jobID?from=<RFC3339>&to=<RFC3339>&afterID=<id>&limit=<n>"
+func (rac *ReportArchiveController) MercuryIndex(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("jobID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	q, err := parseReportArchiveQuery(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	orm := mercury.NewArchiveORM(rac.App.GetDB(), int32(jobID))
+	records, err := orm.Get(c.Request.Context(), q.from, q.to, q.afterID, q.limit)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewMercuryArchivedReportResources(records), "mercuryArchivedReports")
+}
+
+// MercuryReplay starts POSTing every report archived for a Mercury job in a
+// time range to the given URL, oldest first. The replay runs in the
+// background, see ShowReplay for its progress.
+// Example:
+//
+//	"POST <application>/report_archive/mercury/:jobID/replay"
+func (rac *ReportArchiveController) MercuryReplay(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("jobID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request, err := parseReplayReportArchiveRequest(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	ctx := c.Request.Context()
+	orm := mercury.NewArchiveORM(rac.App.GetDB(), int32(jobID))
+	// A Mercury job transmits a single feed, so the feed ID of its first
+	// archived report is the feed ID of all of them.
+	first, err := orm.Get(ctx, request.From, request.To, 0, 1)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(first) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("no reports archived in range"))
+		return
+	}
+	target := mercury.NewArchiveHTTPTarget(clhttp.NewUnrestrictedHTTPClient(), request.URL, first[0].FeedID)
+	rac.startReplay(c, "mercury/"+c.Param("jobID"), request, func(ctx context.Context) (int, error) {
+		return mercury.ReplayArchive(ctx, orm, request.From, request.To, target)
+	})
+}
+
+func (rac *ReportArchiveController) startReplay(c *gin.Context, name string, request ReplayReportArchiveRequest, fn archive.ReplayFunc) {
+	replay, err := rac.App.ReportArchiveReplays().Run(name, request.From, request.To, fn)
+	if err != nil {
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewReportArchiveReplayResource(replay), "reportArchiveReplays", http.StatusAccepted)
+}
+
+// IndexReplays lists the running and recently finished replays, most recent
+// first.
+// Example:
+//
+//	"GET <application>/report_archive/replays"
+func (rac *ReportArchiveController) IndexReplays(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewReportArchiveReplayResources(rac.App.ReportArchiveReplays().Replays()), "reportArchiveReplays")
+}
+
+// ShowReplay shows the progress of a replay. A replay that stopped early
+// reports how many reports were replayed, so that the operator can resume
+// from there.
+// Example:
+//
+//	"GET <application>/report_archive/replays/:ID"
+func (rac *ReportArchiveController) ShowReplay(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	replay, ok := rac.App.ReportArchiveReplays().Replay(id)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("replay not found"))
+		return
+	}
+	jsonAPIResponse(c, presenters.NewReportArchiveReplayResource(replay), "reportArchiveReplays")
+}
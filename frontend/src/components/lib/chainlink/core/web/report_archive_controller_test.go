@@ -0,0 +1,152 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/archive"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestReportArchiveController_LLO(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	const donID = 7
+	orm := archive.NewORM(app.GetDB(), donID)
+	records := make([]*archive.Record, 3)
+	for i := range records {
+		records[i] = &archive.Record{
+			ConfigDigest: ocrtypes.ConfigDigest{1, 2, 3},
+			SeqNr:        uint64(i + 1),
+			Report: ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+				Report: ocrtypes.Report{1, 2, 3},
+				Info:   llotypes.ReportInfo{LifeCycleStage: "production", ReportFormat: llotypes.ReportFormatEVMPremiumLegacy},
+			},
+			Sigs: []ocrtypes.AttributedOnchainSignature{{Signature: []byte{4, 5, 6}, Signer: 1}},
+		}
+		require.NoError(t, orm.Insert(ctx, records[i]))
+	}
+
+	client := app.NewHTTPClient(nil)
+
+	// waitForReplay polls the replay started by resp until it finishes
+	waitForReplay := func(t *testing.T, resp *http.Response) presenters.ReportArchiveReplayResource {
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+		var replay presenters.ReportArchiveReplayResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &replay))
+		assert.Equal(t, "llo/7", replay.Archive)
+		require.Eventually(t, func() bool {
+			resp, cleanup := client.Get("/v2/report_archive/replays/" + replay.ID)
+			defer cleanup()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &replay))
+			return replay.State != archive.ReplayStateRunning
+		}, testutils.WaitTimeout(t), 100*time.Millisecond)
+		return replay
+	}
+
+	t.Run("pages through the archive", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/report_archive/llo/7?limit=2")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var resources []presenters.LLOArchivedReportResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 2)
+		assert.Equal(t, uint64(1), resources[0].SeqNr)
+
+		resp, cleanup = client.Get("/v2/report_archive/llo/7?afterID=" + resources[1].ID)
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 1)
+		assert.Equal(t, strconv.FormatInt(records[2].ID, 10), resources[0].ID)
+		assert.Equal(t, uint64(3), resources[0].SeqNr)
+	})
+
+	t.Run("rejects invalid queries", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/report_archive/llo/7?from=yesterday")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("replays to an HTTP endpoint", func(t *testing.T) {
+		var mu sync.Mutex
+		var received []archive.HTTPTargetReport
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var report archive.HTTPTargetReport
+			if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mu.Lock()
+			received = append(received, report)
+			mu.Unlock()
+		}))
+		t.Cleanup(srv.Close)
+
+		body, err := json.Marshal(web.ReplayReportArchiveRequest{URL: srv.URL})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/report_archive/llo/7/replay", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		result := waitForReplay(t, resp)
+		assert.Equal(t, archive.ReplayStateCompleted, result.State)
+		assert.Equal(t, 3, result.Replayed)
+		assert.Empty(t, result.Error)
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, received, 3)
+		for i, r := range received {
+			assert.Equal(t, uint64(i+1), r.SeqNr)
+			assert.Equal(t, records[i].ConfigDigest.Hex(), r.ConfigDigest)
+		}
+	})
+
+	t.Run("reports how far a failed replay got", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(srv.Close)
+
+		body, err := json.Marshal(web.ReplayReportArchiveRequest{URL: srv.URL})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/report_archive/llo/7/replay", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		result := waitForReplay(t, resp)
+		assert.Equal(t, archive.ReplayStateErrored, result.State)
+		assert.Equal(t, 0, result.Replayed)
+		assert.Contains(t, result.Error, "status code: 503")
+	})
+
+	t.Run("lists replays, most recent first", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/report_archive/replays")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var replays []presenters.ReportArchiveReplayResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &replays))
+		require.Len(t, replays, 2)
+		assert.Equal(t, archive.ReplayStateErrored, replays[0].State)
+		assert.Equal(t, archive.ReplayStateCompleted, replays[1].State)
+	})
+}
@@ -2,6 +2,7 @@ package resolver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/graph-gophers/graphql-go"
@@ -84,6 +85,19 @@ func (r *FeedsManagerResolver) DisabledAt() *graphql.Time {
 	return &graphql.Time{Time: *r.mgr.DisabledAt}
 }
 
+// ApprovalPolicies resolves the feeds manager's approval policies as JSON.
+func (r *FeedsManagerResolver) ApprovalPolicies() (string, error) {
+	policies := r.mgr.ApprovalPolicies
+	if policies == nil {
+		policies = feeds.ApprovalPolicies{}
+	}
+	b, err := json.Marshal(policies)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 // -- FeedsManager Query --
 
 type FeedsManagerPayloadResolver struct {
@@ -291,6 +305,54 @@ func (r *UpdateFeedsManagerSuccessResolver) FeedsManager() *FeedsManagerResolver
 	return NewFeedsManager(r.mgr)
 }
 
+// -- UpdateFeedsManagerApprovalPolicies Mutation --
+
+type UpdateFeedsManagerApprovalPoliciesPayloadResolver struct {
+	mgr       *feeds.FeedsManager
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdateFeedsManagerApprovalPoliciesPayload(mgr *feeds.FeedsManager, err error, inputErrs map[string]string) *UpdateFeedsManagerApprovalPoliciesPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "feeds manager not found", isExpectedErrorFn: nil}
+
+	return &UpdateFeedsManagerApprovalPoliciesPayloadResolver{
+		mgr:                    mgr,
+		inputErrs:              inputErrs,
+		NotFoundErrorUnionType: e,
+	}
+}
+
+func (r *UpdateFeedsManagerApprovalPoliciesPayloadResolver) ToUpdateFeedsManagerApprovalPoliciesSuccess() (*UpdateFeedsManagerApprovalPoliciesSuccessResolver, bool) {
+	if r.mgr != nil {
+		return &UpdateFeedsManagerApprovalPoliciesSuccessResolver{mgr: *r.mgr}, true
+	}
+
+	return nil, false
+}
+
+func (r *UpdateFeedsManagerApprovalPoliciesPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type UpdateFeedsManagerApprovalPoliciesSuccessResolver struct {
+	mgr feeds.FeedsManager
+}
+
+func (r *UpdateFeedsManagerApprovalPoliciesSuccessResolver) FeedsManager() *FeedsManagerResolver {
+	return NewFeedsManager(r.mgr)
+}
+
 // -- EnableFeedsManager Mutation --
 
 type EnableFeedsManagerPayloadResolver struct {
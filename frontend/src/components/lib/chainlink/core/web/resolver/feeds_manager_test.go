@@ -462,6 +462,112 @@ func Test_UpdateFeedsManager(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func Test_UpdateFeedsManagerApprovalPolicies(t *testing.T) {
+	var (
+		mgrID    = int64(1)
+		policies = `[{"name":"fm","action":"approve","jobTypes":["fluxmonitor"]}]`
+
+		mutation = `
+			mutation UpdateFeedsManagerApprovalPolicies($id: ID!, $input: UpdateFeedsManagerApprovalPoliciesInput!) {
+				updateFeedsManagerApprovalPolicies(id: $id, input: $input) {
+					... on UpdateFeedsManagerApprovalPoliciesSuccess {
+						feedsManager {
+							id
+							approvalPolicies
+						}
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+					... on InputErrors {
+						errors {
+							path
+							message
+							code
+						}
+					}
+				}
+			}`
+		variables = map[string]interface{}{
+			"id": "1",
+			"input": map[string]interface{}{
+				"policies": policies,
+			},
+		}
+		want = feeds.ApprovalPolicies{
+			{Name: "fm", Action: feeds.ApprovalPolicyActionApprove, JobTypes: []string{"fluxmonitor"}},
+		}
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateFeedsManagerApprovalPolicies"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("UpdateManagerApprovalPolicies", mock.Anything, mgrID, want).Return(nil)
+				f.Mocks.feedsSvc.On("GetManager", mock.Anything, mgrID).Return(&feeds.FeedsManager{
+					ID:               mgrID,
+					ApprovalPolicies: want,
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"updateFeedsManagerApprovalPolicies": {
+					"feedsManager": {
+						"id": "1",
+						"approvalPolicies": "[{\"name\":\"fm\",\"action\":\"approve\",\"jobTypes\":[\"fluxmonitor\"]}]"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("UpdateManagerApprovalPolicies", mock.Anything, mgrID, want).Return(errors.Wrap(sql.ErrNoRows, "could not update manager approval policies"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"updateFeedsManagerApprovalPolicies": {
+					"message": "feeds manager not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+		{
+			name:          "invalid policies",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"id": "1",
+				"input": map[string]interface{}{
+					"policies": `[{"name":"fm","action":"reject"}]`,
+				},
+			},
+			result: `
+			{
+				"updateFeedsManagerApprovalPolicies": {
+					"errors": [{
+						"path": "input/policies",
+						"message": "policy fm: invalid action \"reject\", must be \"approve\" or \"manual\"",
+						"code": "INVALID_INPUT"
+					}]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func Test_EnableFeedsManager(t *testing.T) {
 	var (
 		mgrID     = int64(1)
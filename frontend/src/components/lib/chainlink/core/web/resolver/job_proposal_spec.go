@@ -96,6 +96,18 @@ func (r *JobProposalSpecResolver) UpdatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.UpdatedAt}
 }
 
+// ApprovalDecision resolves to how the approval policies of the feeds manager
+// handled the spec when it was proposed, if the manager has any.
+func (r *JobProposalSpecResolver) ApprovalDecision() *string {
+	return r.spec.ApprovalDecision.Ptr()
+}
+
+// ApprovalPolicy resolves to the name of the approval policy that matched the
+// spec, if any.
+func (r *JobProposalSpecResolver) ApprovalPolicy() *string {
+	return r.spec.ApprovalPolicy.Ptr()
+}
+
 // -- ApproveJobProposal Mutation --
 
 // ApproveJobProposalSpecPayloadResolver resolves the spec payload.
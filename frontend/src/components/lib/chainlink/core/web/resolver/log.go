@@ -3,7 +3,10 @@ package resolver
 import (
 	"strings"
 
+	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 type LogLevel string
@@ -169,3 +172,141 @@ func NewSetGlobalLogLevelSuccess(lvl LogLevel) *SetGlobalLogLevelSuccessResolver
 func (r *SetGlobalLogLevelSuccessResolver) GlobalLogLevel() *GlobalLogLevelResolver {
 	return GlobalLogLevel(FromLogLevel(r.lvl))
 }
+
+// -- LogLevelOverride --
+
+type LogLevelOverrideResolver struct {
+	override logger.LevelOverride
+}
+
+func NewLogLevelOverride(override logger.LevelOverride) *LogLevelOverrideResolver {
+	return &LogLevelOverrideResolver{override: override}
+}
+
+func NewLogLevelOverrides(overrides []logger.LevelOverride) []*LogLevelOverrideResolver {
+	var resolvers []*LogLevelOverrideResolver
+	for _, o := range overrides {
+		resolvers = append(resolvers, NewLogLevelOverride(o))
+	}
+
+	return resolvers
+}
+
+func (r *LogLevelOverrideResolver) Name() string {
+	return r.override.Name
+}
+
+func (r *LogLevelOverrideResolver) Level() (LogLevel, error) {
+	return ToLogLevel(r.override.Level.String())
+}
+
+func (r *LogLevelOverrideResolver) ExpiresAt() *graphql.Time {
+	if r.override.ExpiresAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.override.ExpiresAt}
+}
+
+// -- LogLevelOverrides Query --
+
+type LogLevelOverridesPayloadResolver struct {
+	overrides []logger.LevelOverride
+}
+
+func NewLogLevelOverridesPayload(overrides []logger.LevelOverride) *LogLevelOverridesPayloadResolver {
+	return &LogLevelOverridesPayloadResolver{overrides: overrides}
+}
+
+func (r *LogLevelOverridesPayloadResolver) Results() []*LogLevelOverrideResolver {
+	return NewLogLevelOverrides(r.overrides)
+}
+
+// -- SetLogLevelOverride Mutation --
+
+type SetLogLevelOverrideInput struct {
+	Name             string
+	Level            LogLevel
+	ExpiresInMinutes *int32
+}
+
+type SetLogLevelOverridePayloadResolver struct {
+	override  *logger.LevelOverride
+	inputErrs map[string]string
+}
+
+func NewSetLogLevelOverridePayload(override *logger.LevelOverride, inputErrs map[string]string) *SetLogLevelOverridePayloadResolver {
+	return &SetLogLevelOverridePayloadResolver{override: override, inputErrs: inputErrs}
+}
+
+func (r *SetLogLevelOverridePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+func (r *SetLogLevelOverridePayloadResolver) ToSetLogLevelOverrideSuccess() (*SetLogLevelOverrideSuccessResolver, bool) {
+	if r.inputErrs != nil || r.override == nil {
+		return nil, false
+	}
+
+	return NewSetLogLevelOverrideSuccess(*r.override), true
+}
+
+type SetLogLevelOverrideSuccessResolver struct {
+	override logger.LevelOverride
+}
+
+func NewSetLogLevelOverrideSuccess(override logger.LevelOverride) *SetLogLevelOverrideSuccessResolver {
+	return &SetLogLevelOverrideSuccessResolver{override: override}
+}
+
+func (r *SetLogLevelOverrideSuccessResolver) Override() *LogLevelOverrideResolver {
+	return NewLogLevelOverride(r.override)
+}
+
+// -- RemoveLogLevelOverride Mutation --
+
+type RemoveLogLevelOverridePayloadResolver struct {
+	name    string
+	removed bool
+}
+
+func NewRemoveLogLevelOverridePayload(name string, removed bool) *RemoveLogLevelOverridePayloadResolver {
+	return &RemoveLogLevelOverridePayloadResolver{name: name, removed: removed}
+}
+
+func (r *RemoveLogLevelOverridePayloadResolver) ToRemoveLogLevelOverrideSuccess() (*RemoveLogLevelOverrideSuccessResolver, bool) {
+	if !r.removed {
+		return nil, false
+	}
+
+	return NewRemoveLogLevelOverrideSuccess(r.name), true
+}
+
+func (r *RemoveLogLevelOverridePayloadResolver) ToNotFoundError() (*NotFoundErrorResolver, bool) {
+	if r.removed {
+		return nil, false
+	}
+
+	return NewNotFoundError("log level override not found"), true
+}
+
+type RemoveLogLevelOverrideSuccessResolver struct {
+	name string
+}
+
+func NewRemoveLogLevelOverrideSuccess(name string) *RemoveLogLevelOverrideSuccessResolver {
+	return &RemoveLogLevelOverrideSuccessResolver{name: name}
+}
+
+func (r *RemoveLogLevelOverrideSuccessResolver) Name() string {
+	return r.name
+}
@@ -7,9 +7,11 @@ import (
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 func TestResolver_SetSQLLogging(t *testing.T) {
@@ -215,3 +217,193 @@ func TestResolver_SetGlobalLogLevel(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_LogLevelOverrides(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetLogLevelOverrides {
+			logLevelOverrides {
+				results {
+					name
+					level
+					expiresAt
+				}
+			}
+		}`
+
+	overrides := logger.NewLevelOverrides()
+	_, err := overrides.Set("OCR2.*", zapcore.WarnLevel, 0)
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "logLevelOverrides"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.cfg.On("LogLevelOverrides").Return(overrides)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+			},
+			query: query,
+			result: `
+				{
+					"logLevelOverrides": {
+						"results": [{
+							"name": "OCR2.*",
+							"level": "WARN",
+							"expiresAt": null
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_SetLogLevelOverride(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SetLogLevelOverride($input: SetLogLevelOverrideInput!) {
+			setLogLevelOverride(input: $input) {
+				... on SetLogLevelOverrideSuccess {
+					override {
+						name
+						level
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":             "EVM.1.Txm",
+			"level":            LogLevelDebug,
+			"expiresInMinutes": 30,
+		},
+	}
+	invalidVariables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":  "EVM.*.Txm",
+			"level": LogLevelDebug,
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "setLogLevelOverride"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				overrides := logger.NewLevelOverrides()
+				f.Mocks.cfg.On("LogLevelOverrides").Return(overrides)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				t.Cleanup(func() {
+					list := overrides.List()
+					require.Len(t, list, 1)
+					assert.Equal(t, zapcore.DebugLevel, list[0].Level)
+					assert.NotNil(t, list[0].ExpiresAt)
+				})
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"setLogLevelOverride": {
+						"override": {
+							"name": "EVM.1.Txm",
+							"level": "DEBUG"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid name",
+			authenticated: true,
+			query:         mutation,
+			variables:     invalidVariables,
+			result: `
+				{
+					"setLogLevelOverride": {
+						"errors": [{
+							"path": "input/name",
+							"message": "invalid logger name \"EVM.*.Txm\": wildcard must be the last name segment, e.g. OCR2.*",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RemoveLogLevelOverride(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RemoveLogLevelOverride($name: String!) {
+			removeLogLevelOverride(name: $name) {
+				... on RemoveLogLevelOverrideSuccess {
+					name
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"name": "OCR2.*",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "removeLogLevelOverride"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				overrides := logger.NewLevelOverrides()
+				_, err := overrides.Set("OCR2.*", zapcore.WarnLevel, 0)
+				require.NoError(t, err)
+				f.Mocks.cfg.On("LogLevelOverrides").Return(overrides)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"removeLogLevelOverride": {
+						"name": "OCR2.*"
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.cfg.On("LogLevelOverrides").Return(logger.NewLevelOverrides())
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"removeLogLevelOverride": {
+						"message": "log level override not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
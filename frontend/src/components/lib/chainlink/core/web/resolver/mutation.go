@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
@@ -570,6 +571,62 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	return NewUpdateFeedsManagerPayload(mgr, nil, nil), nil
 }
 
+type updateFeedsManagerApprovalPoliciesInput struct {
+	Policies string
+}
+
+func (r *Resolver) UpdateFeedsManagerApprovalPolicies(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *updateFeedsManagerApprovalPoliciesInput
+}) (*UpdateFeedsManagerApprovalPoliciesPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var policies feeds.ApprovalPolicies
+	if err = json.Unmarshal([]byte(args.Input.Policies), &policies); err != nil {
+		return NewUpdateFeedsManagerApprovalPoliciesPayload(nil, nil, map[string]string{
+			"input/policies": "invalid JSON value",
+		}), nil
+	}
+	if err = policies.Validate(); err != nil {
+		return NewUpdateFeedsManagerApprovalPoliciesPayload(nil, nil, map[string]string{
+			"input/policies": err.Error(),
+		}), nil
+	}
+
+	feedsService := r.App.GetFeedsService()
+
+	if err = feedsService.UpdateManagerApprovalPolicies(ctx, id, policies); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateFeedsManagerApprovalPoliciesPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	mgr, err := feedsService.GetManager(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateFeedsManagerApprovalPoliciesPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.FeedsManApprovalPoliciesUpdated, map[string]interface{}{
+		"feedsManagerID": mgr.ID,
+		"policies":       policies,
+	})
+
+	return NewUpdateFeedsManagerApprovalPoliciesPayload(mgr, nil, nil), nil
+}
+
 func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
@@ -1260,6 +1317,61 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	return NewSetGlobalLogLevelPayload(args.Level, nil), nil
 }
 
+// SetLogLevelOverride overrides the log level of named loggers.
+func (r *Resolver) SetLogLevelOverride(ctx context.Context, args struct {
+	Input SetLogLevelOverrideInput
+}) (*SetLogLevelOverridePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	inputErrs := map[string]string{}
+	if err := logger.ValidateLevelOverrideName(args.Input.Name); err != nil {
+		inputErrs["input/name"] = err.Error()
+	}
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(FromLogLevel(args.Input.Level))); err != nil {
+		inputErrs["input/level"] = "invalid log level"
+	}
+	var ttl time.Duration
+	if args.Input.ExpiresInMinutes != nil {
+		if *args.Input.ExpiresInMinutes <= 0 {
+			inputErrs["input/expiresInMinutes"] = "must be positive"
+		}
+		ttl = time.Duration(*args.Input.ExpiresInMinutes) * time.Minute
+	}
+	if len(inputErrs) > 0 {
+		return NewSetLogLevelOverridePayload(nil, inputErrs), nil
+	}
+
+	override, err := r.App.GetConfig().LogLevelOverrides().Set(args.Input.Name, lvl, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.LogLevelOverrideSet, map[string]interface{}{
+		"name":      override.Name,
+		"logLevel":  override.Level.String(),
+		"expiresAt": override.ExpiresAt,
+	})
+	return NewSetLogLevelOverridePayload(&override, nil), nil
+}
+
+// RemoveLogLevelOverride removes the log level override of named loggers.
+func (r *Resolver) RemoveLogLevelOverride(ctx context.Context, args struct {
+	Name string
+}) (*RemoveLogLevelOverridePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	removed := r.App.GetConfig().LogLevelOverrides().Remove(args.Name)
+	if removed {
+		r.App.GetAuditLogger().Audit(audit.LogLevelOverrideRemoved, map[string]interface{}{"name": args.Name})
+	}
+	return NewRemoveLogLevelOverridePayload(args.Name, removed), nil
+}
+
 // CreateOCR2KeyBundle resolves a create OCR2 Key bundle mutation
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
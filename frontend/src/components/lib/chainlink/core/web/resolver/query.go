@@ -573,6 +573,15 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 	return NewGlobalLogLevelPayload(logLevel), nil
 }
 
+// LogLevelOverrides retrieves the log level overrides of named loggers.
+func (r *Resolver) LogLevelOverrides(ctx context.Context) (*LogLevelOverridesPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	return NewLogLevelOverridesPayload(r.App.GetConfig().LogLevelOverrides().List()), nil
+}
+
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
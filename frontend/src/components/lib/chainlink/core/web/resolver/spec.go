@@ -249,6 +249,11 @@ func (r *FluxMonitorSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
 }
 
+// DeviationMode resolves the spec's deviation mode.
+func (r *FluxMonitorSpecResolver) DeviationMode() string {
+	return r.spec.DeviationMode
+}
+
 // AbsoluteThreshold resolves the spec's absolute threshold.
 func (r *FluxMonitorSpecResolver) DrumbeatEnabled() bool {
 	return r.spec.DrumbeatEnabled
@@ -315,11 +320,41 @@ func (r *FluxMonitorSpecResolver) PollTimerPeriod() string {
 	return r.spec.PollTimerPeriod.String()
 }
 
+// SubmissionBudget resolves the spec's submission budget.
+func (r *FluxMonitorSpecResolver) SubmissionBudget() int32 {
+	return int32(r.spec.SubmissionBudget)
+}
+
+// SubmissionBudgetPeriod resolves the spec's submission budget period.
+func (r *FluxMonitorSpecResolver) SubmissionBudgetPeriod() string {
+	return r.spec.SubmissionBudgetPeriod.String()
+}
+
 // Threshold resolves the spec's deviation threshold.
 func (r *FluxMonitorSpecResolver) Threshold() float64 {
 	return float64(r.spec.Threshold)
 }
 
+// ThresholdDown resolves the spec's deviation threshold for decreases.
+func (r *FluxMonitorSpecResolver) ThresholdDown() float64 {
+	return float64(r.spec.ThresholdDown)
+}
+
+// ThresholdUp resolves the spec's deviation threshold for increases.
+func (r *FluxMonitorSpecResolver) ThresholdUp() float64 {
+	return float64(r.spec.ThresholdUp)
+}
+
+// VolatilityMultiplier resolves the spec's volatility multiplier.
+func (r *FluxMonitorSpecResolver) VolatilityMultiplier() float64 {
+	return float64(r.spec.VolatilityMultiplier)
+}
+
+// VolatilityWindow resolves the spec's volatility window.
+func (r *FluxMonitorSpecResolver) VolatilityWindow() int32 {
+	return int32(r.spec.VolatilityWindow)
+}
+
 type KeeperSpecResolver struct {
 	spec job.KeeperSpec
 }
@@ -358,6 +393,11 @@ func (r *KeeperSpecResolver) FromAddress() *string {
 	return &addr
 }
 
+// DryRun resolves whether the spec only simulates perform transactions.
+func (r *KeeperSpecResolver) DryRun() bool {
+	return r.spec.DryRun
+}
+
 type OCRSpecResolver struct {
 	spec job.OCROracleSpec
 }
@@ -292,6 +292,65 @@ func TestResolver_FluxMonitorSpec(t *testing.T) {
 				}
 			`,
 		},
+		{
+			name:          "flux monitor spec with deviation rules",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					Type: job.FluxMonitor,
+					FluxMonitorSpec: &job.FluxMonitorSpec{
+						ContractAddress:        contractAddress,
+						Threshold:              0.5,
+						ThresholdUp:            1,
+						ThresholdDown:          0.25,
+						DeviationMode:          job.FluxMonitorDeviationModeAny,
+						VolatilityWindow:       10,
+						VolatilityMultiplier:   2,
+						SubmissionBudget:       6,
+						SubmissionBudgetPeriod: 1 * time.Hour,
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on FluxMonitorSpec {
+									deviationMode
+									submissionBudget
+									submissionBudgetPeriod
+									threshold
+									thresholdDown
+									thresholdUp
+									volatilityMultiplier
+									volatilityWindow
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "FluxMonitorSpec",
+							"deviationMode": "any",
+							"submissionBudget": 6,
+							"submissionBudgetPeriod": "1h0m0s",
+							"threshold": 0.5,
+							"thresholdDown": 0.25,
+							"thresholdUp": 1,
+							"volatilityMultiplier": 2,
+							"volatilityWindow": 10
+						}
+					}
+				}
+			`,
+		},
 	}
 
 	RunGQLTests(t, testCases)
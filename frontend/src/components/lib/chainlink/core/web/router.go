@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Depado/ginprom"
@@ -75,13 +76,12 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	}
 	engine.Use(helmet.Default())
 
-	rl := config.WebServer().RateLimit()
 	api := engine.Group(
 		"/",
-		rateLimiter(
-			rl.AuthenticatedPeriod(),
-			rl.Authenticated(),
-		),
+		reloadableRateLimiter(func() (time.Duration, int64) {
+			rl := config.WebServer().RateLimit()
+			return rl.AuthenticatedPeriod(), rl.Authenticated()
+		}),
 		sessions.Sessions(auth.SessionName, sessionStore),
 	)
 
@@ -138,6 +138,27 @@ func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 	return mgin.NewMiddleware(limiter.New(store, rate))
 }
 
+// reloadableRateLimiter limits requests to the rate returned by rate. The rate
+// is read on every request, so that reloading the config applies to it.
+func reloadableRateLimiter(rate func() (time.Duration, int64)) gin.HandlerFunc {
+	var (
+		mu                    sync.Mutex
+		currPeriod, currLimit = rate()
+		curr                  = rateLimiter(currPeriod, currLimit)
+	)
+	return func(c *gin.Context) {
+		period, limit := rate()
+		mu.Lock()
+		if period != currPeriod || limit != currLimit {
+			currPeriod, currLimit = period, limit
+			curr = rateLimiter(period, limit)
+		}
+		handler := curr
+		mu.Unlock()
+		handler(c)
+	}
+}
+
 // secureOptions configure security options for the secure middleware, mostly
 // for TLS redirection
 func secureOptions(tlsRedirect bool, tlsHost string, devWebServer bool) secure.Options {
@@ -204,11 +225,10 @@ func ginHandlerFromHTTP(h http.HandlerFunc) gin.HandlerFunc {
 
 func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	config := app.GetConfig()
-	rl := config.WebServer().RateLimit()
-	unauth := r.Group("/", rateLimiter(
-		rl.UnauthenticatedPeriod(),
-		rl.Unauthenticated(),
-	))
+	unauth := r.Group("/", reloadableRateLimiter(func() (time.Duration, int64) {
+		rl := config.WebServer().RateLimit()
+		return rl.UnauthenticatedPeriod(), rl.Unauthenticated()
+	}))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
@@ -278,6 +298,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 		authv2.GET("/config/v2", cc.Show)
+		authv2.POST("/config/reload", auth.RequiresAdminRole(cc.Reload))
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -294,6 +315,24 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
 
+		sc := SpendController{app}
+		authv2.GET("/spend", sc.Index)
+
+		bhsbc := BHSBackfillsController{app}
+		authv2.GET("/bhs_backfills", bhsbc.Index)
+		authv2.POST("/bhs_backfills", auth.RequiresAdminRole(bhsbc.Create))
+
+		ktc := KeyTopUpsController{app}
+		authv2.GET("/key_top_ups", paginatedRequest(ktc.Index))
+
+		rac := ReportArchiveController{app}
+		authv2.GET("/report_archive/llo/:donID", rac.LLOIndex)
+		authv2.POST("/report_archive/llo/:donID/replay", auth.RequiresAdminRole(rac.LLOReplay))
+		authv2.GET("/report_archive/mercury/:jobID", rac.MercuryIndex)
+		authv2.POST("/report_archive/mercury/:jobID/replay", auth.RequiresAdminRole(rac.MercuryReplay))
+		authv2.GET("/report_archive/replays", rac.IndexReplays)
+		authv2.GET("/report_archive/replays/:ID", rac.ShowReplay)
+
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
 		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
@@ -379,6 +418,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		kdc := KeeperDryRunController{app}
+		authv2.GET("/jobs/:ID/keeper/dry_run_transactions", kdc.Index)
+
+		drrc := DirectRequestRejectionsController{app}
+		authv2.GET("/jobs/:ID/direct_request/rejections", drrc.Index)
+
+		vbc := VRFBacklogController{app}
+		authv2.GET("/jobs/:ID/vrf/pending_requests", vbc.Index)
+		authv2.POST("/jobs/:ID/vrf/pending_requests/:requestID/fulfill", auth.RequiresAdminRole(vbc.Fulfill))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
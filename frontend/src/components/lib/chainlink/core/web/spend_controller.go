@@ -0,0 +1,50 @@
+package web
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// SpendController reports the spend of the configured gas spend budgets.
+type SpendController struct {
+	App chainlink.Application
+}
+
+// Index lists the gas spend budgets with the fees paid over their current window, optionally filtered by chain
+// Example:
+//
+//	"GET <application>/spend?evmChainID=1"
+func (sc *SpendController) Index(c *gin.Context) {
+	var chainID *big.Int
+	if s := c.Query("evmChainID"); s != "" {
+		var ok bool
+		if chainID, ok = new(big.Int).SetString(s, 10); !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("%w: %q", ErrInvalidChainID, s))
+			return
+		}
+	}
+
+	now := time.Now()
+	resources := []presenters.GasSpendResource{}
+	for i, budget := range chainlink.GasSpendBudgets(sc.App.GetConfig().GasSpend()) {
+		if chainID != nil && budget.ChainID.Cmp(chainID) != 0 {
+			continue
+		}
+
+		spent, err := sc.App.TxmStorageService().GasSpend(c.Request.Context(), budget.Filter(now), budget.ChainID)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		resources = append(resources, *presenters.NewGasSpendResource(i, budget, spent))
+	}
+
+	jsonAPIResponse(c, resources, "gasSpend")
+}
@@ -0,0 +1,81 @@
+package web_test
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestSpendController_Index(t *testing.T) {
+	t.Parallel()
+
+	queue := toml.GasSpendActionQueue
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.GasSpend.Budgets = []toml.GasSpendBudget{
+			{ChainID: ubig.New(testutils.FixtureChainID), Window: commonconfig.MustNewDuration(time.Hour), Limit: assets.NewWeiI(2_000_000)},
+			{ChainID: ubig.NewI(1), Window: commonconfig.MustNewDuration(time.Hour), Limit: assets.NewWeiI(1), Action: &queue},
+		}
+	}))
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
+	_, err := txStore.InsertReceipt(ctx, &evmtypes.Receipt{
+		TxHash:            etx.TxAttempts[0].Hash,
+		BlockHash:         utils.NewHash(),
+		BlockNumber:       big.NewInt(1),
+		GasUsed:           21_000,
+		EffectiveGasPrice: big.NewInt(50),
+		Status:            1,
+	})
+	require.NoError(t, err)
+
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/spend")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var resources []presenters.GasSpendResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+	require.Len(t, resources, 2)
+
+	assert.Equal(t, "reject", resources[0].Action)
+	assert.Equal(t, assets.NewWeiI(1_050_000).String(), resources[0].Spent.String())
+	assert.Equal(t, assets.NewWeiI(950_000).String(), resources[0].Remaining.String())
+	assert.False(t, resources[0].Exceeded)
+
+	assert.Equal(t, "queue", resources[1].Action)
+	assert.Equal(t, assets.NewWeiI(0).String(), resources[1].Spent.String())
+
+	resp, cleanup = client.Get("/v2/spend?evmChainID=1")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var filtered []presenters.GasSpendResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &filtered))
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "1", filtered[0].ID)
+
+	resp, cleanup = client.Get("/v2/spend?evmChainID=abc")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// VRFBacklogController inspects and fulfills the pending requests of running
+// VRF v2 and v2plus jobs.
+type VRFBacklogController struct {
+	App chainlink.Application
+}
+
+// VRFFulfillRequest is a JSONAPI request for manually fulfilling a pending
+// VRF request. All fields are optional.
+type VRFFulfillRequest struct {
+	GasLimit    uint64          `json:"gasLimit"`
+	MaxGasPrice *assets.Wei     `json:"maxGasPrice"`
+	FromAddress *common.Address `json:"fromAddress"`
+}
+
+// Index lists the pending requests of a VRF job, oldest first
+// Example:
+//
+//	"GET <application>/jobs/:ID/vrf/pending_requests"
+func (vbc *VRFBacklogController) Index(c *gin.Context) {
+	jobID, inspector, ok := vbc.inspector(c)
+	if !ok {
+		return
+	}
+	jsonAPIResponse(c, presenters.NewVRFPendingRequestResources(jobID, inspector.PendingRequests()), "vrfPendingRequests")
+}
+
+// Fulfill simulates and enqueues a fulfillment of a pending VRF request,
+// skipping the node's own subscription balance check. A fulfillment the
+// coordinator cannot charge the subscription for fails simulation.
+// Example:
+//
+//	"POST <application>/jobs/:ID/vrf/pending_requests/:requestID/fulfill"
+func (vbc *VRFBacklogController) Fulfill(c *gin.Context) {
+	jobID, inspector, ok := vbc.inspector(c)
+	if !ok {
+		return
+	}
+	requestID, ok := new(big.Int).SetString(c.Param("requestID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid request ID %q", c.Param("requestID")))
+		return
+	}
+
+	request := VRFFulfillRequest{}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	res, err := inspector.ForceFulfill(c.Request.Context(), requestID, vrfcommon.ForceFulfillOptions{
+		GasLimit:    request.GasLimit,
+		MaxGasPrice: request.MaxGasPrice,
+		FromAddress: request.FromAddress,
+	})
+	if err != nil {
+		if errors.Is(err, vrfcommon.ErrRequestNotPending) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	vbc.App.GetAuditLogger().Audit(audit.VRFRequestForceFulfilled, map[string]interface{}{
+		"jobID":       jobID,
+		"requestID":   requestID.String(),
+		"ethTxID":     res.TxID,
+		"fromAddress": res.FromAddress,
+		"gasLimit":    res.GasLimit,
+	})
+	jsonAPIResponse(c, presenters.NewVRFFulfillmentResource(requestID.String(), res), "vrfFulfillments")
+}
+
+func (vbc *VRFBacklogController) inspector(c *gin.Context) (int32, vrfcommon.BacklogInspector, bool) {
+	var jb job.Job
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return 0, nil, false
+	}
+	inspector, err := vbc.App.VRFBacklog().Get(jb.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return 0, nil, false
+	}
+	return jb.ID, inspector, true
+}